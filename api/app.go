@@ -38,6 +38,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
 	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
 	"github.com/topfreegames/podium/log"
@@ -80,6 +81,25 @@ type App struct {
 	ID           uuid.UUID
 	Logger       *zap.Logger
 	Leaderboards lservice.Leaderboard
+
+	tieBreaks       map[string]*lservice.TieBreak
+	compositeScores map[string]*lservice.CompositeScore
+	scoreTypes      map[string]*lservice.ScoreType
+	scoreRules      map[string]*lservice.ScoreRules
+
+	defaultSeason seasonSettings
+	seasons       map[string]seasonSettings
+
+	seasonFamilies      map[string]*lservice.SeasonFamily
+	seasonFamilySeasons map[string]seasonSettings
+
+	leagues map[string]*lservice.League
+
+	tieredLeagues map[string]*lservice.TieredLeague
+
+	ratings map[string]*rating.Settings
+
+	ladders map[string]*lservice.Ladder
 }
 
 // New returns a new podium Application.
@@ -190,12 +210,21 @@ func (app *App) setConfigurationDefaults() {
 	app.Config.SetDefault("graceperiod.ms", 50)
 	app.Config.SetDefault("api.maxReturnedMembers", 2000)
 	app.Config.SetDefault("api.maxReadBufferSize", 32000)
+	app.Config.SetDefault("leaderboards.default_update_policy", database.UpdatePolicyLast)
+	app.Config.SetDefault("leaderboards.default_rank_mode", lservice.RankModeOrdinal)
+	app.Config.SetDefault("leaderboards.definition_cache_ttl", 10*time.Second)
+	app.Config.SetDefault("signed_submissions.window", 5*time.Minute)
+	app.Config.SetDefault("signed_submissions.required", false)
+	app.Config.SetDefault("idempotency.window", 24*time.Hour)
+	app.Config.SetDefault("storage.backend", "redis")
 	app.Config.SetDefault("redis.host", "localhost")
 	app.Config.SetDefault("redis.port", 6379)
 	app.Config.SetDefault("redis.password", "")
 	app.Config.SetDefault("redis.db", 0)
 	app.Config.SetDefault("redis.connectionTimeout", 200)
 	app.Config.SetDefault("redis.cluster.enabled", false)
+	app.Config.SetDefault("redis.keys.schema", int(database.KeySchemaV1))
+	app.Config.SetDefault("redis.keys.prefix", "")
 }
 
 func (app *App) loadConfiguration() error {
@@ -215,6 +244,58 @@ func (app *App) loadConfiguration() error {
 		return fmt.Errorf("could not parse configuration file: %w", err)
 	}
 
+	if err := app.validateUpdatePolicies(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.validateRankModes(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadScoreRules(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadTieBreaks(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadCompositeScores(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadScoreTypes(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.validateSubmissionSigning(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadSeasons(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadSeasonFamilies(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadLeagues(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadTieredLeagues(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadRatings(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	if err := app.loadLadders(); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
 	return nil
 }
 
@@ -282,9 +363,12 @@ func (app *App) configureApplication() error {
 }
 
 func (app *App) createAndConfigureLeaderboardClient() (lservice.Leaderboard, error) {
-	client := app.createLeaderboardClient()
-	err := client.Healthcheck(context.Background())
+	client, err := app.createLeaderboardClient()
+	if err != nil {
+		return nil, err
+	}
 
+	err = client.Healthcheck(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -293,33 +377,91 @@ func (app *App) createAndConfigureLeaderboardClient() (lservice.Leaderboard, err
 	return client, nil
 }
 
-func (app *App) createLeaderboardClient() lservice.Leaderboard {
+func (app *App) createLeaderboardClient() (lservice.Leaderboard, error) {
+	if backend := app.Config.GetString("storage.backend"); backend == "memory" {
+		app.Logger.Info(
+			"Creating leaderboard client.",
+			zap.String("operation", "createLeaderboardClient"),
+			zap.String("backend", backend),
+		)
+		memoryDatabase := database.NewMemoryDatabase()
+		return service.NewService(memoryDatabase, app.leaderboardServiceOptions(memoryDatabase)...), nil
+	}
+
 	shouldRunOnCluster := app.Config.GetBool("redis.cluster.enabled")
 	addrs := app.Config.GetStringSlice("redis.addrs")
 	password := app.Config.GetString("redis.password")
 	host := app.Config.GetString("redis.host")
 	port := app.Config.GetInt("redis.port")
 	db := app.Config.GetInt("redis.db")
+	keySchema := database.KeySchemaVersion(app.Config.GetInt("redis.keys.schema"))
+	keyPrefix := app.Config.GetString("redis.keys.prefix")
 
 	logger := app.Logger.With(
 		zap.String("operation", "createLeaderboardClient"),
 		zap.Strings("addrs", addrs),
 		zap.Bool("cluster", shouldRunOnCluster),
 		zap.String("url", fmt.Sprintf("redis://:<REDACTED>@%s:%v/%v", host, port, db)),
+		zap.Int("keySchema", int(keySchema)),
+		zap.String("keyPrefix", keyPrefix),
 	)
 
-	leaderboardService := service.NewService(database.NewRedisDatabase(database.RedisOptions{
+	redisOptions := database.RedisOptions{
 		ClusterEnabled: shouldRunOnCluster,
 		Addrs:          addrs,
 		Host:           host,
 		Password:       password,
 		Port:           port,
 		DB:             db,
-	}))
+		KeySchema:      keySchema,
+		KeyPrefix:      keyPrefix,
+	}
+	if err := redisOptions.Validate(); err != nil {
+		logger.Error("Invalid redis configuration.", zap.Error(err))
+		return nil, err
+	}
+
+	redisDatabase := database.NewRedisDatabase(redisOptions)
+	leaderboardService := service.NewService(redisDatabase, app.leaderboardServiceOptions(redisDatabase)...)
 
 	logger.Info("Creating leaderboard client.")
 
-	return leaderboardService
+	return leaderboardService, nil
+}
+
+// leaderboardServiceOptions returns the per leaderboard settings of the leaderboard client, the backend also
+// keeps leaderboard definitions, final standings of seasonal leaderboards, league buckets, tier histories,
+// ratings, ladder positions, recent submissions and nonces, features whose store the backend doesn't
+// implement are disabled, like archiving on the memory backend.
+func (app *App) leaderboardServiceOptions(backend database.Database) []lservice.Option {
+	registry, _ := backend.(database.Registry)
+	archive, _ := backend.(database.Archive)
+	leagues, _ := backend.(database.Leagues)
+	tiers, _ := backend.(database.Tiers)
+	ratings, _ := backend.(database.Ratings)
+	ladders, _ := backend.(database.Ladders)
+	submissions, _ := backend.(database.Submissions)
+	nonces, _ := backend.(database.Nonces)
+
+	return []lservice.Option{
+		lservice.WithTieBreaks(app.getTieBreak),
+		lservice.WithCompositeScores(app.getCompositeScore),
+		lservice.WithScoreTypes(app.getScoreType),
+		lservice.WithUpdatePolicies(app.getConfiguredUpdatePolicy),
+		lservice.WithSeasonPolicies(app.getSeasonPolicy),
+		lservice.WithSeasonFamilies(app.getSeasonFamily),
+		lservice.WithRegistry(registry),
+		lservice.WithDefinitionCache(app.ParsedConfig.Leaderboards.DefinitionCacheTTL),
+		lservice.WithArchive(archive, app.isArchived),
+		lservice.WithLeagues(leagues, app.getLeague),
+		lservice.WithTieredLeagues(tiers, app.getTieredLeague),
+		lservice.WithRatings(ratings, app.getRatingSettings),
+		lservice.WithLadders(ladders, app.getLadder),
+		lservice.WithScoreRules(submissions, app.getScoreRules),
+		lservice.WithMetricsReporter(app.DDStatsD),
+		lservice.WithSubmissionSigning(nonces, app.getSubmissionSigning, app.ParsedConfig.SignedSubmissions.Window),
+		lservice.WithIdempotencyWindow(app.ParsedConfig.Idempotency.Window),
+	}
 }
 
 // AddError rate statistics
@@ -414,6 +556,7 @@ func (app *App) startGRPCServer(lis net.Listener) error {
 			app.loggerMiddleware,
 			app.recoveryMiddleware,
 			app.responseTimeMetricsMiddleware,
+			app.serviceErrorsMiddleware,
 		),
 	))
 	api.RegisterPodiumServer(app.grpcServer, app)
@@ -527,6 +670,8 @@ func customHeadersMatcher(key string) (string, bool) {
 		return key, true
 	case strings.ToLower(TenantIDHeaderKey):
 		return key, true
+	case SubmissionSignatureHeaderKey, SubmissionTimestampHeaderKey, SubmissionNonceHeaderKey:
+		return key, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
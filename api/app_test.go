@@ -3,6 +3,7 @@ package api_test
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -55,6 +56,67 @@ var _ = Describe("App", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(strings.ToLower(err.Error())).To(ContainSubstring("could not load configuration file from: ../config/invalid.yaml"))
 		})
+
+		It("Should fail if redis cluster is enabled without key schema v2", func() {
+			os.Setenv("PODIUM_REDIS_CLUSTER_ENABLED", "true")
+			defer os.Unsetenv("PODIUM_REDIS_CLUSTER_ENABLED")
+
+			app, err = api.New("127.0.0.1", 9999, 10000, "../config/test.yaml", false, logger)
+			Expect(app).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("redis cluster requires key schema 2")))
+		})
+
+		It("Should fail if a rating or ladder leaderboard has a score encoding", func() {
+			testConfig, err := ioutil.ReadFile("../config/test.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, test := range []struct {
+				section string
+				entry   string
+				message string
+			}{
+				{"  ratings:\n", "    testkey-float-elo:\n      algorithm: elo\n", "they can't have score type testkey-float*"},
+				{"  ladders:\n", "    testkey-tiebreak:\n      max_challenge_distance: 2\n", "they can't have tie-break testkey-tiebreak"},
+			} {
+				configFile, err := ioutil.TempFile("", "podium-*.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(configFile.Name())
+				_, err = configFile.WriteString(strings.Replace(string(testConfig), test.section, test.section+test.entry, 1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(configFile.Close()).To(Succeed())
+
+				app, err = api.New("127.0.0.1", 9999, 10000, configFile.Name(), false, logger)
+				Expect(app).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring(test.message)))
+			}
+		})
+		It("Should fail if a tie-break can't hold the scores its score rules allow", func() {
+			testConfig, err := ioutil.ReadFile("../config/test.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, test := range []struct {
+				entry   string
+				message string
+			}{
+				{"    testkey-rules-tiebreak:\n      bits: 44\n", "44 bits leave scores from -512 to 511, score rules allow 0 to 1000"},
+				{"    testkey-rules-tiebreak:\n      order: desc\n", ""},
+			} {
+				configFile, err := ioutil.TempFile("", "podium-*.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(configFile.Name())
+				_, err = configFile.WriteString(strings.Replace(string(testConfig), "  tie_breaks:\n", "  tie_breaks:\n"+test.entry, 1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(configFile.Close()).To(Succeed())
+
+				app, err = api.New("127.0.0.1", 9999, 10000, configFile.Name(), false, logger)
+				if test.message == "" {
+					Expect(err).NotTo(HaveOccurred())
+					continue
+				}
+				Expect(app).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring(test.message)))
+			}
+		})
 	})
 
 	Describe("Error Handler", func() {
@@ -0,0 +1,99 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// maxCompositeScoreBits is how many bits a composite score can take and still be stored losslessly.
+const maxCompositeScoreBits = 53
+
+// loadCompositeScores validates the configured composite scores and fills their defaults.
+func (app *App) loadCompositeScores() error {
+	app.compositeScores = map[string]*lservice.CompositeScore{}
+
+	for pattern, criteriaConfig := range app.ParsedConfig.Leaderboards.CompositeScores {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid composite score pattern %s: %w", pattern, err)
+		}
+		if len(criteriaConfig) == 0 {
+			return fmt.Errorf("composite score for %s has no criteria", pattern)
+		}
+
+		compositeScore := &lservice.CompositeScore{}
+		for i, criterionConfig := range criteriaConfig {
+			criterion := lservice.ScoreCriterion{
+				Name:  criterionConfig.Name,
+				Order: criterionConfig.Order,
+				Bits:  criterionConfig.Bits,
+			}
+			if criterion.Name == "" {
+				criterion.Name = fmt.Sprintf("score %d", i)
+			}
+			if criterion.Order == "" {
+				criterion.Order = "desc"
+			}
+
+			if criterion.Order != "asc" && criterion.Order != "desc" {
+				return fmt.Errorf("invalid composite score order for %s %s: %s", pattern, criterion.Name, criterion.Order)
+			}
+			if criterion.Bits == 0 {
+				return fmt.Errorf("composite score criterion %s of %s has no bits", criterion.Name, pattern)
+			}
+
+			compositeScore.Criteria = append(compositeScore.Criteria, criterion)
+		}
+
+		if bits := compositeScore.Bits(); bits > maxCompositeScoreBits {
+			return fmt.Errorf("composite score for %s takes %d bits, at most %d can be stored", pattern, bits, maxCompositeScoreBits)
+		}
+
+		app.compositeScores[pattern] = compositeScore
+	}
+
+	return nil
+}
+
+// getCompositeScore returns the composite score of a leaderboard, nil if members are ranked by a single score.
+func (app *App) getCompositeScore(leaderboardID string) *lservice.CompositeScore {
+	compositeScore, _ := config.MatchLeaderboardPattern(app.compositeScores, leaderboardID)
+	return compositeScore
+}
+
+// getScores converts the composite score values of a request.
+func getScores(values []float64) []int64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	scores := make([]int64, len(values))
+	for i, value := range values {
+		scores[i] = int64(value)
+	}
+	return scores
+}
+
+// newScoresResponse converts composite score values into a response.
+func newScoresResponse(scores []int64) []float64 {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	values := make([]float64, len(scores))
+	for i, score := range scores {
+		values[i] = float64(score)
+	}
+	return values
+}
@@ -0,0 +1,111 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Idempotent Writes", func() {
+	var app *api.App
+	var leaderboardID string
+	var idempotencyKey string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = "testkey-" + uuid.NewV4().String()
+		idempotencyKey = uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should increment a score once per idempotency key", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score?idempotencyKey=%s", leaderboardID, idempotencyKey)
+		for i := 0; i < 2; i++ {
+			status, body := PatchJSON(app, url, map[string]interface{}{"increment": 10})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(Equal(float64(10)))
+		}
+
+		status, body := PatchJSON(app, fmt.Sprintf("/l/%s/members/member1/score?idempotencyKey=%s", leaderboardID, uuid.NewV4().String()),
+			map[string]interface{}{"increment": 10})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["score"]).To(Equal(float64(20)))
+	})
+
+	It("Should return the first response of bulk upserts repeated with the same idempotency key", func() {
+		url := fmt.Sprintf("/l/%s/scores?prevRank=true&idempotencyKey=%s", leaderboardID, idempotencyKey)
+		payload := map[string]interface{}{
+			"members": []map[string]interface{}{
+				{"publicID": "member1", "score": 100},
+				{"publicID": "member2", "score": 200},
+			},
+		}
+
+		status, first := PutJSON(app, url, payload)
+		Expect(status).To(Equal(http.StatusOK), first)
+
+		status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 300})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, repeated := PutJSON(app, url, payload)
+		Expect(status).To(Equal(http.StatusOK), repeated)
+		Expect(repeated).To(MatchJSON(first))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/members/member1", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["score"]).To(Equal(float64(300)))
+	})
+
+	It("Should rate a match once per idempotency key", func() {
+		leaderboardID = "testkey-elo-" + uuid.NewV4().String()
+		url := fmt.Sprintf("/l/%s/matches?idempotencyKey=%s", leaderboardID, idempotencyKey)
+		payload := map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "outcome": "loss"},
+				{"publicID": "member2", "outcome": "win"},
+			},
+		}
+
+		status, first := PostJSON(app, url, payload)
+		Expect(status).To(Equal(http.StatusOK), first)
+
+		status, repeated := PostJSON(app, url, payload)
+		Expect(status).To(Equal(http.StatusOK), repeated)
+		Expect(repeated).To(MatchJSON(first))
+
+		status, body := Get(app, fmt.Sprintf("/l/%s/members/member2/rating", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["matches"]).To(Equal(float64(1)))
+	})
+})
@@ -0,0 +1,103 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+// loadLadders validates the configured ladders, it must run after score types are loaded.
+func (app *App) loadLadders() error {
+	app.ladders = map[string]*lservice.Ladder{}
+
+	for pattern, ladderConfig := range app.ParsedConfig.Leaderboards.Ladders {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid ladder pattern %s: %w", pattern, err)
+		}
+		if ladderConfig.MaxChallengeDistance < 0 {
+			return fmt.Errorf("invalid ladder max challenge distance for %s: %d", pattern, ladderConfig.MaxChallengeDistance)
+		}
+		if encoding := app.scoreEncodingOf(pattern); encoding != "" {
+			return fmt.Errorf("invalid ladder for %s: positions are stored as scores, they can't have %s", pattern, encoding)
+		}
+
+		app.ladders[pattern] = &lservice.Ladder{MaxChallengeDistance: ladderConfig.MaxChallengeDistance}
+	}
+
+	return nil
+}
+
+// getLadder returns the ladder of a leaderboard, nil if it isn't a ladder.
+func (app *App) getLadder(leaderboardID string) *lservice.Ladder {
+	ladder, _ := config.MatchLeaderboardPattern(app.ladders, leaderboardID)
+	return ladder
+}
+
+func newLadderPositionResponse(member *lmodel.Member) *api.LadderPosition {
+	return &api.LadderPosition{
+		PublicID:     member.PublicID,
+		Score:        member.Score,
+		Rank:         int32(member.Rank),
+		PreviousRank: int32(member.PreviousRank),
+	}
+}
+
+// ReportChallengeResult is the handler responsible for reordering ladder positions by a challenge result.
+func (app *App) ReportChallengeResult(ctx context.Context, req *api.ReportChallengeResultRequest) (*api.ReportChallengeResultResponse, error) {
+	if req.Challenge == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "challenger and defender are required")
+	}
+
+	if err := app.verifyUnsignedSubmission(ctx); err != nil {
+		return nil, err
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "ReportChallengeResult"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("challenger", req.Challenge.ChallengerPublicId),
+		zap.String("defender", req.Challenge.DefenderPublicId),
+	)
+
+	var members []*lmodel.Member
+	ctx = lservice.ContextWithIdempotencyKey(ctx, req.IdempotencyKey)
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Reporting challenge result.")
+		members, err = app.Leaderboards.ReportChallengeResult(ctx, req.LeaderboardId, req.Challenge.ChallengerPublicId, req.Challenge.DefenderPublicId, req.Challenge.ChallengerWon)
+		if err != nil {
+			lg.Error("Report challenge result failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Report challenge result succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ReportChallengeResultResponse{
+		Success:    true,
+		Challenger: newLadderPositionResponse(members[0]),
+		Defender:   newLadderPositionResponse(members[1]),
+	}, nil
+}
@@ -0,0 +1,104 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Ladder Handler", func() {
+	var app *api.App
+	var leaderboardID string
+
+	challenge := func(challenger, defender string, challengerWon bool) (int, string) {
+		return PostJSON(app, fmt.Sprintf("/l/%s/challenges", leaderboardID), map[string]interface{}{
+			"challengerPublicId": challenger,
+			"defenderPublicId":   defender,
+			"challengerWon":      challengerWon,
+		})
+	}
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = "testkey-ladder-" + uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should move a winning challenger to the defender position and shift everyone in between down", func() {
+		for _, members := range [][]string{{"member2", "member1"}, {"member3", "member2"}} {
+			status, body := challenge(members[0], members[1], false)
+			Expect(status).To(Equal(http.StatusOK), body)
+		}
+
+		status, body := challenge("member3", "member1", true)
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		Expect(result["challenger"]).To(Equal(map[string]interface{}{
+			"publicID": "member3", "score": float64(1), "rank": float64(1), "previousRank": float64(3),
+		}))
+		Expect(result["defender"]).To(Equal(map[string]interface{}{
+			"publicID": "member1", "score": float64(2), "rank": float64(2), "previousRank": float64(1),
+		}))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/top/1", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		members := result["members"].([]interface{})
+		Expect(members).To(HaveLen(3))
+		for i, publicID := range []string{"member3", "member1", "member2"} {
+			Expect(members[i].(map[string]interface{})["publicID"]).To(Equal(publicID))
+			Expect(members[i].(map[string]interface{})["rank"]).To(Equal(float64(i + 1)))
+		}
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/members/member2/rank", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["rank"]).To(Equal(float64(3)))
+	})
+
+	It("Should return 400 if defender is out of challenge range", func() {
+		for _, members := range [][]string{{"member2", "member1"}, {"member3", "member2"}, {"member4", "member3"}} {
+			status, body := challenge(members[0], members[1], false)
+			Expect(status).To(Equal(http.StatusOK), body)
+		}
+
+		status, body := challenge("member4", "member1", true)
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should return 400 if scores are written to a ladder", func() {
+		status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should return 400 if leaderboard isn't a ladder", func() {
+		leaderboardID = "testkey-" + uuid.NewV4().String()
+
+		status, body := challenge("member2", "member1", true)
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+})
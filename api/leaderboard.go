@@ -51,26 +51,48 @@ func (app *App) BulkUpsertScores(ctx context.Context, req *api.BulkUpsertScoresR
 		return nil, err
 	}
 
+	updatePolicy, err := getUpdatePolicy(req.UpdatePolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	lg := app.Logger.With(
 		zap.String("handler", "BulkUpsertScores"),
 		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("updatePolicy", updatePolicy),
 	)
 
 	members := make([]*lmodel.Member, len(req.MemberScores.Members))
-
-	err := withSegment("Model", ctx, func() error {
-		lg.Debug("Setting member scores.")
-		for i, ms := range req.MemberScores.Members {
-			members[i] = &lmodel.Member{Score: int64(ms.Score), PublicID: ms.PublicID}
+	for i, ms := range req.MemberScores.Members {
+		members[i] = &lmodel.Member{
+			Score:        ms.Score,
+			Scores:       getScores(ms.Scores),
+			PublicID:     ms.PublicID,
+			Precondition: getPrecondition(ms.ExpectedScore, ms.IfAbsent, ms.IfPresent),
 		}
+	}
+
+	submission := &lmodel.SignedSubmission{
+		Operation:      service.SubmissionOperationSet,
+		Leaderboard:    req.LeaderboardId,
+		Members:        members,
+		UpdatePolicy:   req.UpdatePolicy,
+		ScoreTTL:       int64(req.ScoreTTL),
+		PrevRank:       req.PrevRank,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	err = app.verifySubmission(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := app.Leaderboards.SetMembersScore(ctx, req.LeaderboardId, members, req.PrevRank, getScoreTTL(req.ScoreTTL)); err != nil {
+	ctx = service.ContextWithIdempotencyKey(ctx, req.IdempotencyKey)
+	err = withSegment("Model", ctx, func() error {
+		lg.Debug("Setting member scores.")
+		if err := app.Leaderboards.SetMembersScore(ctx, req.LeaderboardId, members, req.PrevRank, getScoreTTL(req.ScoreTTL), updatePolicy); err != nil {
 			lg.Error("Setting member scores failed.", zap.Error(err))
 			app.AddError()
-			//TODO: Turn all these LeaderboardExpiredError verifications into a middleware
-			if _, ok := err.(*service.LeaderboardExpiredError); ok {
-				return status.Errorf(codes.InvalidArgument, err.Error())
-			}
+			app.releaseSubmission(ctx, submission)
 			return err
 		}
 		lg.Debug("Setting member scores succeeded.")
@@ -85,10 +107,13 @@ func (app *App) BulkUpsertScores(ctx context.Context, req *api.BulkUpsertScoresR
 	for i, m := range members {
 		responses[i] = &api.BulkUpsertScoresResponse_Member{
 			PublicID:     m.PublicID,
-			Score:        float64(m.Score),
+			Score:        m.Score,
+			Scores:       newScoresResponse(m.Scores),
 			Rank:         int32(m.Rank),
 			PreviousRank: int32(m.PreviousRank),
 			ExpireAt:     int32(m.ExpireAt),
+			ScoreChanged: m.ScoreChanged,
+			Quarantined:  m.Quarantined,
 		}
 	}
 
@@ -105,27 +130,48 @@ func getScoreTTL(scoreTTL int32) string {
 
 // UpsertScore is the handler responsible for creating or updating the member score.
 func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*api.UpsertScoreResponse, error) {
+	updatePolicy, err := getUpdatePolicy(req.UpdatePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	precondition := getPrecondition(req.ScoreChange.ExpectedScore, req.ScoreChange.IfAbsent, req.ScoreChange.IfPresent)
+	submission := &lmodel.SignedSubmission{
+		Operation:   service.SubmissionOperationSet,
+		Leaderboard: req.LeaderboardId,
+		Members: []*lmodel.Member{
+			{PublicID: req.MemberPublicId, Score: req.ScoreChange.Score, Scores: getScores(req.ScoreChange.Scores), Precondition: precondition},
+		},
+		UpdatePolicy:   req.UpdatePolicy,
+		ScoreTTL:       int64(req.ScoreTTL),
+		PrevRank:       req.PrevRank,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	err = app.verifySubmission(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
+
 	lg := app.Logger.With(
 		zap.String("handler", "UpsertScore"),
 		zap.String("leaderboard", req.LeaderboardId),
 		zap.String("memberPublicID", req.MemberPublicId),
+		zap.String("updatePolicy", updatePolicy),
 	)
 
 	var member *lmodel.Member
-	err := withSegment("Model", ctx, func() error {
-		lg.Debug("Setting member score.", zap.Int64("score", int64(req.ScoreChange.Score)))
+	ctx = service.ContextWithIdempotencyKey(ctx, req.IdempotencyKey)
+	err = withSegment("Model", ctx, func() error {
+		lg.Debug("Setting member score.", zap.Float64("score", req.ScoreChange.Score))
 
 		var err error
-		member, err = app.Leaderboards.SetMemberScore(
-			ctx, req.LeaderboardId, req.MemberPublicId, int64(req.ScoreChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL))
+		member, err = app.setMemberScore(ctx, req.LeaderboardId, req.MemberPublicId, req.ScoreChange.Score,
+			req.ScoreChange.Scores, precondition, req.PrevRank, getScoreTTL(req.ScoreTTL), updatePolicy)
 
 		if err != nil {
 			lg.Error("Setting member score failed.", zap.Error(err))
 			app.AddError()
-			if _, ok := err.(*service.LeaderboardExpiredError); ok {
-				return status.Errorf(codes.InvalidArgument, err.Error())
-			}
-
+			app.releaseSubmission(ctx, submission)
 			return err
 		}
 		lg.Debug("Setting member score succeeded.")
@@ -139,19 +185,59 @@ func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*
 	return &api.UpsertScoreResponse{
 		Success:      true,
 		PublicID:     member.PublicID,
-		Score:        float64(member.Score),
+		Score:        member.Score,
+		Scores:       newScoresResponse(member.Scores),
 		Rank:         int32(member.Rank),
 		PreviousRank: int32(member.PreviousRank),
 		ExpireAt:     int32(member.ExpireAt),
+		ScoreChanged: member.ScoreChanged,
+		Quarantined:  member.Quarantined,
 	}, nil
 }
 
+// setMemberScore writes the member score, or the composite score values if any was sent, if precondition holds.
+func (app *App) setMemberScore(
+	ctx context.Context, leaderboardID, memberPublicID string, score float64, scores []float64, precondition *lmodel.Precondition,
+	prevRank bool, scoreTTL, updatePolicy string,
+) (*lmodel.Member, error) {
+	if len(scores) == 0 && precondition == nil {
+		return app.Leaderboards.SetMemberScore(ctx, leaderboardID, memberPublicID, score, prevRank, scoreTTL, updatePolicy)
+	}
+
+	members := []*lmodel.Member{{PublicID: memberPublicID, Score: score, Scores: getScores(scores), Precondition: precondition}}
+	if err := app.Leaderboards.SetMembersScore(ctx, leaderboardID, members, prevRank, scoreTTL, updatePolicy); err != nil {
+		return nil, err
+	}
+	return members[0], nil
+}
+
+// getPrecondition returns the precondition of a score write, nil if none was sent.
+func getPrecondition(expectedScore *float64, ifAbsent, ifPresent bool) *lmodel.Precondition {
+	if expectedScore == nil && !ifAbsent && !ifPresent {
+		return nil
+	}
+
+	return &lmodel.Precondition{ExpectedScore: expectedScore, IfAbsent: ifAbsent, IfPresent: ifPresent}
+}
+
 // IncrementScore is the handler responsible for incrementing the member score.
 func (app *App) IncrementScore(ctx context.Context, req *api.IncrementScoreRequest) (*api.IncrementScoreResponse, error) {
 	if req.Body.Increment == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "increment is required")
 	}
 
+	submission := &lmodel.SignedSubmission{
+		Operation:      service.SubmissionOperationIncrement,
+		Leaderboard:    req.LeaderboardId,
+		Members:        []*lmodel.Member{{PublicID: req.MemberPublicId, Score: req.Body.Increment}},
+		ScoreTTL:       int64(req.ScoreTTL),
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	err := app.verifySubmission(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
+
 	lg := app.Logger.With(
 		zap.String("handler", "IncrementScore"),
 		zap.String("leaderboard", req.LeaderboardId),
@@ -159,19 +245,16 @@ func (app *App) IncrementScore(ctx context.Context, req *api.IncrementScoreReque
 	)
 
 	var member *lmodel.Member
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
-		lg.Debug("Incrementing member score.", zap.Int64("increment", int64(req.Body.Increment)))
-		member, err = app.Leaderboards.IncrementMemberScore(context.Background(), req.LeaderboardId, req.MemberPublicId,
-			int(req.Body.Increment), getScoreTTL(req.ScoreTTL))
+		lg.Debug("Incrementing member score.", zap.Float64("increment", req.Body.Increment))
+		member, err = app.Leaderboards.IncrementMemberScore(service.ContextWithIdempotencyKey(context.Background(), req.IdempotencyKey),
+			req.LeaderboardId, req.MemberPublicId, req.Body.Increment, getScoreTTL(req.ScoreTTL))
 
 		if err != nil {
 			lg.Error("Member score increment failed.", zap.Error(err))
 			app.AddError()
-			if _, ok := err.(*service.LeaderboardExpiredError); ok {
-				return status.Errorf(codes.InvalidArgument, err.Error())
-			}
-
+			app.releaseSubmission(ctx, submission)
 			return err
 		}
 		lg.Debug("Member score increment succeeded.")
@@ -184,7 +267,7 @@ func (app *App) IncrementScore(ctx context.Context, req *api.IncrementScoreReque
 	return &api.IncrementScoreResponse{
 		Success:      true,
 		PublicID:     member.PublicID,
-		Score:        float64(member.Score),
+		Score:        member.Score,
 		Rank:         int32(member.Rank),
 		PreviousRank: int32(member.PreviousRank),
 		ExpireAt:     int32(member.ExpireAt),
@@ -256,9 +339,10 @@ func (app *App) RemoveMembers(ctx context.Context, req *api.RemoveMembersRequest
 	return &api.RemoveMembersResponse{Success: true}, nil
 }
 
+// getOrder returns order if it's valid, otherwise an empty one so the leaderboard default order is used.
 func getOrder(order string) string {
-	if order == "" || (order != "asc" && order != "desc") {
-		return "desc"
+	if order != "asc" && order != "desc" {
+		return ""
 	}
 	return order
 }
@@ -273,12 +357,17 @@ func (app *App) GetMember(ctx context.Context, req *api.GetMemberRequest) (*api.
 
 	order := getOrder(req.Order)
 
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	var member *lmodel.Member
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Getting member.")
 		//TODO: Add a NotFound error on the library
-		member, err = app.Leaderboards.GetMember(ctx, req.LeaderboardId, req.MemberPublicId, order, req.ScoreTTL)
+		member, err = app.Leaderboards.GetMember(ctx, req.LeaderboardId, req.MemberPublicId, order, req.ScoreTTL, rankMode)
 		switch {
 		case err != nil && strings.HasPrefix(err.Error(), notFoundError):
 			lg.Debug("Member not found.", zap.Error(err))
@@ -299,7 +388,8 @@ func (app *App) GetMember(ctx context.Context, req *api.GetMemberRequest) (*api.
 	return &api.GetMemberResponse{
 		Success:      true,
 		PublicID:     member.PublicID,
-		Score:        float64(member.Score),
+		Score:        member.Score,
+		Scores:       newScoresResponse(member.Scores),
 		Rank:         int32(member.Rank),
 		PreviousRank: int32(member.PreviousRank),
 		ExpireAt:     int32(member.ExpireAt),
@@ -316,11 +406,16 @@ func (app *App) GetRank(ctx context.Context, req *api.GetRankRequest) (*api.GetR
 
 	order := getOrder(req.Order)
 
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	var rank int
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Getting rank.")
-		rank, err = app.Leaderboards.GetRank(ctx, req.LeaderboardId, req.MemberPublicId, order)
+		rank, err = app.Leaderboards.GetRank(ctx, req.LeaderboardId, req.MemberPublicId, order, rankMode)
 
 		if err != nil && strings.HasPrefix(err.Error(), notFoundError) {
 			lg.Debug("Member not found.", zap.Error(err))
@@ -365,7 +460,12 @@ func (app *App) GetRankMultiLeaderboards(ctx context.Context, req *api.GetRankMu
 	err := withSegment("Model", ctx, func() error {
 		for i, leaderboardID := range leaderboardIDs {
 			lg.Debug("Getting member rank on leaderboard.", zap.String("leaderboard", leaderboardID))
-			member, err := app.Leaderboards.GetMember(ctx, leaderboardID, req.MemberPublicId, order, req.ScoreTTL)
+			rankMode, err := app.getRankMode(leaderboardID, "")
+			if err != nil {
+				return err
+			}
+
+			member, err := app.Leaderboards.GetMember(ctx, leaderboardID, req.MemberPublicId, order, req.ScoreTTL, rankMode)
 			if err != nil && strings.HasPrefix(err.Error(), notFoundError) {
 				lg.Debug("Member not found.", zap.Error(err))
 				app.AddError()
@@ -379,7 +479,8 @@ func (app *App) GetRankMultiLeaderboards(ctx context.Context, req *api.GetRankMu
 			serializedScores[i] = &api.GetRankMultiLeaderboardsResponse_Member{
 				LeaderboardID: leaderboardID,
 				Rank:          int32(member.Rank),
-				Score:         float64(member.Score),
+				Score:         member.Score,
+				Scores:        newScoresResponse(member.Scores),
 				ExpireAt:      int32(member.ExpireAt),
 			}
 		}
@@ -405,7 +506,7 @@ func (app *App) GetAroundMember(ctx context.Context, req *api.GetAroundMemberReq
 
 	order := getOrder(req.Order)
 
-	pageSize := getPageSize(int(req.PageSize))
+	pageSize := int(req.PageSize)
 	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
 		msg := fmt.Sprintf(
 			"Max pageSize allowed: %d. pageSize requested: %d",
@@ -415,12 +516,17 @@ func (app *App) GetAroundMember(ctx context.Context, req *api.GetAroundMemberReq
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	var members []*lmodel.Member
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Getting members around player.")
 		members, err = app.Leaderboards.GetAroundMe(ctx, req.LeaderboardId, pageSize, req.MemberPublicId, order,
-			req.GetLastIfNotFound)
+			req.GetLastIfNotFound, rankMode)
 		if err != nil && strings.HasPrefix(err.Error(), notFoundError) {
 			lg.Debug("Member not found.", zap.Error(err))
 			app.AddError()
@@ -453,14 +559,6 @@ func (app *App) GetAroundMember(ctx context.Context, req *api.GetAroundMemberReq
 	}, nil
 }
 
-func getPageSize(pageSize int) int {
-	if pageSize == 0 {
-		pageSize = defaultPageSize
-	}
-
-	return pageSize
-}
-
 // GetAroundScore retrieves a list of member scores and ranks centered req a given score.
 func (app *App) GetAroundScore(ctx context.Context, req *api.GetAroundScoreRequest) (*api.GetAroundScoreResponse, error) {
 	lg := app.Logger.With(
@@ -470,7 +568,7 @@ func (app *App) GetAroundScore(ctx context.Context, req *api.GetAroundScoreReque
 
 	order := getOrder(req.Order)
 
-	pageSize := getPageSize(int(req.PageSize))
+	pageSize := int(req.PageSize)
 	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
 		msg := fmt.Sprintf(
 			"Max pageSize allowed: %d. pageSize requested: %d",
@@ -483,8 +581,8 @@ func (app *App) GetAroundScore(ctx context.Context, req *api.GetAroundScoreReque
 	var members []*lmodel.Member
 	err := withSegment("Model", ctx, func() error {
 		var err error
-		lg.Debug("Getting players around score.", zap.Int64("score", int64(req.Score)))
-		members, err = app.Leaderboards.GetAroundScore(ctx, req.LeaderboardId, pageSize, int64(req.Score), order)
+		lg.Debug("Getting players around score.", zap.Float64("score", req.Score))
+		members, err = app.Leaderboards.GetAroundScore(ctx, req.LeaderboardId, pageSize, req.Score, order)
 		if err != nil && strings.HasPrefix(err.Error(), notFoundError) {
 			lg.Debug("Member not found.", zap.Error(err))
 			app.AddError()
@@ -558,7 +656,7 @@ func (app *App) GetTopMembers(ctx context.Context, req *api.GetTopMembersRequest
 
 	order := getOrder(req.Order)
 
-	pageSize := getPageSize(int(req.PageSize))
+	pageSize := int(req.PageSize)
 	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
 		msg := fmt.Sprintf(
 			"Max pageSize allowed: %d. pageSize requested: %d",
@@ -568,7 +666,26 @@ func (app *App) GetTopMembers(ctx context.Context, req *api.GetTopMembersRequest
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 
-	members, err := app.Leaderboards.GetLeaders(ctx, req.LeaderboardId, pageSize, pageNumber, order)
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardID := req.LeaderboardId
+	if req.MemberPublicId != "" {
+		leaderboardID, err = app.Leaderboards.GetMemberLeaderboard(ctx, req.LeaderboardId, req.MemberPublicId)
+		if err != nil && strings.HasPrefix(err.Error(), notFoundError) {
+			lg.Debug("Member not found.", zap.Error(err))
+			app.AddError()
+			return nil, status.Errorf(codes.NotFound, "Member not found.")
+		} else if err != nil {
+			lg.Error("Getting member leaderboard failed.", zap.Error(err))
+			app.AddError()
+			return nil, err
+		}
+	}
+
+	members, err := app.Leaderboards.GetLeaders(ctx, leaderboardID, pageSize, pageNumber, order, rankMode)
 
 	if err != nil {
 		lg.Error("Getting top members failed.", zap.Error(err))
@@ -606,20 +723,20 @@ func (app *App) GetTopPercentage(ctx context.Context, req *api.GetTopPercentageR
 
 	order := getOrder(req.Order)
 
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	var members []*lmodel.Member
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Getting top percentage.", zap.Int("percentage", int(req.Percentage)))
 		members, err = app.Leaderboards.GetTopPercentage(ctx, req.LeaderboardId, defaultPageSize,
-			int(req.Percentage), app.Config.GetInt("api.maxReturnedMembers"), order)
+			int(req.Percentage), app.Config.GetInt("api.maxReturnedMembers"), order, rankMode)
 
 		if err != nil {
 			lg.Error("Getting top percentage failed.", zap.Error(err))
-			if _, ok := err.(*service.PercentageError); ok {
-				app.AddError()
-				return status.Errorf(codes.InvalidArgument, err.Error())
-			}
-
 			app.AddError()
 			return err
 		}
@@ -653,7 +770,8 @@ func newGetMembersResponseList(members []*lmodel.Member) []*api.GetMembersRespon
 	for i, m := range members {
 		list[i] = &api.GetMembersResponse_Member{
 			PublicID: m.PublicID,
-			Score:    float64(m.Score),
+			Score:    m.Score,
+			Scores:   newScoresResponse(m.Scores),
 			Rank:     int32(m.Rank),
 			ExpireAt: int32(m.ExpireAt),
 			Position: int32(i),
@@ -679,11 +797,16 @@ func (app *App) GetMembers(ctx context.Context, req *api.GetMembersRequest) (*ap
 
 	memberIDs := strings.Split(req.Ids, ",")
 
+	rankMode, err := app.getRankMode(req.LeaderboardId, req.RankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	var members []*lmodel.Member
-	err := withSegment("Model", ctx, func() error {
+	err = withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Getting members.", zap.String("ids", req.Ids))
-		members, err = app.Leaderboards.GetMembers(ctx, req.LeaderboardId, memberIDs, order, req.ScoreTTL)
+		members, err = app.Leaderboards.GetMembers(ctx, req.LeaderboardId, memberIDs, order, req.ScoreTTL, rankMode)
 
 		if err != nil {
 			lg.Error("Getting members failed.", zap.Error(err))
@@ -734,7 +857,8 @@ func newMemberRankResponseList(members []*lmodel.Member) []*api.Member {
 	for i, m := range members {
 		list[i] = &api.Member{
 			PublicID: m.PublicID,
-			Score:    float64(m.Score),
+			Score:    m.Score,
+			Scores:   newScoresResponse(m.Scores),
 			Rank:     int32(m.Rank),
 			Metadata: m.Metadata,
 		}
@@ -748,21 +872,32 @@ func (app *App) UpsertScoreMultiLeaderboards(ctx context.Context, req *api.Upser
 		return nil, status.Error(codes.InvalidArgument, "leaderboards is required")
 	}
 
+	if err := app.verifyUnsignedSubmission(ctx); err != nil {
+		return nil, err
+	}
+
 	lg := app.Logger.With(
 		zap.String("handler", "UpsertScoreAllLeaderboards"),
 		zap.String("memberPublicID", req.MemberPublicId),
 	)
 
+	updatePolicy, err := getUpdatePolicy(req.UpdatePolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	serializedScores := make([]*api.UpsertScoreMultiLeaderboardsResponse_Member, len(req.ScoreMultiChange.Leaderboards))
 
-	err := withSegment("Model", ctx, func() error {
+	ctx = service.ContextWithIdempotencyKey(ctx, req.IdempotencyKey)
+	err = withSegment("Model", ctx, func() error {
 		for i, leaderboardID := range req.ScoreMultiChange.Leaderboards {
 			lg.Debug("Updating score.",
 				zap.String("leaderboardID", leaderboardID),
-				zap.Int64("score", int64(req.ScoreMultiChange.Score)))
+				zap.Float64("score", req.ScoreMultiChange.Score),
+				zap.String("updatePolicy", updatePolicy))
 
-			member, err := app.Leaderboards.SetMemberScore(ctx, leaderboardID, req.MemberPublicId,
-				int64(req.ScoreMultiChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL))
+			member, err := app.setMemberScore(ctx, leaderboardID, req.MemberPublicId,
+				req.ScoreMultiChange.Score, req.ScoreMultiChange.Scores, nil, req.PrevRank, getScoreTTL(req.ScoreTTL), updatePolicy)
 
 			if err != nil {
 				lg.Error("Update score failed.", zap.Error(err))
@@ -771,11 +906,14 @@ func (app *App) UpsertScoreMultiLeaderboards(ctx context.Context, req *api.Upser
 			}
 			serializedScore := &api.UpsertScoreMultiLeaderboardsResponse_Member{
 				PublicID:      member.PublicID,
-				Score:         float64(member.Score),
+				Score:         member.Score,
+				Scores:        newScoresResponse(member.Scores),
 				Rank:          int32(member.Rank),
 				PreviousRank:  int32(member.PreviousRank),
 				ExpireAt:      int32(member.ExpireAt),
 				LeaderboardID: leaderboardID,
+				ScoreChanged:  member.ScoreChanged,
+				Quarantined:   member.Quarantined,
 			}
 			serializedScores[i] = serializedScore
 		}
@@ -0,0 +1,183 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+func newLeaderboardArchiveResponse(archive *lmodel.LeaderboardArchive) *api.LeaderboardArchive {
+	response := &api.LeaderboardArchive{
+		Id:           archive.ID,
+		SeasonEnd:    int32(archive.SeasonEnd),
+		ArchivedAt:   int32(archive.ArchivedAt),
+		TotalMembers: int32(archive.TotalMembers),
+		RewardOrder:  archive.RewardOrder,
+	}
+	for _, bracket := range archive.RewardBrackets {
+		response.RewardBrackets = append(response.RewardBrackets, &api.RewardBracket{
+			Name:    bracket.Name,
+			MinRank: int32(bracket.MinRank),
+			MaxRank: int32(bracket.MaxRank),
+		})
+	}
+	return response
+}
+
+// GetLeaderboardArchive is the handler responsible for retrieving when a leaderboard season was archived.
+func (app *App) GetLeaderboardArchive(ctx context.Context, req *api.GetLeaderboardArchiveRequest) (*api.GetLeaderboardArchiveResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetLeaderboardArchive"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var archive *lmodel.LeaderboardArchive
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting leaderboard archive.")
+		archive, err = app.Leaderboards.GetLeaderboardArchive(ctx, req.LeaderboardId)
+		if err != nil {
+			lg.Error("Get leaderboard archive failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Get leaderboard archive succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetLeaderboardArchiveResponse{Success: true, Archive: newLeaderboardArchiveResponse(archive)}, nil
+}
+
+// GetArchivedTopMembers retrieves one page of the final standings of a leaderboard season.
+func (app *App) GetArchivedTopMembers(ctx context.Context, req *api.GetArchivedTopMembersRequest) (*api.GetArchivedTopMembersResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetArchivedTopMembers"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	pageNumber := int(math.Max(float64(req.PageNumber), 1))
+
+	order := getOrder(req.Order)
+
+	pageSize := int(req.PageSize)
+	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			pageSize,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	members, err := app.Leaderboards.GetArchivedLeaders(ctx, req.LeaderboardId, pageSize, pageNumber, order)
+	if err != nil {
+		lg.Error("Getting archived top members failed.", zap.Error(err))
+		app.AddError()
+		return nil, err
+	}
+
+	tenantID, ok := tryGetTenantIDFromHeader(ctx)
+	if ok {
+		members, err = app.Enricher.Enrich(ctx, tenantID, req.LeaderboardId, members)
+		if err != nil {
+			lg.Error("Enriching members failed.", zap.Error(err))
+			app.AddError()
+			return nil, status.Errorf(codes.Internal, "Unable to enrich members")
+		}
+	}
+
+	return &api.GetArchivedTopMembersResponse{
+		Success: true,
+		Members: newMemberRankResponseList(members),
+	}, nil
+}
+
+// GetArchivedMember is the handler responsible for retrieving a member final score and rank in a leaderboard season.
+func (app *App) GetArchivedMember(ctx context.Context, req *api.GetArchivedMemberRequest) (*api.GetArchivedMemberResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetArchivedMember"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	order := getOrder(req.Order)
+
+	var member *lmodel.Member
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting archived member.")
+		member, err = app.Leaderboards.GetArchivedMember(ctx, req.LeaderboardId, req.MemberPublicId, order)
+		if err != nil {
+			lg.Debug("Get archived member failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting archived member succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetArchivedMemberResponse{
+		Success:  true,
+		PublicID: member.PublicID,
+		Score:    member.Score,
+		Scores:   newScoresResponse(member.Scores),
+		Rank:     int32(member.Rank),
+	}, nil
+}
+
+// GetArchivedMemberReward is the handler responsible for retrieving the reward bracket a member was assigned in a leaderboard season.
+func (app *App) GetArchivedMemberReward(ctx context.Context, req *api.GetArchivedMemberRewardRequest) (*api.GetArchivedMemberRewardResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetArchivedMemberReward"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	var member *lmodel.Member
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting archived member reward.")
+		member, err = app.Leaderboards.GetArchivedMemberReward(ctx, req.LeaderboardId, req.MemberPublicId)
+		if err != nil {
+			lg.Debug("Get archived member reward failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting archived member reward succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetArchivedMemberRewardResponse{
+		Success:       true,
+		PublicID:      member.PublicID,
+		Score:         member.Score,
+		Scores:        newScoresResponse(member.Scores),
+		Rank:          int32(member.Rank),
+		RewardBracket: member.RewardBracket,
+	}, nil
+}
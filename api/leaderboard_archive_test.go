@@ -0,0 +1,158 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/topfreegames/podium/api"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+	pb "github.com/topfreegames/podium/proto/podium/api/v1"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Leaderboard Archive Handler", func() {
+	var app *api.App
+	var redisDatabase *database.Redis
+	var leaderboardID string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		redisClient, err := GetTestingRedis(app)
+		Expect(err).NotTo(HaveOccurred())
+		redisDatabase = &database.Redis{Client: redisClient}
+
+		now := time.Now().UTC()
+		leaderboardID = fmt.Sprintf("testkey-archive-%s-from%dto%d", uuid.NewV4().String(), now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix())
+	})
+
+	AfterEach(func() {
+		redisDatabase.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+		redisDatabase.Del(context.Background(), redisDatabase.Keys.LeaderboardArchive(leaderboardID))
+		redisDatabase.ZRem(context.Background(), redisDatabase.Keys.SeasonRollovers(), leaderboardID)
+		redisDatabase.Del(context.Background(), redisDatabase.Keys.LeaderboardArchives())
+	})
+
+	archiveLeaderboard := func(options *database.ArchiveOptions) {
+		for i, memberID := range []string{"member1", "member2", "member3"} {
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/%s/score", leaderboardID, memberID), map[string]interface{}{"score": 100 - i})
+			Expect(status).To(Equal(http.StatusOK), body)
+		}
+
+		leaderboards, err := redisDatabase.GetLeaderboardsToArchive(NewEmptyCtx(), time.Now().Add(2*time.Hour), 1000)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(ContainElement(leaderboardID))
+
+		_, err = redisDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = redisDatabase.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("Should get archive, archived top members and archived member after leaderboard is removed", func() {
+		archiveLeaderboard(nil)
+
+		status, body := Get(app, fmt.Sprintf("/l/%s/archive", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		archive := result["archive"].(map[string]interface{})
+		Expect(archive["id"]).To(Equal(leaderboardID))
+		Expect(archive["totalMembers"]).To(BeEquivalentTo(3))
+		Expect(archive["seasonEnd"]).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 2))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/archive/top/2?pageSize=2", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		members := result["members"].([]interface{})
+		Expect(members).To(HaveLen(1))
+		Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member3"))
+		Expect(members[0].(map[string]interface{})["rank"]).To(BeEquivalentTo(3))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/archive/members/member1?order=asc", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["score"]).To(BeEquivalentTo(100))
+		Expect(result["rank"]).To(BeEquivalentTo(3))
+	})
+
+	It("Should fail with 404 if archived member wasn't in leaderboard", func() {
+		archiveLeaderboard(nil)
+
+		status, body := Get(app, fmt.Sprintf("/l/%s/archive/members/unknown", leaderboardID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("Should get archived member reward bracket", func() {
+		archiveLeaderboard(&database.ArchiveOptions{
+			RewardTiers: []rewards.Tier{{Name: "gold", MaxRank: 1}, {Name: "silver", MaxRank: 2}},
+			RewardOrder: "desc",
+		})
+
+		status, body := Get(app, fmt.Sprintf("/l/%s/archive", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		archive := result["archive"].(map[string]interface{})
+		Expect(archive["rewardOrder"]).To(Equal("desc"))
+		Expect(archive["rewardBrackets"]).To(HaveLen(2))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/archive/members/member2/reward", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["rank"]).To(BeEquivalentTo(2))
+		Expect(result["rewardBracket"]).To(Equal("silver"))
+
+		SetupGRPC(app, func(cli pb.PodiumClient) {
+			resp, err := cli.GetArchivedMemberReward(context.Background(), &pb.GetArchivedMemberRewardRequest{
+				LeaderboardId:  leaderboardID,
+				MemberPublicId: "member3",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Rank).To(BeEquivalentTo(3))
+			Expect(resp.RewardBracket).To(BeEmpty())
+		})
+	})
+
+	It("Should fail with 404 if leaderboard has no reward brackets", func() {
+		archiveLeaderboard(nil)
+
+		status, body := Get(app, fmt.Sprintf("/l/%s/archive/members/member1/reward", leaderboardID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("Should fail with 404 if leaderboard isn't archived", func() {
+		code, body := Get(app, fmt.Sprintf("/l/%s/archive", leaderboardID))
+		Expect(code).To(Equal(http.StatusNotFound), body)
+
+		SetupGRPC(app, func(cli pb.PodiumClient) {
+			_, err := cli.GetArchivedTopMembers(context.Background(), &pb.GetArchivedTopMembersRequest{
+				LeaderboardId: leaderboardID,
+				PageNumber:    1,
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
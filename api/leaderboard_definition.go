@@ -0,0 +1,212 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+func newLeaderboardDefinitionModel(leaderboardID string, definition *api.LeaderboardDefinition) *lmodel.LeaderboardDefinition {
+	if definition == nil {
+		return &lmodel.LeaderboardDefinition{ID: leaderboardID}
+	}
+
+	return &lmodel.LeaderboardDefinition{
+		ID:              leaderboardID,
+		DisplayName:     definition.DisplayName,
+		Order:           definition.Order,
+		UpdatePolicy:    definition.UpdatePolicy,
+		DefaultPageSize: int(definition.DefaultPageSize),
+		MaxPageSize:     int(definition.MaxPageSize),
+		StartTime:       int(definition.StartTime),
+		EndTime:         int(definition.EndTime),
+		Retention:       int(definition.Retention),
+	}
+}
+
+func newLeaderboardDefinitionResponse(definition *lmodel.LeaderboardDefinition) *api.LeaderboardDefinition {
+	return &api.LeaderboardDefinition{
+		Id:              definition.ID,
+		DisplayName:     definition.DisplayName,
+		Order:           definition.Order,
+		UpdatePolicy:    definition.UpdatePolicy,
+		DefaultPageSize: int32(definition.DefaultPageSize),
+		MaxPageSize:     int32(definition.MaxPageSize),
+		StartTime:       int32(definition.StartTime),
+		EndTime:         int32(definition.EndTime),
+		Retention:       int32(definition.Retention),
+		CreatedAt:       int32(definition.CreatedAt),
+		UpdatedAt:       int32(definition.UpdatedAt),
+	}
+}
+
+// validateLeaderboardDefinitionPageSizes checks a definition doesn't allow pages larger than api.maxReturnedMembers.
+func (app *App) validateLeaderboardDefinitionPageSizes(definition *lmodel.LeaderboardDefinition) error {
+	maxReturnedMembers := app.Config.GetInt("api.maxReturnedMembers")
+	if definition.DefaultPageSize > maxReturnedMembers || definition.MaxPageSize > maxReturnedMembers {
+		msg := fmt.Sprintf("Max pageSize allowed: %d. defaultPageSize: %d, maxPageSize: %d",
+			maxReturnedMembers, definition.DefaultPageSize, definition.MaxPageSize)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	return nil
+}
+
+// CreateLeaderboardDefinition is the handler responsible for registering a leaderboard.
+func (app *App) CreateLeaderboardDefinition(ctx context.Context, req *api.CreateLeaderboardDefinitionRequest) (*api.LeaderboardDefinitionResponse, error) {
+	definition := newLeaderboardDefinitionModel(req.GetDefinition().GetId(), req.Definition)
+	lg := app.Logger.With(
+		zap.String("handler", "CreateLeaderboardDefinition"),
+		zap.String("leaderboard", definition.ID),
+	)
+
+	if err := app.validateLeaderboardDefinitionPageSizes(definition); err != nil {
+		return nil, err
+	}
+
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Creating leaderboard definition.")
+		definition, err = app.Leaderboards.CreateLeaderboardDefinition(ctx, definition)
+		if err != nil {
+			lg.Error("Create leaderboard definition failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Create leaderboard definition succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardDefinitionResponse{Success: true, Definition: newLeaderboardDefinitionResponse(definition)}, nil
+}
+
+// GetLeaderboardDefinition is the handler responsible for retrieving a registered leaderboard.
+func (app *App) GetLeaderboardDefinition(ctx context.Context, req *api.GetLeaderboardDefinitionRequest) (*api.LeaderboardDefinitionResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetLeaderboardDefinition"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var definition *lmodel.LeaderboardDefinition
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting leaderboard definition.")
+		definition, err = app.Leaderboards.GetLeaderboardDefinition(ctx, req.LeaderboardId)
+		if err != nil {
+			lg.Error("Get leaderboard definition failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Get leaderboard definition succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardDefinitionResponse{Success: true, Definition: newLeaderboardDefinitionResponse(definition)}, nil
+}
+
+// ListLeaderboardDefinitions is the handler responsible for retrieving every registered leaderboard.
+func (app *App) ListLeaderboardDefinitions(ctx context.Context, req *api.ListLeaderboardDefinitionsRequest) (*api.ListLeaderboardDefinitionsResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "ListLeaderboardDefinitions"),
+	)
+
+	var definitions []*lmodel.LeaderboardDefinition
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Listing leaderboard definitions.")
+		definitions, err = app.Leaderboards.ListLeaderboardDefinitions(ctx)
+		if err != nil {
+			lg.Error("List leaderboard definitions failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("List leaderboard definitions succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*api.LeaderboardDefinition, len(definitions))
+	for i, definition := range definitions {
+		responses[i] = newLeaderboardDefinitionResponse(definition)
+	}
+
+	return &api.ListLeaderboardDefinitionsResponse{Success: true, Definitions: responses}, nil
+}
+
+// UpdateLeaderboardDefinition is the handler responsible for replacing a registered leaderboard definition.
+func (app *App) UpdateLeaderboardDefinition(ctx context.Context, req *api.UpdateLeaderboardDefinitionRequest) (*api.LeaderboardDefinitionResponse, error) {
+	definition := newLeaderboardDefinitionModel(req.LeaderboardId, req.Definition)
+	lg := app.Logger.With(
+		zap.String("handler", "UpdateLeaderboardDefinition"),
+		zap.String("leaderboard", definition.ID),
+	)
+
+	if err := app.validateLeaderboardDefinitionPageSizes(definition); err != nil {
+		return nil, err
+	}
+
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Updating leaderboard definition.")
+		definition, err = app.Leaderboards.UpdateLeaderboardDefinition(ctx, definition)
+		if err != nil {
+			lg.Error("Update leaderboard definition failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Update leaderboard definition succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardDefinitionResponse{Success: true, Definition: newLeaderboardDefinitionResponse(definition)}, nil
+}
+
+// RemoveLeaderboardDefinition is the handler responsible for unregistering a leaderboard.
+func (app *App) RemoveLeaderboardDefinition(ctx context.Context, req *api.RemoveLeaderboardDefinitionRequest) (*api.RemoveLeaderboardDefinitionResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "RemoveLeaderboardDefinition"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	err := withSegment("Model", ctx, func() error {
+		lg.Debug("Removing leaderboard definition.")
+		if err := app.Leaderboards.RemoveLeaderboardDefinition(ctx, req.LeaderboardId); err != nil {
+			lg.Error("Remove leaderboard definition failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Remove leaderboard definition succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RemoveLeaderboardDefinitionResponse{Success: true}, nil
+}
@@ -0,0 +1,189 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	pb "github.com/topfreegames/podium/proto/podium/api/v1"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Leaderboard Definition Handler", func() {
+	var app *api.App
+	var leaderboardID string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboardDefinition(NewEmptyCtx(), leaderboardID)
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should create, get, list, update and remove a leaderboard definition", func() {
+		status, body := PostJSON(app, "/leaderboards", map[string]interface{}{
+			"id":          leaderboardID,
+			"displayName": "Weekly ranking",
+			"order":       "asc",
+			"maxPageSize": 50,
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		definition := result["definition"].(map[string]interface{})
+		Expect(definition["id"]).To(Equal(leaderboardID))
+		Expect(definition["displayName"]).To(Equal("Weekly ranking"))
+		Expect(definition["createdAt"]).To(BeNumerically("~", time.Now().Unix(), 1))
+
+		status, body = Get(app, fmt.Sprintf("/leaderboards/%s", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["definition"].(map[string]interface{})["order"]).To(Equal("asc"))
+
+		status, body = Get(app, "/leaderboards")
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		ids := []interface{}{}
+		for _, listed := range result["definitions"].([]interface{}) {
+			ids = append(ids, listed.(map[string]interface{})["id"])
+		}
+		Expect(ids).To(ContainElement(leaderboardID))
+
+		status, body = PutJSON(app, fmt.Sprintf("/leaderboards/%s", leaderboardID), map[string]interface{}{
+			"order": "desc",
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		definition = result["definition"].(map[string]interface{})
+		Expect(definition["order"]).To(Equal("desc"))
+		Expect(definition["displayName"]).To(Equal(""))
+
+		status, body = Delete(app, fmt.Sprintf("/leaderboards/%s", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = Get(app, fmt.Sprintf("/leaderboards/%s", leaderboardID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("Should create a leaderboard definition (grpc)", func() {
+		SetupGRPC(app, func(cli pb.PodiumClient) {
+			resp, err := cli.CreateLeaderboardDefinition(context.Background(), &pb.CreateLeaderboardDefinitionRequest{
+				Definition: &pb.LeaderboardDefinition{Id: leaderboardID, UpdatePolicy: "best"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Success).To(BeTrue())
+			Expect(resp.Definition.UpdatePolicy).To(Equal("best"))
+		})
+	})
+
+	It("Should fail with 409 if leaderboard is already registered", func() {
+		status, body := PostJSON(app, "/leaderboards", map[string]interface{}{"id": leaderboardID})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PostJSON(app, "/leaderboards", map[string]interface{}{"id": leaderboardID})
+		Expect(status).To(Equal(http.StatusConflict), body)
+	})
+
+	It("Should fail with 400 if definition is invalid", func() {
+		status, body := PostJSON(app, "/leaderboards", map[string]interface{}{"id": leaderboardID, "order": "random"})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["reason"]).To(Equal("invalid leaderboard definition: invalid order: random"))
+
+		status, body = PostJSON(app, "/leaderboards", map[string]interface{}{
+			"id":          leaderboardID,
+			"maxPageSize": app.Config.GetInt("api.maxReturnedMembers") + 1,
+		})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should fail with 404 updating a leaderboard that isn't registered", func() {
+		status, body := PutJSON(app, fmt.Sprintf("/leaderboards/%s", leaderboardID), map[string]interface{}{"order": "asc"})
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	Describe("When leaderboard is registered", func() {
+		BeforeEach(func() {
+			status, body := PostJSON(app, "/leaderboards", map[string]interface{}{
+				"id":              leaderboardID,
+				"order":           "asc",
+				"updatePolicy":    "best",
+				"defaultPageSize": 2,
+				"maxPageSize":     3,
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			for member, score := range map[string]int{"member_a": 300, "member_b": 200, "member_c": 100} {
+				status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/%s/score", leaderboardID, member), map[string]interface{}{
+					"score": score,
+				})
+				Expect(status).To(Equal(http.StatusOK), body)
+			}
+		})
+
+		It("Should use leaderboard order and default page size if request doesn't set them", func() {
+			status, body := Get(app, fmt.Sprintf("/l/%s/top/1", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member_c"))
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/top/1?order=desc&pageSize=3", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["members"].([]interface{})[0].(map[string]interface{})["publicID"]).To(Equal("member_a"))
+		})
+
+		It("Should fail with 400 if page size is above leaderboard max page size", func() {
+			status, body := Get(app, fmt.Sprintf("/l/%s/top/1?pageSize=4", leaderboardID))
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(Equal("Max pageSize allowed: 3. pageSize requested: 4"))
+		})
+
+		It("Should use leaderboard update policy if request doesn't set one", func() {
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member_c/score", leaderboardID), map[string]interface{}{
+				"score": 500,
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(BeEquivalentTo(100))
+			Expect(result["rank"]).To(BeEquivalentTo(1))
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member_c/score?updatePolicy=last", leaderboardID), map[string]interface{}{
+				"score": 500,
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(BeEquivalentTo(500))
+			Expect(result["rank"]).To(BeEquivalentTo(3))
+		})
+	})
+})
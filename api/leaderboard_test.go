@@ -59,6 +59,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		redisClient.Del(context.Background(), "testkey3")
 		redisClient.Del(context.Background(), "testkey4")
 		redisClient.Del(context.Background(), "testkey5")
+		redisClient.Del(context.Background(), "testkey-tiebreak")
+		redisClient.Del(context.Background(), "testkey-composite")
+		redisClient.Del(context.Background(), "testkey-dense")
+		redisClient.Del(context.Background(), "testkey-float")
+		redisClient.Del(context.Background(), "testkey-decimal")
 	})
 
 	Describe("When leaderboard has expired", func() {
@@ -103,6 +108,11 @@ var _ = Describe("Leaderboard Handler", func() {
 					quarterYear,
 					lastQuarter,
 				),
+				fmt.Sprintf(
+					"testkey-season-from%sto%s",
+					time.Now().UTC().Add(-30*time.Minute).Format("200601021504"),
+					time.Now().UTC().Add(-5*time.Minute).Format("200601021504"),
+				),
 			}
 		)
 
@@ -150,6 +160,102 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 	})
 
+	Describe("When leaderboard season is out of window", func() {
+		var (
+			notStartedKey = fmt.Sprintf("testkey-from%sto%s",
+				time.Now().UTC().AddDate(0, 0, 1).Format("20060102"),
+				time.Now().UTC().AddDate(0, 0, 2).Format("20060102"),
+			)
+			closedKey = fmt.Sprintf("testkey-from%dto%d",
+				time.Now().UTC().Add(-3*time.Hour).Unix(),
+				time.Now().UTC().Add(-time.Hour).Unix(),
+			)
+		)
+
+		AfterEach(func() {
+			redisClient.Del(context.Background(), closedKey)
+		})
+
+		It("PUT upsert score", func() {
+			payload := map[string]interface{}{"score": int64(100)}
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/memberpublicid/score", notStartedKey), payload)
+			Expect(status).To(Equal(http.StatusBadRequest))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(ContainSubstring(fmt.Sprintf("season of leaderboard %s starts at", notStartedKey)))
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/memberpublicid/score", closedKey), payload)
+			Expect(status).To(Equal(http.StatusBadRequest))
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(ContainSubstring(fmt.Sprintf("season of leaderboard %s closed at", closedKey)))
+		})
+
+		It("PATCH increment score", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				_, err := cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  closedKey,
+					MemberPublicId: "memberpublicid",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 100},
+				})
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		It("GET member during retention", func() {
+			err := redisClient.ZAdd(context.Background(), closedKey, &redis.Member{Member: "memberpublicid", Score: 100})
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body := Get(app, fmt.Sprintf("/l/%s/members/memberpublicid", closedKey))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(BeEquivalentTo(100))
+		})
+	})
+
+	Describe("When leaderboard is a season family", func() {
+		var currentKey = func() string {
+			return "testkey-family-" + time.Now().UTC().Format("year2006month01day02")
+		}
+
+		AfterEach(func() {
+			redisClient.Del(context.Background(), currentKey())
+		})
+
+		It("Should write to and read from the current season", func() {
+			status, body := PutJSON(app, "/l/testkey-family/members/memberpublicid/score", map[string]interface{}{"score": int64(100)})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), currentKey(), "memberpublicid", "desc", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(BeEquivalentTo(100))
+
+			status, body = Get(app, "/l/testkey-family@current/members/memberpublicid")
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(BeEquivalentTo(100))
+		})
+
+		It("Should read from the previous season", func() {
+			status, body := PutJSON(app, "/l/testkey-family/members/memberpublicid/score", map[string]interface{}{"score": int64(100)})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, "/l/testkey-family@previous/members/memberpublicid")
+			Expect(status).To(Equal(http.StatusNotFound), body)
+		})
+
+		It("Should fail if season selector is invalid", func() {
+			status, body := Get(app, "/l/testkey-family@next/members/memberpublicid")
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(ContainSubstring("invalid season selector: testkey-family@next"))
+		})
+	})
+
 	Describe("Bulk Upsert Members Score", func() {
 		It("Should set correct members score in redis and respond with the correct values (http)", func() {
 			payload := map[string]interface{}{"members": []map[string]interface{}{
@@ -168,16 +274,16 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(payload["members"].([]map[string]interface{})[i]["publicID"].(string)))
 			}
 
-			member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false)
+			member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member1.Rank).To(Equal(1))
-			Expect(member1.Score).To(Equal(int64(150)))
+			Expect(member1.Score).To(Equal(float64(150)))
 			Expect(member1.PublicID).To(Equal("memberpublicid1"))
 
-			member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false)
+			member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member2.Rank).To(Equal(2))
-			Expect(member2.Score).To(Equal(int64(100)))
+			Expect(member2.Score).To(Equal(float64(100)))
 			Expect(member2.PublicID).To(Equal("memberpublicid2"))
 		})
 
@@ -203,16 +309,16 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(m.PublicID).To(Equal(req.MemberScores.Members[i].PublicID))
 				}
 
-				member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false)
+				member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member1.Rank).To(Equal(1))
-				Expect(member1.Score).To(Equal(int64(150)))
+				Expect(member1.Score).To(Equal(float64(150)))
 				Expect(member1.PublicID).To(Equal("memberpublicid1"))
 
-				member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false)
+				member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member2.Rank).To(Equal(2))
-				Expect(member2.Score).To(Equal(int64(100)))
+				Expect(member2.Score).To(Equal(float64(100)))
 				Expect(member2.PublicID).To(Equal("memberpublicid2"))
 			})
 		})
@@ -236,16 +342,16 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(payload["members"].([]map[string]interface{})[i]["publicID"].(string)))
 			}
 
-			member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false)
+			member1, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid1", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member1.Rank).To(Equal(1))
-			Expect(member1.Score).To(Equal(bigScore1))
+			Expect(member1.Score).To(Equal(float64(bigScore1)))
 			Expect(member1.PublicID).To(Equal("memberpublicid1"))
 
-			member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false)
+			member2, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member2.Rank).To(Equal(2))
-			Expect(member2.Score).To(Equal(bigScore2))
+			Expect(member2.Score).To(Equal(float64(bigScore2)))
 			Expect(member2.PublicID).To(Equal("memberpublicid2"))
 		})
 
@@ -270,10 +376,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(payload["members"].([]map[string]interface{})[i]["publicID"].(string)))
 				Expect(int(member["expireAt"].(float64))).To(BeNumerically("~", time.Now().Unix()+int64(ttl), 1))
 
-				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", true)
+				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", true, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(memb.Rank).To(Equal(i + 1))
-				Expect(memb.Score).To(Equal(payload["members"].([]map[string]interface{})[i]["score"].(int64)))
+				Expect(memb.Score).To(Equal(float64(payload["members"].([]map[string]interface{})[i]["score"].(int64))))
 				Expect(memb.PublicID).To(Equal(member["publicID"]))
 				Expect(memb.ExpireAt).To(BeNumerically("~", time.Now().Unix()+int64(ttl), 1))
 			}
@@ -321,14 +427,39 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(payload2["members"].([]map[string]interface{})[i]["publicID"].(string)))
 				Expect(int(member["previousRank"].(float64))).To(Equal(i + 2))
 
-				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(memb.Rank).To(Equal(i + 1))
-				Expect(memb.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(memb.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(memb.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
+		It("Should sum scores if update policy is sum (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				req := &pb.BulkUpsertScoresRequest{
+					LeaderboardId: testLeaderboardID,
+					MemberScores: &pb.BulkUpsertScoresRequest_MemberScores{
+						Members: []*pb.BulkUpsertScoresRequest_MemberScore{
+							{PublicID: "memberpublicid1", Score: 100},
+							{PublicID: "memberpublicid2", Score: 0},
+						},
+					},
+					UpdatePolicy: "sum",
+				}
+
+				_, err := cli.BulkUpsertScores(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				resp, err := cli.BulkUpsertScores(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Members[0].Score).To(Equal(float64(200)))
+				Expect(resp.Members[0].ScoreChanged).To(BeTrue())
+				Expect(resp.Members[1].Score).To(Equal(float64(0)))
+				Expect(resp.Members[1].ScoreChanged).To(BeFalse())
+			})
+		})
+
 		It("Should work when setting scores to 0", func() {
 			payload := map[string]interface{}{"members": []map[string]interface{}{
 				{"publicID": "memberpublicid1", "score": int64(0)},
@@ -344,13 +475,138 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int64(member["score"].(float64))).To(Equal(int64(0)))
 				Expect(member["publicID"]).To(Equal(payload["members"].([]map[string]interface{})[i]["publicID"].(string)))
 
-				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				memb, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(memb.Score).To(Equal(int64(0)))
+				Expect(memb.Score).To(Equal(float64(0)))
 				Expect(memb.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
+		It("Should rank whoever reached the score first higher if leaderboard has tie-break", func() {
+			for _, publicID := range []string{"membera", "memberb", "memberc"} {
+				score := int64(100)
+				if publicID == "memberc" {
+					score = 101
+				}
+				status, body := PutJSON(app, "/l/testkey-tiebreak/members/"+publicID+"/score", map[string]interface{}{"score": score})
+				Expect(status).To(Equal(http.StatusOK), body)
+				var result map[string]interface{}
+				json.Unmarshal([]byte(body), &result)
+				Expect(int64(result["score"].(float64))).To(Equal(score))
+				time.Sleep(5 * time.Millisecond)
+			}
+
+			status, body := Get(app, "/l/testkey-tiebreak/top/1")
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(3))
+			for i, expected := range []struct {
+				publicID string
+				score    int64
+			}{{"memberc", 101}, {"membera", 100}, {"memberb", 100}} {
+				member := members[i].(map[string]interface{})
+				Expect(member["publicID"]).To(Equal(expected.publicID))
+				Expect(int64(member["score"].(float64))).To(Equal(expected.score))
+				Expect(int(member["rank"].(float64))).To(Equal(i + 1))
+			}
+
+			status, body = Get(app, "/l/testkey-tiebreak/members/memberb")
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(int64(result["score"].(float64))).To(Equal(int64(100)))
+			Expect(int(result["rank"].(float64))).To(Equal(3))
+
+			status, body = Get(app, "/l/testkey-tiebreak/members/memberb/rank?rankMode=competition")
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("ties are broken by submission time"))
+		})
+
+		It("Should fail if score can't be stored with leaderboard tie-break", func() {
+			status, body := PutJSON(app, "/l/testkey-tiebreak/members/memberpublicid/score", map[string]interface{}{"score": int64(10000)})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("score 10000 out of range (-8192, 8191)"))
+		})
+
+		It("Should fail if an increment results in a score that can't be stored with leaderboard tie-break", func() {
+			status, body := PutJSON(app, "/l/testkey-tiebreak/members/memberoverflow/score", map[string]interface{}{"score": int64(8000)})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = PatchJSON(app, "/l/testkey-tiebreak/members/memberoverflow/score", map[string]interface{}{"increment": 500})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(Equal("score 8500 out of range (-8192, 8191)"))
+
+			status, body = Get(app, "/l/testkey-tiebreak/members/memberoverflow")
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(int64(result["score"].(float64))).To(Equal(int64(8000)))
+		})
+
+		It("Should rank by every composite score criterion and return scores list", func() {
+			for publicID, scores := range map[string][]int64{
+				"membera": {3, 120, 5},
+				"memberb": {3, 90, 1},
+				"memberc": {2, 10, 200},
+			} {
+				status, body := PutJSON(app, "/l/testkey-composite/members/"+publicID+"/score", map[string]interface{}{"scores": scores})
+				Expect(status).To(Equal(http.StatusOK), body)
+				var result map[string]interface{}
+				json.Unmarshal([]byte(body), &result)
+				Expect(result["scores"]).To(HaveLen(3))
+			}
+
+			status, body := Get(app, "/l/testkey-composite/top/1")
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(3))
+			for i, expected := range []struct {
+				publicID string
+				scores   []interface{}
+			}{
+				{"memberb", []interface{}{float64(3), float64(90), float64(1)}},
+				{"membera", []interface{}{float64(3), float64(120), float64(5)}},
+				{"memberc", []interface{}{float64(2), float64(10), float64(200)}},
+			} {
+				member := members[i].(map[string]interface{})
+				Expect(member["publicID"]).To(Equal(expected.publicID))
+				Expect(member["scores"]).To(Equal(expected.scores))
+			}
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				resp, err := cli.GetMember(context.Background(), &pb.GetMemberRequest{LeaderboardId: "testkey-composite", MemberPublicId: "memberc"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Rank).To(Equal(int32(3)))
+				Expect(resp.Scores).To(Equal([]float64{2, 10, 200}))
+			})
+		})
+
+		It("Should fail if scores don't fit leaderboard composite score", func() {
+			status, body := PutJSON(app, "/l/testkey-composite/members/memberpublicid/score", map[string]interface{}{"scores": []int64{16, 0, 0}})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("invalid scores: stars 16 out of range (0, 15)"))
+
+			status, body = PutJSON(app, "/l/testkey-composite/members/memberpublicid/score", map[string]interface{}{"score": 100})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(Equal("invalid scores: expected 3 scores, got 0"))
+
+			status, body = PutJSON(app, "/l/testkey/members/memberpublicid/score", map[string]interface{}{"scores": []int64{1, 2}})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(Equal("invalid scores: leaderboard has no composite score"))
+		})
+
 		It("Should fail if wrong type for score", func() {
 			payload := map[string]interface{}{"members": []map[string]interface{}{
 				{"publicID": "memberpublicid1", "score": "hundred"},
@@ -427,10 +683,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(payload["score"]))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
@@ -450,10 +706,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(resp.Score).To(Equal(req.ScoreChange.Score))
 				Expect(resp.Rank).To(Equal(int32(1)))
 
-				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member.Rank).To(Equal(1))
-				Expect(member.Score).To(Equal(int64(100)))
+				Expect(member.Score).To(Equal(float64(100)))
 				Expect(member.PublicID).To(Equal("memberpublicid"))
 			})
 		})
@@ -472,10 +728,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(payload["score"]))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(bigScore))
+			Expect(member.Score).To(Equal(float64(bigScore)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
@@ -496,10 +752,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 			Expect(int(result["expireAt"].(float64))).To(BeNumerically("~", time.Now().Unix()+int64(ttl), 1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", true)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", true, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Unix()+int64(ttl), 1))
 
@@ -541,10 +797,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int(result["rank"].(float64))).To(Equal(2))
 			Expect(int(result["previousRank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(2))
-			Expect(member.Score).To(Equal(int64(10)))
+			Expect(member.Score).To(Equal(float64(10)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
@@ -561,13 +817,72 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(payload["score"]))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(0)))
+			Expect(member.Score).To(Equal(float64(0)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
+		It("Should keep best score if update policy is best", func() {
+			status, body := PutJSON(app, "/l/testkey/members/memberpublicid/score", map[string]interface{}{"score": int64(100)})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = PutJSON(app, "/l/testkey/members/memberpublicid/score?updatePolicy=best", map[string]interface{}{"score": int64(50)})
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(int64(result["score"].(float64))).To(Equal(int64(100)))
+			Expect(result["scoreChanged"]).To(BeFalse())
+
+			status, body = PutJSON(app, "/l/testkey/members/memberpublicid/score?updatePolicy=best", map[string]interface{}{"score": int64(150)})
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(int64(result["score"].(float64))).To(Equal(int64(150)))
+			Expect(result["scoreChanged"]).To(BeTrue())
+		})
+
+		It("Should use leaderboard default update policy if request has none (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				for _, score := range []float64{100, 50} {
+					_, err := cli.UpsertScore(context.Background(), &pb.UpsertScoreRequest{
+						LeaderboardId:  "testkey5",
+						MemberPublicId: "memberpublicid",
+						ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: score},
+					})
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), "testkey5", "memberpublicid", "desc", false, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(member.Score).To(Equal(float64(100)))
+
+				resp, err := cli.UpsertScore(context.Background(), &pb.UpsertScoreRequest{
+					LeaderboardId:  "testkey5",
+					MemberPublicId: "memberpublicid",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 10},
+					UpdatePolicy:   "last",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Score).To(Equal(float64(10)))
+				Expect(resp.ScoreChanged).To(BeTrue())
+
+				member, err = app.Leaderboards.GetMember(NewEmptyCtx(), "testkey5", "memberpublicid", "desc", false, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(member.Score).To(Equal(float64(10)))
+			})
+		})
+
+		It("Should fail if update policy is invalid", func() {
+			status, body := PutJSON(app, "/l/testkey/members/memberpublicid/score?updatePolicy=highest", map[string]interface{}{"score": int64(100)})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("invalid update policy: highest"))
+		})
+
 		It("Should fail if wrong type for score", func() {
 			payload := map[string]interface{}{
 				"score": "hundred",
@@ -629,6 +944,38 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(status).To(Equal(http.StatusOK), string(body))
 		}, 0.05)
+		It("Should keep the fraction of float scores", func() {
+			status, body := PutJSON(app, "/l/testkey-float/members/memberpublicid/score", map[string]interface{}{"score": 61.0375})
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["score"]).To(Equal(61.0375))
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				resp, err := cli.GetMember(context.Background(), &pb.GetMemberRequest{LeaderboardId: "testkey-float", MemberPublicId: "memberpublicid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Score).To(Equal(61.0375))
+			})
+		})
+
+		It("Should fail if score isn't exactly representable by the leaderboard score type", func() {
+			for _, invalid := range []struct {
+				leaderboardID string
+				score         float64
+				reason        string
+			}{
+				{"testkey", 10.5, "invalid scores: score 10.5 isn't an integer"},
+				{"testkey", 1e16, "score 10000000000000000 out of range (-9007199254740991, 9007199254740991)"},
+				{"testkey-decimal", 10.125, "invalid scores: score 10.125 has more than 2 fraction digits"},
+			} {
+				status, body := PutJSON(app, "/l/"+invalid.leaderboardID+"/members/memberpublicid/score", map[string]interface{}{"score": invalid.score})
+				Expect(status).To(Equal(http.StatusBadRequest), body)
+				var result map[string]interface{}
+				json.Unmarshal([]byte(body), &result)
+				Expect(result["success"]).To(BeFalse())
+				Expect(result["reason"]).To(Equal(invalid.reason))
+			}
+		})
 	})
 
 	Describe("Increment Member Score", func() {
@@ -637,7 +984,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				"increment": 10,
 			}
 
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := PatchJSON(app, "/l/testkey/members/memberpublicid/score", payload)
@@ -650,10 +997,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(int64(110)))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(110)))
+			Expect(member.Score).To(Equal(float64(110)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
@@ -665,7 +1012,7 @@ var _ = Describe("Leaderboard Handler", func() {
 					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
 				}
 
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 
 				resp, err := cli.IncrementScore(context.Background(), req)
@@ -676,10 +1023,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int64(resp.Score)).To(Equal(int64(110)))
 				Expect(resp.Rank).To(Equal(int32(1)))
 
-				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member.Rank).To(Equal(1))
-				Expect(member.Score).To(Equal(int64(110)))
+				Expect(member.Score).To(Equal(float64(110)))
 				Expect(member.PublicID).To(Equal("memberpublicid"))
 			})
 		})
@@ -699,10 +1046,10 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(int64(10)))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(10)))
+			Expect(member.Score).To(Equal(float64(10)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
@@ -762,11 +1109,21 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(status).To(Equal(http.StatusOK), string(body))
 		}, 0.05)
+		It("Should sum decimal scores exactly", func() {
+			for i := 0; i < 10; i++ {
+				status, body := PatchJSON(app, "/l/testkey-decimal/members/memberpublicid/score", map[string]interface{}{"increment": 0.1})
+				Expect(status).To(Equal(http.StatusOK), body)
+			}
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), "testkey-decimal", "memberpublicid", "desc", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(float64(1)))
+		})
 	})
 
 	Describe("Remove Member Score", func() {
 		It("Should delete member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Delete(app, "/l/testkey/members?ids=memberpublicid")
@@ -775,14 +1132,14 @@ var _ = Describe("Leaderboard Handler", func() {
 			json.Unmarshal([]byte(body), &result)
 			Expect(result["success"]).To(BeTrue())
 
-			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Could not find data for member"))
 		})
 
 		It("Should delete member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.RemoveMemberRequest{
@@ -793,15 +1150,15 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.Success).To(BeTrue())
 
-				_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("Could not find data for member"))
 			})
 		})
 
 		It("Should delete many member score from redis if they exists", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
-			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Delete(app, "/l/testkey/members?ids=memberpublicid,memberpublicid2")
@@ -810,16 +1167,16 @@ var _ = Describe("Leaderboard Handler", func() {
 			json.Unmarshal([]byte(body), &result)
 			Expect(result["success"]).To(BeTrue())
 
-			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Could not find data for member"))
-			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false)
+			_, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Could not find data for member"))
 		})
 
 		It("Should fail if error removing score", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			app := GetDefaultTestAppWithFaultyRedis()
@@ -836,7 +1193,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			json.Unmarshal([]byte(body), &result)
 			Expect(result["success"]).To(BeTrue())
 
-			_, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			_, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Could not find data for member"))
 		})
@@ -854,7 +1211,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should remove member score", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			ctx["lead"] = lbID
 			ctx["memberID"] = memberID
@@ -871,7 +1228,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 	Describe("Get Member", func() {
 		It("Should get member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid")
@@ -883,16 +1240,16 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int64(result["score"].(float64))).To(Equal(int64(100)))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
 		It("Should get member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.GetMemberRequest{
@@ -907,17 +1264,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int64(resp.Score)).To(Equal(int64(100)))
 				Expect(resp.Rank).To(Equal(int32(1)))
 
-				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member.Rank).To(Equal(1))
-				Expect(member.Score).To(Equal(int64(100)))
+				Expect(member.Score).To(Equal(float64(100)))
 				Expect(member.PublicID).To(Equal("memberpublicid"))
 			})
 		})
 
 		It("Should get member score from redis if greater than int", func() {
-			bigScore := int64(15584657100001)
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", bigScore, false, "")
+			bigScore := float64(15584657100001)
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", bigScore, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid")
@@ -926,18 +1283,18 @@ var _ = Describe("Leaderboard Handler", func() {
 			json.Unmarshal([]byte(body), &result)
 			Expect(result["success"]).To(BeTrue())
 			Expect(result["publicID"]).To(Equal("memberpublicid"))
-			Expect(int64(result["score"].(float64))).To(Equal(bigScore))
+			Expect(result["score"]).To(Equal(bigScore))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(bigScore))
+			Expect(member.Score).To(Equal(float64(bigScore)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
 		It("Should get member score from redis if score exists including expiration timestamp", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "15")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "15", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid?scoreTTL=true")
@@ -950,15 +1307,15 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 			Expect(int(result["expireAt"].(float64))).To(BeNumerically("~", time.Now().Unix()+15, 1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
 		It("Should get member score from redis if score exists including expiration timestamp if no ttl", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicidnottl", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicidnottl", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicidnottl?scoreTTL=true")
@@ -992,7 +1349,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get member", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			ctx["lead"] = lbID
@@ -1009,7 +1366,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 	Describe("Get Member Rank", func() {
 		It("Should get member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid/rank")
@@ -1020,16 +1377,16 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(result["publicID"]).To(Equal("memberpublicid"))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
 		It("Should get member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.GetRankRequest{
@@ -1042,16 +1399,16 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(resp.PublicID).To(Equal("memberpublicid"))
 				Expect(resp.Rank).To(Equal(int32(1)))
 
-				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member.Rank).To(Equal(1))
-				Expect(member.Score).To(Equal(int64(100)))
+				Expect(member.Score).To(Equal(float64(100)))
 				Expect(member.PublicID).To(Equal("memberpublicid"))
 			})
 		})
 
 		It("Should get member score from redis if score exists and order is asc", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid/rank?order=asc")
@@ -1062,13 +1419,58 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(result["publicID"]).To(Equal("memberpublicid"))
 			Expect(int(result["rank"].(float64))).To(Equal(1))
 
-			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Rank).To(Equal(1))
-			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Score).To(Equal(float64(100)))
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
+		It("Should give members with equal scores the same rank if rank mode is set", func() {
+			for member, score := range map[string]float64{"member_a": 300, "member_b": 200, "member_c": 200, "member_d": 100} {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, member, score, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := Get(app, "/l/testkey/members/member_d/rank?rankMode=competition")
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(int(result["rank"].(float64))).To(Equal(4))
+
+			status, body = Get(app, "/l/testkey/members/member_d/rank?rankMode=dense")
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(int(result["rank"].(float64))).To(Equal(3))
+		})
+
+		It("Should use leaderboard default rank mode if request has none", func() {
+			for member, score := range map[string]float64{"member_a": 300, "member_b": 200, "member_c": 200, "member_d": 100} {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), "testkey-dense", member, score, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := Get(app, "/l/testkey-dense/members/member_d/rank")
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(int(result["rank"].(float64))).To(Equal(3))
+
+			status, body = Get(app, "/l/testkey-dense/members/member_d/rank?rankMode=ordinal")
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(int(result["rank"].(float64))).To(Equal(4))
+		})
+
+		It("Should fail with 400 if rank mode is invalid", func() {
+			status, body := Get(app, "/l/testkey/members/memberpublicid/rank?rankMode=olympic")
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("invalid rank mode: olympic"))
+		})
+
 		It("Should fail with 404 if score does not exist", func() {
 			status, body := Get(app, "/l/testkey/members/memberpublicid/rank")
 			Expect(status).To(Equal(http.StatusNotFound), body)
@@ -1089,11 +1491,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get member rank", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1132,7 +1534,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1159,10 +1561,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 				Expect(metadata[expectedMetadataKey]).To(Equal(expectedMetadataValue))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1175,7 +1577,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			app.Enricher = enricher
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1202,10 +1604,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 				Expect(metadata).To(BeEmpty())
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1220,7 +1622,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			enricher.EXPECT().Enrich(gomock.Any(), tenantID, testLeaderboardID, gomock.Any()).Return(nil, errors.New("failed to enrich"))
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1232,7 +1634,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get member score and neighbours from redis if member score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1253,10 +1655,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(member.PublicID).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 					Expect(int(member.Score)).To(Equal(100 - pos))
 
-					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false)
+					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(dbMember.Rank).To(Equal(int(member.Rank)))
-					Expect(dbMember.Score).To(Equal(int64(member.Score)))
+					Expect(dbMember.Score).To(Equal(float64(member.Score)))
 					Expect(dbMember.PublicID).To(Equal(member.PublicID))
 				}
 			})
@@ -1264,7 +1666,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score and neighbours from redis in reverse order if member score exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1285,7 +1687,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists but less than pageSize neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(16-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1302,17 +1704,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", i+1)))
 				Expect(int(member["score"].(float64))).To(Equal(15 - i))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get member score and default limit neighbours from redis if member score and less than limit neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(16-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1330,17 +1732,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 				Expect(int(member["score"].(float64))).To(Equal(15 - pos))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get member score and limit neighbours from redis if member score exists and custom limit", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1359,17 +1761,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get member score and limit neighbours from redis if member score exists and repeated scores", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1387,17 +1789,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["rank"].(float64))).To(Equal(pos))
 				Expect(int64(member["score"].(float64))).To(Equal(int64(100)))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get last positions if not in ranking", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1416,10 +1818,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["rank"].(float64))).To(Equal(pos))
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1455,7 +1857,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and member in ranking bottom", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1472,17 +1874,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", i+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - i - 1))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get one page of top members from redis if leaderboard exists and member in ranking top", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1499,10 +1901,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", 80+i+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - 80 - i - 1))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1526,11 +1928,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get around member", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1569,7 +1971,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1593,10 +1995,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 				Expect(metadata[expectedMetadataKey]).To(Equal(expectedMetadataValue))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1609,7 +2011,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			app.Enricher = enricher
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1633,10 +2035,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 				Expect(metadata).To(BeEmpty())
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1651,7 +2053,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			enricher.EXPECT().Enrich(gomock.Any(), tenantID, testLeaderboardID, gomock.Any()).Return(nil, errors.New("failed to enrich"))
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1663,7 +2065,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get score neighbours from redis if score is sent (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1684,10 +2086,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(member.PublicID).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 					Expect(int(member.Score)).To(Equal(100 - pos))
 
-					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false)
+					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(dbMember.Rank).To(Equal(int(member.Rank)))
-					Expect(dbMember.Score).To(Equal(int64(member.Score)))
+					Expect(dbMember.Score).To(Equal(float64(member.Score)))
 					Expect(dbMember.PublicID).To(Equal(member.PublicID))
 				}
 			})
@@ -1695,7 +2097,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get rank neighbours from redis in reverse order if score is sent", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1718,7 +2120,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists but less than pageSize neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(16-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1736,17 +2138,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", i+1)))
 				Expect(int(member["score"].(float64))).To(Equal(15 - i))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should limit neighbours from redis if score is sent and custom limit", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1766,10 +2168,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -1806,7 +2208,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and score <= 0", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1829,10 +2231,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos)))
 					Expect(int(member["score"].(float64))).To(Equal(20 - i - 1))
 
-					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-					Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+					Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 					Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 				}
 			}
@@ -1840,7 +2242,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and score in ranking top", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1861,10 +2263,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos)))
 					Expect(int(member["score"].(float64))).To(Equal(100 - i - 1))
 
-					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-					Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+					Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 					Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 				}
 			}
@@ -1882,7 +2284,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	Describe("Get Total Members Handler", func() {
 		It("Should get the number of members in a leaderboard it exists (http)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1895,7 +2297,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get the number of members in a leaderboard it exists (grpc)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1928,11 +2330,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get total members", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1969,7 +2371,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1988,14 +2390,64 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - i))
 				Expect(metadata[expectedMetadataKey]).To(Equal(expectedMetadataValue))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
+		It("Should get top members ranked in the requested rank mode (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				for member, score := range map[string]float64{"member_a": 300, "member_b": 200, "member_c": 200, "member_d": 100} {
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, member, score, false, "", "")
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				resp, err := cli.GetTopMembers(context.Background(), &pb.GetTopMembersRequest{
+					LeaderboardId: testLeaderboardID,
+					PageNumber:    1,
+					RankMode:      "dense",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Members).To(HaveLen(4))
+				Expect(resp.Members[0].Rank).To(Equal(int32(1)))
+				Expect(resp.Members[1].Rank).To(Equal(int32(2)))
+				Expect(resp.Members[2].Rank).To(Equal(int32(2)))
+				Expect(resp.Members[3].Rank).To(Equal(int32(3)))
+			})
+		})
+
+		It("Should get top members of the league bucket member was assigned to (grpc)", func() {
+			leagueID := "testkey-league-" + uuid.NewV4().String()
+			defer app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leagueID)
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				for i, member := range []string{"member_a", "member_b", "member_c"} {
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leagueID, member, float64(300-i), false, "", "")
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				resp, err := cli.GetTopMembers(context.Background(), &pb.GetTopMembersRequest{
+					LeaderboardId:  leagueID,
+					PageNumber:     1,
+					MemberPublicId: "member_c",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Members).To(HaveLen(1))
+				Expect(resp.Members[0].PublicID).To(Equal("member_c"))
+				Expect(resp.Members[0].Rank).To(Equal(int32(1)))
+
+				_, err = cli.GetTopMembers(context.Background(), &pb.GetTopMembersRequest{
+					LeaderboardId:  leagueID,
+					PageNumber:     1,
+					MemberPublicId: "member_d",
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
 		It("Should succeed with no tenant-id sent for enrichment", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			defer ctrl.Finish()
@@ -2004,7 +2456,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			app.Enricher = enricher
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2024,10 +2476,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["score"].(float64))).To(Equal(100 - i))
 				Expect(metadata).To(BeEmpty())
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -2046,7 +2498,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2061,7 +2513,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get one page of top members from redis if leaderboard exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -2078,10 +2530,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(member.PublicID).To(Equal(fmt.Sprintf("member_%d", i+1)))
 					Expect(int(member.Score)).To(Equal(100 - i))
 
-					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false)
+					dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member.PublicID, "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(dbMember.Rank).To(Equal(int(member.Rank)))
-					Expect(dbMember.Score).To(Equal(int64(member.Score)))
+					Expect(dbMember.Score).To(Equal(float64(member.Score)))
 					Expect(dbMember.PublicID).To(Equal(member.PublicID))
 				}
 			})
@@ -2089,7 +2541,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members in reverse order from redis if leaderboard exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2110,7 +2562,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2129,17 +2581,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", pos+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - pos))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get top members from redis if leaderboard exists with custom pageSize", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2156,17 +2608,17 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", i+1)))
 				Expect(int(member["score"].(float64))).To(Equal(100 - i))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
 
 		It("Should get empty list if page does not exist", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2181,7 +2633,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get only one page of top members from redis if leaderboard exists and repeated scores", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2197,10 +2649,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(int(member["rank"].(float64))).To(Equal(i + 1))
 				Expect(int64(member["score"].(float64))).To(Equal(int64(100)))
 
-				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false)
+				dbMember, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, member["publicID"].(string), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dbMember.Rank).To(Equal(int(member["rank"].(float64))))
-				Expect(dbMember.Score).To(Equal(int64(member["score"].(float64))))
+				Expect(dbMember.Score).To(Equal(float64(member["score"].(float64))))
 				Expect(dbMember.PublicID).To(Equal(member["publicID"]))
 			}
 		})
@@ -2208,7 +2660,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should not fail is page number 0 is sent", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -2256,11 +2708,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get top members", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2299,7 +2751,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2334,7 +2786,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			app.Enricher = enricher
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2371,7 +2823,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			enricher.EXPECT().Enrich(gomock.Any(), tenantID, leaderboardID, gomock.Any()).Return(nil, errors.New("failed to enrich"))
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2385,7 +2837,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				leaderboardID := uuid.NewV4().String()
 
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -2411,7 +2863,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2465,7 +2917,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			lead := uuid.NewV4().String()
 
 			for i := 0; i < 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2574,10 +3026,10 @@ var _ = Describe("Leaderboard Handler", func() {
 				Expect(score["leaderboardID"]).To(Equal(payload["leaderboards"].([]string)[i]))
 
 				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), score["leaderboardID"].(string),
-					"memberpublicid", "desc", false)
+					"memberpublicid", "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(member.Rank).To(Equal(1))
-				Expect(member.Score).To(Equal(int64(100)))
+				Expect(member.Score).To(Equal(float64(100)))
 				Expect(member.PublicID).To(Equal("memberpublicid"))
 			}
 		})
@@ -2609,10 +3061,10 @@ var _ = Describe("Leaderboard Handler", func() {
 					Expect(score.LeaderboardID).To(Equal(payload["leaderboards"].([]string)[i]))
 
 					member, err := app.Leaderboards.GetMember(NewEmptyCtx(), score.LeaderboardID,
-						"memberpublicid", "desc", false)
+						"memberpublicid", "desc", false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(member.Rank).To(Equal(1))
-					Expect(member.Score).To(Equal(int64(100)))
+					Expect(member.Score).To(Equal(float64(100)))
 					Expect(member.PublicID).To(Equal("memberpublicid"))
 				}
 			})
@@ -2686,7 +3138,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), 500, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2739,7 +3191,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				})
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2781,7 +3233,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			app.Enricher = enricher
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2823,7 +3275,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			enricher.EXPECT().Enrich(gomock.Any(), tenantID, leaderboardID, gomock.Any()).Return(nil, errors.New("failed to enrich"))
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2840,7 +3292,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				leaderboardID := uuid.NewV4().String()
 
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -2891,7 +3343,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 1; i <= 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2950,7 +3402,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			for i := 1; i <= 1000; i++ {
 				memberID := fmt.Sprintf("member_%d", i)
 				memberIDs = append(memberIDs, memberID)
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, memberID, int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, memberID, float64(101-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
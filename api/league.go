@@ -0,0 +1,45 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// loadLeagues validates the configured leagues.
+func (app *App) loadLeagues() error {
+	app.leagues = map[string]*lservice.League{}
+
+	for pattern, leagueConfig := range app.ParsedConfig.Leaderboards.Leagues {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid league pattern %s: %w", pattern, err)
+		}
+		if leagueConfig.BucketSize <= 0 {
+			return fmt.Errorf("invalid league bucket size for %s: %d", pattern, leagueConfig.BucketSize)
+		}
+
+		app.leagues[pattern] = &lservice.League{BucketSize: leagueConfig.BucketSize}
+	}
+
+	return nil
+}
+
+// getLeague returns the league of a leaderboard, nil if its members aren't split in buckets,
+// tier leaderboards of tiered leagues are leagues too.
+func (app *App) getLeague(leaderboardID string) *lservice.League {
+	if league, ok := config.MatchLeaderboardPattern(app.leagues, leaderboardID); ok {
+		return league
+	}
+	return app.getTierLeague(leaderboardID)
+}
@@ -17,6 +17,7 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/topfreegames/podium/leaderboard/v2/service"
 	"github.com/topfreegames/podium/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -107,6 +108,37 @@ func (app *App) recoveryMiddleware(ctx context.Context, req interface{}, info *g
 	return handler(ctx, req)
 }
 
+// serviceErrorsMiddleware maps leaderboard client errors that handlers return as they are to their status
+func (app *App) serviceErrorsMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	h, err := handler(ctx, req)
+	return h, getServiceError(err)
+}
+
+// getServiceError maps leaderboard client errors to grpc status, other errors are kept as they are
+func getServiceError(err error) error {
+	switch err.(type) {
+	case *service.LeaderboardExpiredError, *service.InvalidSeasonSelectorError, *service.PercentageError,
+		*service.PageSizeOutOfRangeError, *service.InvalidRankModeError, *service.TieBreakRankModeError,
+		*service.ScoreOutOfRangeError, *service.InvalidScoresError, *service.ScoreRuleViolationError,
+		*service.InvalidPreconditionError, *service.InvalidLeaderboardDefinitionError, *service.InvalidMatchResultError,
+		*service.InvalidChallengeError:
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case *service.SeasonNotStartedError, *service.SeasonClosedError, *service.PreconditionFailedError,
+		*service.LeaderboardNotRatedError, *service.LeaderboardNotLadderError:
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	case *service.MemberNotFoundError, *service.LeaderboardDefinitionNotFoundError, *service.LeaderboardArchiveNotFoundError,
+		*service.LeaderboardRewardsNotFoundError, *service.TieredLeagueNotFoundError:
+		return status.Errorf(codes.NotFound, err.Error())
+	case *service.LeaderboardDefinitionAlreadyExistsError:
+		return status.Errorf(codes.AlreadyExists, err.Error())
+	case *service.RatingConflictError:
+		return status.Errorf(codes.Aborted, err.Error())
+	case *service.InvalidSubmissionSignatureError:
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	return err
+}
+
 func (app *App) responseTimeMetricsMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	startTime := time.Now()
 	h, err := handler(ctx, req)
@@ -0,0 +1,116 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Conditional Writes", func() {
+	var app *api.App
+	var leaderboardID string
+
+	memberScore := func(memberPublicID string) float64 {
+		status, body := Get(app, fmt.Sprintf("/l/%s/members/%s", leaderboardID, memberPublicID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		return result["score"].(float64)
+	}
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = "testkey-" + uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should write a score only if the current score is the expected one", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 100})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 200, "expectedScore": 50})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("precondition of member member1 failed: current score is 100"))
+		Expect(memberScore("member1")).To(Equal(float64(100)))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 200, "expectedScore": 100})
+		Expect(status).To(Equal(http.StatusOK), body)
+		Expect(memberScore("member1")).To(Equal(float64(200)))
+	})
+
+	It("Should write a score only if the member is absent or present", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 100, "ifPresent": true})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("precondition of member member1 failed: member is absent"))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100, "ifAbsent": true})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 200, "ifAbsent": true})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("current score is 100"))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 200, "ifPresent": true})
+		Expect(status).To(Equal(http.StatusOK), body)
+		Expect(memberScore("member1")).To(Equal(float64(200)))
+	})
+
+	It("Should write no score of a bulk upsert if a precondition fails", func() {
+		url := fmt.Sprintf("/l/%s/scores", leaderboardID)
+		status, body := PutJSON(app, url, map[string]interface{}{
+			"members": []map[string]interface{}{{"publicID": "member1", "score": 100}},
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PutJSON(app, url, map[string]interface{}{
+			"members": []map[string]interface{}{
+				{"publicID": "member1", "score": 150, "expectedScore": 100},
+				{"publicID": "member2", "score": 300, "ifPresent": true},
+			},
+		})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("precondition of member member2 failed: member is absent"))
+		Expect(memberScore("member1")).To(Equal(float64(100)))
+
+		status, body = PutJSON(app, url, map[string]interface{}{
+			"members": []map[string]interface{}{
+				{"publicID": "member1", "score": 150, "expectedScore": 100},
+				{"publicID": "member2", "score": 300, "ifAbsent": true},
+			},
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+		Expect(memberScore("member1")).To(Equal(float64(150)))
+		Expect(memberScore("member2")).To(Equal(float64(300)))
+	})
+
+	It("Should reject preconditions with more than one condition", func() {
+		status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID),
+			map[string]interface{}{"score": 100, "ifAbsent": true, "ifPresent": true})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("invalid precondition of member member1"))
+	})
+})
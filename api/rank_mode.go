@@ -0,0 +1,60 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (app *App) validateRankModes() error {
+	leaderboardsConfig := app.ParsedConfig.Leaderboards
+	if !lservice.IsValidRankMode(leaderboardsConfig.DefaultRankMode) {
+		return fmt.Errorf("invalid default rank mode: %s", leaderboardsConfig.DefaultRankMode)
+	}
+
+	for pattern, rankMode := range leaderboardsConfig.RankModes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid rank mode pattern %s: %w", pattern, err)
+		}
+		if !lservice.IsValidRankMode(rankMode) {
+			return fmt.Errorf("invalid rank mode for %s: %s", pattern, rankMode)
+		}
+	}
+
+	return nil
+}
+
+// getRankMode returns the rank mode of the request if set, otherwise the leaderboard default one. Leaderboards
+// with a tie-break have no tied members, so configured rank modes don't apply to them and they are ranked
+// by position.
+func (app *App) getRankMode(leaderboardID, rankMode string) (string, error) {
+	if rankMode != "" {
+		if !lservice.IsValidRankMode(rankMode) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid rank mode: %s", rankMode)
+		}
+		return rankMode, nil
+	}
+
+	if app.getTieBreak(leaderboardID) != nil {
+		return lservice.RankModeOrdinal, nil
+	}
+
+	leaderboardsConfig := app.ParsedConfig.Leaderboards
+	if rankMode, ok := config.MatchLeaderboardPattern(leaderboardsConfig.RankModes, leaderboardID); ok {
+		return rankMode, nil
+	}
+	return leaderboardsConfig.DefaultRankMode, nil
+}
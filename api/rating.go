@@ -0,0 +1,165 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+// loadRatings validates the configured rating settings and fills their defaults, it must run after score types are loaded.
+func (app *App) loadRatings() error {
+	app.ratings = map[string]*rating.Settings{}
+
+	for pattern, ratingConfig := range app.ParsedConfig.Leaderboards.Ratings {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid rating pattern %s: %w", pattern, err)
+		}
+
+		settings := rating.WithDefaults(rating.Settings{
+			Algorithm:         ratingConfig.Algorithm,
+			InitialRating:     ratingConfig.InitialRating,
+			KFactor:           ratingConfig.KFactor,
+			InitialDeviation:  ratingConfig.InitialDeviation,
+			InitialVolatility: ratingConfig.InitialVolatility,
+			Tau:               ratingConfig.Tau,
+		})
+		if err := rating.ValidateSettings(settings); err != nil {
+			return fmt.Errorf("invalid rating for %s: %w", pattern, err)
+		}
+		if encoding := app.scoreEncodingOf(pattern); encoding != "" {
+			return fmt.Errorf("invalid rating for %s: ratings are stored as scores, they can't have %s", pattern, encoding)
+		}
+
+		app.ratings[pattern] = &settings
+	}
+
+	return nil
+}
+
+// getRatingSettings returns the rating settings of a leaderboard, nil if it isn't a rating leaderboard.
+func (app *App) getRatingSettings(leaderboardID string) *rating.Settings {
+	settings, _ := config.MatchLeaderboardPattern(app.ratings, leaderboardID)
+	return settings
+}
+
+func newMemberRatingResponse(memberRating *lmodel.MemberRating) *api.MemberRating {
+	return &api.MemberRating{
+		PublicID:       memberRating.PublicID,
+		Score:          float64(memberRating.Score),
+		Rank:           int32(memberRating.Rank),
+		PreviousRank:   int32(memberRating.PreviousRank),
+		Rating:         memberRating.Rating,
+		PreviousRating: memberRating.PreviousRating,
+		Deviation:      memberRating.Deviation,
+		Volatility:     memberRating.Volatility,
+		Matches:        int32(memberRating.Matches),
+	}
+}
+
+// SubmitMatchResult is the handler responsible for rating the members of a match of a rating leaderboard.
+func (app *App) SubmitMatchResult(ctx context.Context, req *api.SubmitMatchResultRequest) (*api.SubmitMatchResultResponse, error) {
+	if req.Match == nil || len(req.Match.Results) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least two results are required")
+	}
+
+	if err := app.verifyUnsignedSubmission(ctx); err != nil {
+		return nil, err
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "SubmitMatchResult"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	results := make([]*lmodel.MatchResult, 0, len(req.Match.Results))
+	for _, result := range req.Match.Results {
+		results = append(results, &lmodel.MatchResult{
+			PublicID:  result.PublicID,
+			Placement: int(result.Placement),
+			Outcome:   result.Outcome,
+		})
+	}
+
+	var memberRatings []*lmodel.MemberRating
+	ctx = service.ContextWithIdempotencyKey(ctx, req.IdempotencyKey)
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Submitting match result.")
+		memberRatings, err = app.Leaderboards.SubmitMatchResult(ctx, req.LeaderboardId, results)
+		if err != nil {
+			lg.Error("Submit match result failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Submit match result succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.SubmitMatchResultResponse{
+		Success: true,
+		Members: make([]*api.MemberRating, 0, len(memberRatings)),
+	}
+	for _, memberRating := range memberRatings {
+		response.Members = append(response.Members, newMemberRatingResponse(memberRating))
+	}
+	return response, nil
+}
+
+// GetMemberRating is the handler responsible for retrieving the rating of a member of a rating leaderboard.
+func (app *App) GetMemberRating(ctx context.Context, req *api.GetMemberRatingRequest) (*api.GetMemberRatingResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetMemberRating"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	var memberRating *lmodel.MemberRating
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting member rating.")
+		memberRating, err = app.Leaderboards.GetMemberRating(ctx, req.LeaderboardId, req.MemberPublicId)
+		if err != nil {
+			lg.Error("Get member rating failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Get member rating succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetMemberRatingResponse{
+		Success:    true,
+		PublicID:   memberRating.PublicID,
+		Score:      float64(memberRating.Score),
+		Rank:       int32(memberRating.Rank),
+		Rating:     memberRating.Rating,
+		Deviation:  memberRating.Deviation,
+		Volatility: memberRating.Volatility,
+		Matches:    int32(memberRating.Matches),
+	}, nil
+}
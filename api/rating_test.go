@@ -0,0 +1,131 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Rating Handler", func() {
+	var app *api.App
+	var leaderboardID string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = "testkey-elo-" + uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should refuse score writes to rating leaderboards", func() {
+		status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 5000})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+		Expect(body).To(ContainSubstring("only written by match results"))
+
+		status, body = PatchJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"increment": 5000})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should rate match members and rank them by rating", func() {
+		status, body := PostJSON(app, fmt.Sprintf("/l/%s/matches", leaderboardID), map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "outcome": "loss"},
+				{"publicID": "member2", "outcome": "win"},
+			},
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		Expect(result["members"]).To(Equal([]interface{}{
+			map[string]interface{}{
+				"publicID": "member1", "score": float64(1484), "rank": float64(2), "previousRank": float64(-1),
+				"rating": float64(1484), "previousRating": float64(1500), "deviation": float64(0), "volatility": float64(0), "matches": float64(1),
+			},
+			map[string]interface{}{
+				"publicID": "member2", "score": float64(1516), "rank": float64(1), "previousRank": float64(-1),
+				"rating": float64(1516), "previousRating": float64(1500), "deviation": float64(0), "volatility": float64(0), "matches": float64(1),
+			},
+		}))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/members/member2", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["score"]).To(Equal(float64(1516)))
+		Expect(result["rank"]).To(Equal(float64(1)))
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/members/member1/rating", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["rating"]).To(Equal(float64(1484)))
+		Expect(result["matches"]).To(Equal(float64(1)))
+	})
+
+	It("Should keep Glicko-2 deviation and volatility", func() {
+		leaderboardID = "testkey-glicko-" + uuid.NewV4().String()
+
+		status, body := PostJSON(app, fmt.Sprintf("/l/%s/matches", leaderboardID), map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "placement": 1},
+				{"publicID": "member2", "placement": 2},
+				{"publicID": "member3", "placement": 3},
+			},
+		})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = Get(app, fmt.Sprintf("/l/%s/members/member1/rating", leaderboardID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["rating"]).To(BeNumerically(">", 1500))
+		Expect(result["deviation"]).To(BeNumerically("<", 350))
+		Expect(result["volatility"]).To(BeNumerically("~", 0.06, 0.001))
+		Expect(result["rank"]).To(Equal(float64(1)))
+	})
+
+	It("Should return 400 if match results are invalid", func() {
+		status, body := PostJSON(app, fmt.Sprintf("/l/%s/matches", leaderboardID), map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "outcome": "win"},
+			},
+		})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should return 400 if leaderboard isn't a rating leaderboard", func() {
+		status, body := PostJSON(app, "/l/testkey-unrated/matches", map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "outcome": "win"},
+				{"publicID": "member2", "outcome": "loss"},
+			},
+		})
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("Should return 404 if member was never rated", func() {
+		status, body := Get(app, fmt.Sprintf("/l/%s/members/member1/rating", leaderboardID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+})
@@ -0,0 +1,102 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+// loadScoreRules validates the configured score rules.
+func (app *App) loadScoreRules() error {
+	app.scoreRules = map[string]*lservice.ScoreRules{}
+
+	for pattern, rulesConfig := range app.ParsedConfig.Leaderboards.ScoreRules {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid score rules pattern %s: %w", pattern, err)
+		}
+
+		rules := &lservice.ScoreRules{
+			MinScore:         rulesConfig.MinScore,
+			MaxScore:         rulesConfig.MaxScore,
+			MaxIncrement:     rulesConfig.MaxIncrement,
+			MaxDelta:         rulesConfig.MaxDelta,
+			DeltaWindow:      rulesConfig.DeltaWindow,
+			MaxSubmissions:   rulesConfig.MaxSubmissions,
+			SubmissionWindow: rulesConfig.SubmissionWindow,
+			Quarantine:       rulesConfig.Quarantine,
+		}
+		if err := rules.Validate(); err != nil {
+			return fmt.Errorf("invalid score rules for %s: %w", pattern, err)
+		}
+
+		app.scoreRules[pattern] = rules
+	}
+
+	return nil
+}
+
+// getScoreRules returns the score rules of a leaderboard, nil if it accepts any score.
+func (app *App) getScoreRules(leaderboardID string) *lservice.ScoreRules {
+	rules, _ := config.MatchLeaderboardPattern(app.scoreRules, leaderboardID)
+	return rules
+}
+
+func newQuarantinedMemberResponse(member *lmodel.QuarantinedMember) *api.QuarantinedMember {
+	return &api.QuarantinedMember{
+		PublicID:    member.PublicID,
+		Score:       member.Score,
+		Rule:        member.Rule,
+		SubmittedAt: int64(member.SubmittedAt),
+	}
+}
+
+// GetQuarantinedMembers is the handler responsible for listing the score writes held for review.
+func (app *App) GetQuarantinedMembers(ctx context.Context, req *api.GetQuarantinedMembersRequest) (*api.GetQuarantinedMembersResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetQuarantinedMembers"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var members []*lmodel.QuarantinedMember
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting quarantined members.")
+		members, err = app.Leaderboards.GetQuarantinedMembers(ctx, req.LeaderboardId)
+		if err != nil {
+			lg.Error("Get quarantined members failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Get quarantined members succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetQuarantinedMembersResponse{
+		Success: true,
+		Members: make([]*api.QuarantinedMember, 0, len(members)),
+	}
+	for _, member := range members {
+		response.Members = append(response.Members, newQuarantinedMemberResponse(member))
+	}
+
+	return response, nil
+}
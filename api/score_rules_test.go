@@ -0,0 +1,133 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Score Rules Handler", func() {
+	var app *api.App
+	var leaderboardID string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	Describe("When leaderboard refuses scores breaking its rules", func() {
+		BeforeEach(func() {
+			leaderboardID = "testkey-rules-" + uuid.NewV4().String()
+		})
+
+		It("Should refuse scores out of bounds", func() {
+			for _, score := range []float64{-1, 1001} {
+				status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": score})
+				Expect(status).To(Equal(http.StatusBadRequest), body)
+				Expect(body).To(ContainSubstring("breaks rule"))
+			}
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 1000})
+			Expect(status).To(Equal(http.StatusOK), body)
+		})
+
+		It("Should refuse increments larger than max increment", func() {
+			status, body := PatchJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"increment": 101})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("max_increment"))
+
+			status, body = PatchJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"increment": 100})
+			Expect(status).To(Equal(http.StatusOK), body)
+		})
+
+		It("Should refuse submissions past max submissions", func() {
+			for i := 1; i <= 3; i++ {
+				status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": i})
+				Expect(status).To(Equal(http.StatusOK), body)
+			}
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 4})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("max_submissions"))
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member2/score", leaderboardID), map[string]interface{}{"score": 4})
+			Expect(status).To(Equal(http.StatusOK), body)
+		})
+	})
+
+	Describe("When leaderboard quarantines scores breaking its rules", func() {
+		BeforeEach(func() {
+			leaderboardID = "testkey-quarantine-" + uuid.NewV4().String()
+		})
+
+		It("Should hold scores breaking a rule for review without ranking them", func() {
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/scores", leaderboardID), map[string]interface{}{
+				"members": []map[string]interface{}{
+					{"publicID": "member1", "score": 500},
+					{"publicID": "member2", "score": 5000},
+				},
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			written := result["members"].([]interface{})
+			Expect(written).To(HaveLen(2))
+			Expect(written[0].(map[string]interface{})["rank"]).To(Equal(float64(1)))
+			Expect(written[0].(map[string]interface{})["quarantined"]).To(BeFalse())
+			Expect(written[1].(map[string]interface{})["publicID"]).To(Equal("member2"))
+			Expect(written[1].(map[string]interface{})["quarantined"]).To(BeTrue())
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/top/1", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(1))
+			Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member1"))
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/quarantine", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			quarantined := result["members"].([]interface{})
+			Expect(quarantined).To(HaveLen(1))
+			Expect(quarantined[0].(map[string]interface{})["publicID"]).To(Equal("member2"))
+			Expect(quarantined[0].(map[string]interface{})["score"]).To(Equal(float64(5000)))
+			Expect(quarantined[0].(map[string]interface{})["rule"]).To(Equal("max_score"))
+			Expect(quarantined[0].(map[string]interface{})["submittedAt"]).NotTo(BeEmpty())
+		})
+
+		It("Should forget quarantined scores when leaderboard is removed", func() {
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 5000})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Delete(app, fmt.Sprintf("/l/%s", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/quarantine", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["members"]).To(BeEmpty())
+		})
+	})
+})
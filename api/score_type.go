@@ -0,0 +1,65 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// loadScoreTypes validates the configured score types, it must run after tie-breaks and composite scores are loaded.
+func (app *App) loadScoreTypes() error {
+	app.scoreTypes = map[string]*lservice.ScoreType{}
+
+	for pattern, scoreTypeConfig := range app.ParsedConfig.Leaderboards.ScoreTypes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid score type pattern %s: %w", pattern, err)
+		}
+
+		scoreType := &lservice.ScoreType{Type: scoreTypeConfig.Type, Digits: scoreTypeConfig.Digits}
+		if scoreType.Type == "" {
+			scoreType.Type = lservice.ScoreTypeInteger
+		}
+		if err := scoreType.Validate(); err != nil {
+			return fmt.Errorf("invalid score type for %s: %w", pattern, err)
+		}
+		if scoreType.Type != lservice.ScoreTypeInteger && (app.getTieBreak(pattern) != nil || app.getCompositeScore(pattern) != nil) {
+			return fmt.Errorf("invalid score type for %s: %s scores can't have a tie-break or a composite score", pattern, scoreType.Type)
+		}
+
+		app.scoreTypes[pattern] = scoreType
+	}
+
+	return nil
+}
+
+// scoreEncodingOf returns which tie-break, composite score or score type changes how scores of leaderboards
+// matching pattern are stored, empty if they're stored as submitted.
+func (app *App) scoreEncodingOf(pattern string) string {
+	if other, ok := config.OverlappingPattern(app.tieBreaks, pattern); ok {
+		return "tie-break " + other
+	}
+	if other, ok := config.OverlappingPattern(app.compositeScores, pattern); ok {
+		return "composite score " + other
+	}
+	if other, ok := config.OverlappingPattern(app.scoreTypes, pattern); ok {
+		return "score type " + other
+	}
+	return ""
+}
+
+// getScoreType returns the score type of a leaderboard, nil if it accepts integer scores.
+func (app *App) getScoreType(leaderboardID string) *lservice.ScoreType {
+	scoreType, _ := config.MatchLeaderboardPattern(app.scoreTypes, leaderboardID)
+	return scoreType
+}
@@ -0,0 +1,169 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	// Season timezones must load on hosts without a timezone database.
+	_ "time/tzdata"
+
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// seasonSettings are the settings of a configured season.
+type seasonSettings struct {
+	policy  expiration.Policy
+	archive bool
+}
+
+// loadSeasons validates the configured seasons and builds their settings.
+func (app *App) loadSeasons() error {
+	leaderboardsConfig := app.ParsedConfig.Leaderboards
+
+	defaultSeason, err := newSeasonSettings(leaderboardsConfig.DefaultSeason, seasonSettings{})
+	if err != nil {
+		return fmt.Errorf("invalid default season: %w", err)
+	}
+
+	seasons := map[string]seasonSettings{}
+	for pattern, seasonConfig := range leaderboardsConfig.Seasons {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid season pattern %s: %w", pattern, err)
+		}
+
+		season, err := newSeasonSettings(seasonConfig, defaultSeason)
+		if err != nil {
+			return fmt.Errorf("invalid season for %s: %w", pattern, err)
+		}
+		seasons[pattern] = season
+	}
+
+	app.defaultSeason = defaultSeason
+	app.seasons = seasons
+	return nil
+}
+
+// newSeasonSettings returns the settings of seasonConfig, settings it doesn't set are taken from fallback.
+func newSeasonSettings(seasonConfig config.SeasonConfig, fallback seasonSettings) (seasonSettings, error) {
+	season := fallback
+
+	if seasonConfig.Timezone != "" {
+		location, err := time.LoadLocation(seasonConfig.Timezone)
+		if err != nil {
+			return seasonSettings{}, fmt.Errorf("invalid timezone %s: %w", seasonConfig.Timezone, err)
+		}
+		season.policy.Location = location
+	}
+
+	retention := expiration.Retention{
+		Multiplier: seasonConfig.RetentionMultiplier,
+		Grace:      seasonConfig.RetentionGrace,
+		Duration:   seasonConfig.Retention,
+	}
+	if retention.Multiplier < 0 || retention.Grace < 0 || retention.Duration < 0 {
+		return seasonSettings{}, fmt.Errorf("retention can't be negative")
+	}
+
+	retentions := 0
+	for _, isSet := range []bool{retention.Multiplier != 0, retention.Grace != 0, retention.Duration != 0} {
+		if isSet {
+			retentions++
+		}
+	}
+	if retentions > 1 {
+		return seasonSettings{}, fmt.Errorf("only one of retention_multiplier, retention_grace and retention can be set")
+	}
+	if retentions == 1 {
+		season.policy.Retention = retention
+	}
+
+	if seasonConfig.Archive != nil {
+		season.archive = *seasonConfig.Archive
+	}
+
+	return season, nil
+}
+
+// loadSeasonFamilies validates the configured season families, their seasonal leaderboards get the family
+// season settings.
+func (app *App) loadSeasonFamilies() error {
+	seasonFamilies := map[string]*lservice.SeasonFamily{}
+	seasonFamilySeasons := map[string]seasonSettings{}
+
+	for familyID, familyConfig := range app.ParsedConfig.Leaderboards.SeasonFamilies {
+		if strings.Contains(familyID, lservice.SeasonSelectorSeparator) {
+			return fmt.Errorf("invalid season family %s: it can't contain %s", familyID, lservice.SeasonSelectorSeparator)
+		}
+		if !expiration.IsValidCadence(familyConfig.Cadence) {
+			return fmt.Errorf("invalid season family cadence for %s: %s", familyID, familyConfig.Cadence)
+		}
+
+		season, err := newSeasonSettings(familyConfig.SeasonConfig, app.defaultSeason)
+		if err != nil {
+			return fmt.Errorf("invalid season family %s: %w", familyID, err)
+		}
+
+		prefix := familyConfig.Prefix
+		if prefix == "" {
+			prefix = familyID + "-"
+		}
+
+		seasonFamilies[familyID] = &lservice.SeasonFamily{
+			Prefix:   prefix,
+			Cadence:  expiration.Cadence(familyConfig.Cadence),
+			Location: season.policy.Location,
+		}
+		seasonFamilySeasons[prefix] = season
+	}
+
+	app.seasonFamilies = seasonFamilies
+	app.seasonFamilySeasons = seasonFamilySeasons
+	return nil
+}
+
+// getSeasonFamily returns the season family of an ID, nil if it isn't a family.
+func (app *App) getSeasonFamily(familyID string) *lservice.SeasonFamily {
+	return app.seasonFamilies[strings.ToLower(familyID)]
+}
+
+// getSeason returns the season settings of a leaderboard, the ones of its season family if it's a seasonal
+// leaderboard of a family, otherwise the configured ones or the default ones.
+func (app *App) getSeason(leaderboardID string) seasonSettings {
+	var familyPrefix string
+	for prefix := range app.seasonFamilySeasons {
+		if strings.HasPrefix(leaderboardID, prefix) && len(prefix) > len(familyPrefix) {
+			familyPrefix = prefix
+		}
+	}
+	if familyPrefix != "" {
+		return app.seasonFamilySeasons[familyPrefix]
+	}
+
+	if season, ok := config.MatchLeaderboardPattern(app.seasons, leaderboardID); ok {
+		return season
+	}
+	return app.defaultSeason
+}
+
+// getSeasonPolicy returns the season policy of a leaderboard, see getSeason.
+func (app *App) getSeasonPolicy(leaderboardID string) expiration.Policy {
+	return app.getSeason(leaderboardID).policy
+}
+
+// isArchived reports if the final standings of a leaderboard are archived when its season ends, see getSeason.
+func (app *App) isArchived(leaderboardID string) bool {
+	return app.getSeason(leaderboardID).archive
+}
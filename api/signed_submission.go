@@ -0,0 +1,105 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Headers carrying the signature of score submissions, the timestamp is unix seconds
+const (
+	SubmissionSignatureHeaderKey = "x-podium-signature"
+	SubmissionTimestampHeaderKey = "x-podium-timestamp"
+	SubmissionNonceHeaderKey     = "x-podium-nonce"
+)
+
+// validateSubmissionSigning validates the configured signing keys of each tenant.
+func (app *App) validateSubmissionSigning() error {
+	if app.ParsedConfig.SignedSubmissions.Window <= 0 {
+		return fmt.Errorf("invalid signed submissions window %s", app.ParsedConfig.SignedSubmissions.Window)
+	}
+
+	for tenantID, signingConfig := range app.ParsedConfig.SignedSubmissions.Tenants {
+		if signingConfig.Key == "" {
+			return fmt.Errorf("invalid submission signing for tenant %s: key is required", tenantID)
+		}
+	}
+
+	return nil
+}
+
+// getSubmissionSigning returns how the score submissions of a tenant are signed, nil if they aren't.
+// Clients can send any tenant ID, so once any tenant requires signatures, unknown tenants, including an empty
+// one, can't send unsigned submissions either.
+func (app *App) getSubmissionSigning(tenantID string) *lservice.SubmissionSigning {
+	signingConfig := app.ParsedConfig.SignedSubmissions
+	required := signingConfig.Required
+	for configuredID, tenant := range signingConfig.Tenants {
+		if strings.EqualFold(configuredID, tenantID) {
+			return &lservice.SubmissionSigning{Key: tenant.Key, Required: tenant.Required || signingConfig.Required}
+		}
+		required = required || tenant.Required
+	}
+
+	if required {
+		return &lservice.SubmissionSigning{Required: true}
+	}
+	return nil
+}
+
+// verifySubmission checks the signature sent along a score submission, the submission holds the write as sent
+// and is completed with the signature headers.
+func (app *App) verifySubmission(ctx context.Context, submission *lmodel.SignedSubmission) error {
+	tenantID, _ := tryGetTenantIDFromHeader(ctx)
+	submission.Nonce = getHeaderValue(ctx, SubmissionNonceHeaderKey)
+	submission.Signature = getHeaderValue(ctx, SubmissionSignatureHeaderKey)
+
+	if timestamp := getHeaderValue(ctx, SubmissionTimestampHeaderKey); timestamp != "" {
+		var err error
+		submission.Timestamp, err = strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, "invalid submission signature: invalid timestamp %s", timestamp)
+		}
+	}
+
+	return app.Leaderboards.VerifySubmission(ctx, tenantID, submission)
+}
+
+// releaseSubmission forgets the nonce of a verified submission whose write failed, so it can be sent again.
+func (app *App) releaseSubmission(ctx context.Context, submission *lmodel.SignedSubmission) {
+	tenantID, _ := tryGetTenantIDFromHeader(ctx)
+	if err := app.Leaderboards.ReleaseSubmission(ctx, tenantID, submission); err != nil {
+		app.Logger.Error("Releasing submission nonce failed.", zap.Error(err))
+	}
+}
+
+// verifyUnsignedSubmission refuses score submissions that can't be signed from tenants that require signatures.
+func (app *App) verifyUnsignedSubmission(ctx context.Context) error {
+	tenantID, _ := tryGetTenantIDFromHeader(ctx)
+	return app.Leaderboards.VerifySubmission(ctx, tenantID, &lmodel.SignedSubmission{})
+}
+
+func getHeaderValue(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
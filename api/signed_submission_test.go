@@ -0,0 +1,252 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Signed Submissions", func() {
+	var app *api.App
+	var leaderboardID string
+
+	signHeaders := func(tenantID, key string, submission *lmodel.SignedSubmission) []string {
+		submission.Leaderboard = leaderboardID
+		submission.Timestamp = time.Now().Unix()
+		submission.Nonce = uuid.NewV4().String()
+		return []string{
+			api.TenantIDHeaderKey, tenantID,
+			api.SubmissionSignatureHeaderKey, lservice.SignSubmission(key, submission),
+			api.SubmissionTimestampHeaderKey, strconv.FormatInt(submission.Timestamp, 10),
+			api.SubmissionNonceHeaderKey, submission.Nonce,
+		}
+	}
+
+	signedHeaders := func(tenantID, key, operation string, members ...*lmodel.Member) []string {
+		return signHeaders(tenantID, key, &lmodel.SignedSubmission{Operation: operation, Members: members})
+	}
+
+	requireSignatures := func(tenantID string, required bool) {
+		tenant := app.ParsedConfig.SignedSubmissions.Tenants[tenantID]
+		tenant.Required = required
+		app.ParsedConfig.SignedSubmissions.Tenants[tenantID] = tenant
+	}
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		leaderboardID = "testkey-float-signed-" + uuid.NewV4().String()
+	})
+
+	AfterEach(func() {
+		app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+	})
+
+	It("Should accept signed score submissions once", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		headers := signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("nonce was already used"))
+	})
+
+	It("Should accept signed increments and bulk submissions", func() {
+		headers := signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationIncrement, &lmodel.Member{PublicID: "member1", Score: 10})
+		status, body := PatchJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"increment": 10}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		headers = signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet,
+			&lmodel.Member{PublicID: "member1", Score: 20}, &lmodel.Member{PublicID: "member2", Score: 30.5})
+		status, body = PutJSON(app, fmt.Sprintf("/l/%s/scores", leaderboardID), map[string]interface{}{
+			"members": []map[string]interface{}{
+				{"publicID": "member1", "score": 20},
+				{"publicID": "member2", "score": 30.5},
+			},
+		}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should refuse submissions whose payload doesn't match the signature", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+
+		headers := signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 1000000}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature doesn't match"))
+
+		headers = signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		status, body = PatchJSON(app, url, map[string]interface{}{"increment": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature doesn't match"))
+
+		headers = signedHeaders("signed-game", "another-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+
+		headers = signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		headers[5] = "yesterday"
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("invalid timestamp"))
+	})
+
+	It("Should refuse unsigned submissions of tenants that require signatures", func() {
+		requireSignatures("required-game", true)
+		defer requireSignatures("required-game", false)
+
+		status, body := PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100},
+			api.TenantIDHeaderKey, "required-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		status, body = PutJSON(app, "/m/member1/scores", map[string]interface{}{"score": 100, "leaderboards": []string{leaderboardID}},
+			api.TenantIDHeaderKey, "required-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+
+		headers := signedHeaders("required-game", "required-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100},
+			api.TenantIDHeaderKey, "signed-game")
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should refuse unsigned submissions of unknown tenants once any tenant requires signatures", func() {
+		requireSignatures("required-game", true)
+		defer requireSignatures("required-game", false)
+
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 100})
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, api.TenantIDHeaderKey, "unknown-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, api.TenantIDHeaderKey, "Signed-Game")
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should accept a signed submission again after its write failed", func() {
+		leaderboardID = "testkey-rules-signed-" + uuid.NewV4().String()
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		headers := signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 2000})
+
+		for i := 0; i < 2; i++ {
+			status, body := PutJSON(app, url, map[string]interface{}{"score": 2000}, headers...)
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("breaks rule"))
+		}
+	})
+
+	It("Should refuse signed submissions whose write options don't match the signature", func() {
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		member := &lmodel.Member{PublicID: "member1", Score: 100}
+
+		headers := signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, member)
+		status, body := PutJSON(app, url+"?updatePolicy=sum&scoreTTL=60", map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature doesn't match"))
+
+		headers = signedHeaders("signed-game", "signed-game-secret", lservice.SubmissionOperationSet, member)
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100, "ifAbsent": true}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature doesn't match"))
+
+		headers = signHeaders("signed-game", "signed-game-secret", &lmodel.SignedSubmission{
+			Operation:      lservice.SubmissionOperationSet,
+			Members:        []*lmodel.Member{{PublicID: "member1", Score: 100, Precondition: &lmodel.Precondition{IfAbsent: true}}},
+			UpdatePolicy:   "sum",
+			ScoreTTL:       60,
+			PrevRank:       true,
+			IdempotencyKey: "retry-1",
+		})
+		status, body = PutJSON(app, url+"?updatePolicy=sum&scoreTTL=60&prevRank=true&idempotencyKey=retry-1",
+			map[string]interface{}{"score": 100, "ifAbsent": true}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should answer retries of signed submissions made with an idempotency key", func() {
+		idempotencyKey := uuid.NewV4().String()
+		url := fmt.Sprintf("/l/%s/members/member1/score?idempotencyKey=%s", leaderboardID, idempotencyKey)
+		headers := signHeaders("signed-game", "signed-game-secret", &lmodel.SignedSubmission{
+			Operation:      lservice.SubmissionOperationIncrement,
+			Members:        []*lmodel.Member{{PublicID: "member1", Score: 10}},
+			IdempotencyKey: idempotencyKey,
+		})
+
+		status, firstBody := PatchJSON(app, url, map[string]interface{}{"increment": 10}, headers...)
+		Expect(status).To(Equal(http.StatusOK), firstBody)
+
+		status, body := PatchJSON(app, url, map[string]interface{}{"increment": 10}, headers...)
+		Expect(status).To(Equal(http.StatusOK), body)
+		Expect(body).To(Equal(firstBody))
+	})
+
+	It("Should refuse unsigned submissions of unknown tenants when signatures are required", func() {
+		app.ParsedConfig.SignedSubmissions.Required = true
+		defer func() { app.ParsedConfig.SignedSubmissions.Required = false }()
+
+		url := fmt.Sprintf("/l/%s/members/member1/score", leaderboardID)
+		status, body := PutJSON(app, url, map[string]interface{}{"score": 100})
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, api.TenantIDHeaderKey, "unknown-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		headers := signedHeaders("unknown-game", "any-secret", lservice.SubmissionOperationSet, &lmodel.Member{PublicID: "member1", Score: 100})
+		status, body = PutJSON(app, url, map[string]interface{}{"score": 100}, headers...)
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("has no signing key"))
+	})
+
+	It("Should refuse match and challenge results of tenants that require signatures", func() {
+		requireSignatures("required-game", true)
+		defer requireSignatures("required-game", false)
+
+		status, body := PostJSON(app, fmt.Sprintf("/l/testkey-elo-%s/matches", uuid.NewV4().String()), map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"publicID": "member1", "outcome": "loss"},
+				{"publicID": "member2", "outcome": "win"},
+			},
+		}, api.TenantIDHeaderKey, "required-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+
+		status, body = PostJSON(app, fmt.Sprintf("/l/testkey-ladder-%s/challenges", uuid.NewV4().String()), map[string]interface{}{
+			"challengerPublicId": "member1",
+			"defenderPublicId":   "member2",
+			"challengerWon":      true,
+		}, api.TenantIDHeaderKey, "required-game")
+		Expect(status).To(Equal(http.StatusUnauthorized), body)
+		Expect(body).To(ContainSubstring("signature is required"))
+	})
+})
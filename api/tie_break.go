@@ -0,0 +1,95 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"math"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// loadTieBreaks validates the configured tie-breaks and fills their defaults, it must run after score rules are loaded.
+func (app *App) loadTieBreaks() error {
+	app.tieBreaks = map[string]*lservice.TieBreak{}
+
+	for pattern, tieBreakConfig := range app.ParsedConfig.Leaderboards.TieBreaks {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid tie-break pattern %s: %w", pattern, err)
+		}
+
+		tieBreak := lservice.NewTieBreak(tieBreakConfig.Order)
+		if tieBreak.Order == "" {
+			tieBreak.Order = "desc"
+		}
+		if !tieBreakConfig.Epoch.IsZero() {
+			tieBreak.Epoch = tieBreakConfig.Epoch
+		}
+		if tieBreakConfig.Resolution != 0 {
+			tieBreak.Resolution = tieBreakConfig.Resolution
+		}
+		minScore, maxScore, bounded := app.scoreRulesRange(pattern)
+		if tieBreakConfig.Bits != 0 {
+			tieBreak.Bits = tieBreakConfig.Bits
+		} else if bounded {
+			tieBreak.Bits = lservice.TieBreakBitsFor(int64(math.Ceil(math.Max(-minScore, maxScore))))
+			if tieBreak.Bits == 0 {
+				return fmt.Errorf("invalid tie-break for %s: score rules allow scores from %g to %g, too large to break ties", pattern, minScore, maxScore)
+			}
+		}
+
+		if tieBreak.Order != "asc" && tieBreak.Order != "desc" {
+			return fmt.Errorf("invalid tie-break order for %s: %s", pattern, tieBreak.Order)
+		}
+		if tieBreak.Resolution < 0 {
+			return fmt.Errorf("invalid tie-break resolution for %s: %s", pattern, tieBreak.Resolution)
+		}
+		if tieBreak.Bits > 52 {
+			return fmt.Errorf("invalid tie-break bits for %s: %d, it must be between 1 and 52", pattern, tieBreak.Bits)
+		}
+		if rangeMin, rangeMax := tieBreak.ScoreRange(); bounded && (minScore < float64(rangeMin) || maxScore > float64(rangeMax)) {
+			return fmt.Errorf("invalid tie-break bits for %s: %d bits leave scores from %d to %d, score rules allow %g to %g",
+				pattern, tieBreak.Bits, rangeMin, rangeMax, minScore, maxScore)
+		}
+
+		app.tieBreaks[pattern] = tieBreak
+	}
+
+	return nil
+}
+
+// scoreRulesRange returns the lowest and highest scores score rules allow to leaderboards matching pattern,
+// reporting if every score rules that can apply to them bound both.
+func (app *App) scoreRulesRange(pattern string) (float64, float64, bool) {
+	overlapping := config.OverlappingPatterns(app.scoreRules, pattern)
+	if len(overlapping) == 0 {
+		return 0, 0, false
+	}
+
+	minScore, maxScore := math.Inf(1), math.Inf(-1)
+	for _, rulesPattern := range overlapping {
+		rules := app.scoreRules[rulesPattern]
+		if rules.MinScore == nil || rules.MaxScore == nil {
+			return 0, 0, false
+		}
+		minScore = math.Min(minScore, *rules.MinScore)
+		maxScore = math.Max(maxScore, *rules.MaxScore)
+	}
+
+	return minScore, maxScore, true
+}
+
+// getTieBreak returns the tie-break of a leaderboard, nil if members with equal scores are ordered by public ID.
+func (app *App) getTieBreak(leaderboardID string) *lservice.TieBreak {
+	tieBreak, _ := config.MatchLeaderboardPattern(app.tieBreaks, leaderboardID)
+	return tieBreak
+}
@@ -0,0 +1,140 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+// loadTieredLeagues validates the configured tiered leagues and builds them, the leaderboards of each tier
+// are handled like the ones of a season family whose final standings are always archived.
+func (app *App) loadTieredLeagues() error {
+	tieredLeagues := map[string]*lservice.TieredLeague{}
+
+	for leagueID, leagueConfig := range app.ParsedConfig.Leaderboards.TieredLeagues {
+		if !expiration.IsValidCadence(leagueConfig.Cadence) {
+			return fmt.Errorf("invalid tiered league cadence for %s: %s", leagueID, leagueConfig.Cadence)
+		}
+		if len(leagueConfig.Tiers) == 0 {
+			return fmt.Errorf("invalid tiered league %s: it must have tiers", leagueID)
+		}
+		if leagueConfig.BucketSize <= 0 {
+			return fmt.Errorf("invalid tiered league bucket size for %s: %d", leagueID, leagueConfig.BucketSize)
+		}
+		if leagueConfig.Promote < 0 || leagueConfig.Relegate < 0 {
+			return fmt.Errorf("invalid tiered league %s: promote and relegate can't be negative", leagueID)
+		}
+
+		tiers := map[string]bool{}
+		for _, tier := range leagueConfig.Tiers {
+			if tier == "" || tiers[tier] {
+				return fmt.Errorf("invalid tiered league %s: tiers must be unique and not empty", leagueID)
+			}
+			tiers[tier] = true
+		}
+
+		season, err := newSeasonSettings(leagueConfig.SeasonConfig, app.defaultSeason)
+		if err != nil {
+			return fmt.Errorf("invalid tiered league %s: %w", leagueID, err)
+		}
+		season.archive = true
+
+		prefix := leagueConfig.Prefix
+		if prefix == "" {
+			prefix = leagueID + "-"
+		}
+
+		tieredLeague := &lservice.TieredLeague{
+			Prefix:     prefix,
+			Cadence:    expiration.Cadence(leagueConfig.Cadence),
+			Location:   season.policy.Location,
+			Tiers:      leagueConfig.Tiers,
+			BucketSize: leagueConfig.BucketSize,
+			Promote:    leagueConfig.Promote,
+			Relegate:   leagueConfig.Relegate,
+		}
+		for _, tier := range tieredLeague.Tiers {
+			app.seasonFamilySeasons[tieredLeague.TierPrefix(tier)] = season
+		}
+		tieredLeagues[leagueID] = tieredLeague
+	}
+
+	app.tieredLeagues = tieredLeagues
+	return nil
+}
+
+// getTieredLeague returns the tiered league of an ID, nil if it isn't one.
+func (app *App) getTieredLeague(leagueID string) *lservice.TieredLeague {
+	return app.tieredLeagues[strings.ToLower(leagueID)]
+}
+
+// getTierLeague returns the league of a tier leaderboard, nil if leaderboard isn't a tier of any tiered league.
+func (app *App) getTierLeague(leaderboardID string) *lservice.League {
+	for _, tieredLeague := range app.tieredLeagues {
+		if _, ok := tieredLeague.ParseTier(leaderboardID); ok {
+			return &lservice.League{BucketSize: tieredLeague.BucketSize}
+		}
+	}
+	return nil
+}
+
+// GetMemberTier is the handler responsible for retrieving the tier a member plays the current season of a tiered league in.
+func (app *App) GetMemberTier(ctx context.Context, req *api.GetMemberTierRequest) (*api.GetMemberTierResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetMemberTier"),
+		zap.String("tieredLeague", req.TieredLeagueId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	var memberTier *lmodel.MemberTier
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting member tier.")
+		memberTier, err = app.Leaderboards.GetMemberTier(ctx, req.TieredLeagueId, req.MemberPublicId)
+		if err != nil {
+			lg.Error("Get member tier failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Get member tier succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetMemberTierResponse{
+		Success:       true,
+		PublicID:      memberTier.PublicID,
+		Tier:          memberTier.Tier,
+		LeaderboardId: memberTier.Leaderboard,
+		History:       make([]*api.TierMovement, 0, len(memberTier.History)),
+	}
+	for _, movement := range memberTier.History {
+		response.History = append(response.History, &api.TierMovement{
+			LeaderboardId: movement.Leaderboard,
+			SeasonEnd:     int32(movement.SeasonEnd),
+			Tier:          movement.Tier,
+			Rank:          int32(movement.Rank),
+			Movement:      movement.Movement,
+			NextTier:      movement.NextTier,
+		})
+	}
+	return response, nil
+}
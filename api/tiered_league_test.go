@@ -0,0 +1,112 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/topfreegames/podium/api"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	. "github.com/topfreegames/podium/testing"
+)
+
+var _ = Describe("Tiered League Handler", func() {
+	var app *api.App
+	var redisDatabase *database.Redis
+	var memberID string
+	var leaderboardID string
+
+	BeforeEach(func() {
+		app = GetDefaultTestApp()
+		InitializeTestServer(app)
+
+		redisClient, err := GetTestingRedis(app)
+		Expect(err).NotTo(HaveOccurred())
+		redisDatabase = &database.Redis{Client: redisClient}
+
+		memberID = "member-" + uuid.NewV4().String()
+		leaderboardID = ""
+	})
+
+	AfterEach(func() {
+		if leaderboardID != "" {
+			app.Leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			redisDatabase.ZRem(context.Background(), redisDatabase.Keys.SeasonRollovers(), lservice.LeagueBucket(leaderboardID, 0))
+		}
+		redisDatabase.Del(context.Background(), redisDatabase.Keys.TierHistory("test-tiered"))
+	})
+
+	It("Should get the lowest tier and its current leaderboard for new members", func() {
+		status, body := Get(app, fmt.Sprintf("/tiered-leagues/test-tiered/members/%s", memberID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		Expect(result["publicID"]).To(Equal(memberID))
+		Expect(result["tier"]).To(Equal("bronze"))
+		Expect(result["history"]).To(BeEmpty())
+
+		leaderboardID = result["leaderboardId"].(string)
+		Expect(strings.HasPrefix(leaderboardID, "test-tiered-bronze-")).To(BeTrue())
+
+		status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/%s/score", leaderboardID, memberID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		bucket, err := app.Leaderboards.GetMemberLeaderboard(NewEmptyCtx(), leaderboardID, memberID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucket).To(Equal(lservice.LeagueBucket(leaderboardID, 0)))
+	})
+
+	It("Should get the tier member was moved to when its latest season ended", func() {
+		seasonEnd := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+		err := redisDatabase.RecordTierMovements(NewEmptyCtx(), "test-tiered", map[string]*database.TierMovement{
+			memberID: {
+				Leaderboard: "test-tiered-bronze-season1~0",
+				SeasonEnd:   seasonEnd,
+				Tier:        "bronze",
+				Rank:        1,
+				Movement:    lservice.TierPromoted,
+				NextTier:    "silver",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status, body := Get(app, fmt.Sprintf("/tiered-leagues/test-tiered/members/%s", memberID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["tier"]).To(Equal("silver"))
+		Expect(result["history"]).To(Equal([]interface{}{map[string]interface{}{
+			"leaderboardId": "test-tiered-bronze-season1~0",
+			"seasonEnd":     float64(seasonEnd.Unix()),
+			"tier":          "bronze",
+			"rank":          float64(1),
+			"movement":      "promoted",
+			"nextTier":      "silver",
+		}}))
+	})
+
+	It("Should return 404 if tiered league isn't configured", func() {
+		status, body := Get(app, fmt.Sprintf("/tiered-leagues/unknown/members/%s", memberID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+})
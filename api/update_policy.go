@@ -0,0 +1,56 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (app *App) validateUpdatePolicies() error {
+	leaderboardsConfig := app.ParsedConfig.Leaderboards
+	if !database.IsValidUpdatePolicy(leaderboardsConfig.DefaultUpdatePolicy) {
+		return fmt.Errorf("invalid default update policy: %s", leaderboardsConfig.DefaultUpdatePolicy)
+	}
+
+	for pattern, updatePolicy := range leaderboardsConfig.UpdatePolicies {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid update policy pattern %s: %w", pattern, err)
+		}
+		if !database.IsValidUpdatePolicy(updatePolicy) {
+			return fmt.Errorf("invalid update policy for %s: %s", pattern, updatePolicy)
+		}
+	}
+
+	return nil
+}
+
+// getUpdatePolicy returns the update policy of the request if it's valid, an empty one is resolved by the
+// leaderboard client from the leaderboard definition and then the configured update policies.
+func getUpdatePolicy(updatePolicy string) (string, error) {
+	if !database.IsValidUpdatePolicy(updatePolicy) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid update policy: %s", updatePolicy)
+	}
+	return updatePolicy, nil
+}
+
+// getConfiguredUpdatePolicy returns the update policy configured to the leaderboard, otherwise the default one.
+func (app *App) getConfiguredUpdatePolicy(leaderboardID string) string {
+	leaderboardsConfig := app.ParsedConfig.Leaderboards
+	if updatePolicy, ok := config.MatchLeaderboardPattern(leaderboardsConfig.UpdatePolicies, leaderboardID); ok {
+		return updatePolicy
+	}
+	return leaderboardsConfig.DefaultUpdatePolicy
+}
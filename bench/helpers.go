@@ -119,13 +119,15 @@ func generateNMembers(amount int) string {
 			Port:           config.GetInt("redis.port"),
 			Password:       config.GetString("redis.password"),
 			DB:             config.GetInt("redis.db"),
+			KeySchema:      database.KeySchemaVersion(config.GetInt("redis.keys.schema")),
+			KeyPrefix:      config.GetString("redis.keys.prefix"),
 		}),
 	)
 
 	lbID := "leaderboard-0"
 
 	for i := 0; i < amount; i++ {
-		client.SetMemberScore(context.Background(), lbID, fmt.Sprintf("bench-member-%d", i), int64(100+i), false, "inf")
+		client.SetMemberScore(context.Background(), lbID, fmt.Sprintf("bench-member-%d", i), float64(100+i), false, "inf", "")
 	}
 
 	return lbID
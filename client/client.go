@@ -57,7 +57,7 @@ var (
 type Member struct {
 	LeaderboardID string
 	PublicID      string
-	Score         int
+	Score         float64
 	Rank          int
 	PreviousRank  int
 }
@@ -73,7 +73,7 @@ type MemberList struct {
 type Score struct {
 	LeaderboardID string
 	PublicID      string
-	Score         int
+	Score         float64
 	Rank          int
 	PreviousRank  int
 }
@@ -308,7 +308,7 @@ func (p *Podium) GetTopPercent(ctx context.Context, leaderboard string, percenta
 }
 
 // UpdateScore updates the score of a particular member in a leaderboard
-func (p *Podium) UpdateScore(ctx context.Context, leaderboard, memberID string, score, scoreTTL int) (*Member, error) {
+func (p *Podium) UpdateScore(ctx context.Context, leaderboard, memberID string, score float64, scoreTTL int) (*Member, error) {
 	route := p.buildUpdateScoreURL(leaderboard, memberID, scoreTTL)
 	payload := map[string]interface{}{
 		"score": score,
@@ -326,7 +326,7 @@ func (p *Podium) UpdateScore(ctx context.Context, leaderboard, memberID string,
 }
 
 // IncrementScore increments the score of a particular member in a leaderboard
-func (p *Podium) IncrementScore(ctx context.Context, leaderboard, memberID string, increment, scoreTTL int) (*Member, error) {
+func (p *Podium) IncrementScore(ctx context.Context, leaderboard, memberID string, increment float64, scoreTTL int) (*Member, error) {
 	route := p.buildIncrementScoreURL(leaderboard, memberID, scoreTTL)
 	payload := map[string]interface{}{
 		"increment": increment,
@@ -344,7 +344,7 @@ func (p *Podium) IncrementScore(ctx context.Context, leaderboard, memberID strin
 }
 
 // UpdateScores updates the score of a member in more than one leaderboard
-func (p *Podium) UpdateScores(ctx context.Context, leaderboards []string, memberID string, score, scoreTTL int) (*ScoreList, error) {
+func (p *Podium) UpdateScores(ctx context.Context, leaderboards []string, memberID string, score float64, scoreTTL int) (*ScoreList, error) {
 	route := p.buildUpdateScoresURL(memberID, scoreTTL)
 	payload := map[string]interface{}{
 		"score":        score,
@@ -48,10 +48,10 @@ var _ = Describe("client", func() {
 			Expect(scores).NotTo(BeNil())
 			Expect(len(scores.Scores)).To(Equal(3))
 			Expect(scores.Scores[0].LeaderboardID).To(Equal("l1"))
-			Expect(scores.Scores[0].Score).To(Equal(3))
+			Expect(scores.Scores[0].Score).To(Equal(float64(3)))
 			Expect(scores.Scores[0].Rank).To(Equal(3))
 			Expect(scores.Scores[1].LeaderboardID).To(Equal("l2"))
-			Expect(scores.Scores[1].Score).To(Equal(1))
+			Expect(scores.Scores[1].Score).To(Equal(float64(1)))
 			Expect(scores.Scores[1].Rank).To(Equal(3))
 		})
 	})
@@ -70,7 +70,7 @@ var _ = Describe("client", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).NotTo(BeNil())
 			Expect(members.Members[0].PublicID).To(Equal("1"))
-			Expect(members.Members[0].Score).To(Equal(2))
+			Expect(members.Members[0].Score).To(Equal(float64(2)))
 			Expect(len(members.Members)).To(Equal(1))
 		})
 	})
@@ -88,7 +88,7 @@ var _ = Describe("client", func() {
 
 			Expect(members).NotTo(BeNil())
 			Expect(members.Members[0].PublicID).To(Equal("1"))
-			Expect(members.Members[0].Score).To(Equal(2))
+			Expect(members.Members[0].Score).To(Equal(float64(2)))
 			Expect(len(members.Members)).To(Equal(1))
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -107,7 +107,7 @@ var _ = Describe("client", func() {
 
 			Expect(member).NotTo(BeNil())
 			Expect(member.PublicID).To(Equal("1"))
-			Expect(member.Score).To(Equal(2))
+			Expect(member.Score).To(Equal(float64(2)))
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -123,7 +123,7 @@ var _ = Describe("client", func() {
 
 			Expect(member).NotTo(BeNil())
 			Expect(member.PublicID).To(Equal("1"))
-			Expect(member.Score).To(Equal(2))
+			Expect(member.Score).To(Equal(float64(2)))
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
@@ -141,7 +141,7 @@ var _ = Describe("client", func() {
 
 			Expect(member).NotTo(BeNil())
 			Expect(member.PublicID).To(Equal("123"))
-			Expect(member.Score).To(Equal(12))
+			Expect(member.Score).To(Equal(float64(12)))
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -157,7 +157,7 @@ var _ = Describe("client", func() {
 
 			Expect(member).NotTo(BeNil())
 			Expect(member.PublicID).To(Equal("123"))
-			Expect(member.Score).To(Equal(12))
+			Expect(member.Score).To(Equal(float64(12)))
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
@@ -213,7 +213,7 @@ var _ = Describe("client", func() {
 
 			Expect(members).NotTo(BeNil())
 			Expect(members.Members[0].PublicID).To(Equal("1"))
-			Expect(members.Members[0].Score).To(Equal(2))
+			Expect(members.Members[0].Score).To(Equal(float64(2)))
 			Expect(members.Members[0].Rank).To(Equal(1))
 			Expect(members.Members[0].PreviousRank).To(Equal(1))
 			Expect(len(members.Members)).To(Equal(1))
@@ -232,7 +232,7 @@ var _ = Describe("client", func() {
 
 			Expect(members).NotTo(BeNil())
 			Expect(members.Members[0].PublicID).To(Equal("1"))
-			Expect(members.Members[0].Score).To(Equal(2))
+			Expect(members.Members[0].Score).To(Equal(float64(2)))
 			Expect(members.Members[0].Rank).To(Equal(1))
 			Expect(members.Members[0].PreviousRank).To(Equal(1))
 			Expect(len(members.Members)).To(Equal(1))
@@ -271,7 +271,7 @@ var _ = Describe("client", func() {
 
 			Expect(member).NotTo(BeNil())
 			Expect(member.PublicID).To(Equal("1"))
-			Expect(member.Score).To(Equal(2))
+			Expect(member.Score).To(Equal(float64(2)))
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
@@ -553,9 +553,9 @@ var _ = Describe("client", func() {
 			Expect(err).To(BeNil())
 			Expect(members).NotTo(BeNil())
 			Expect(members.Members[0].PublicID).To(Equal("1"))
-			Expect(members.Members[0].Score).To(Equal(5))
+			Expect(members.Members[0].Score).To(Equal(float64(5)))
 			Expect(members.Members[1].PublicID).To(Equal("3"))
-			Expect(members.Members[1].Score).To(Equal(4))
+			Expect(members.Members[1].Score).To(Equal(float64(4)))
 			Expect(members.NotFound[0]).To(Equal("2"))
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -13,9 +13,9 @@ type PodiumInterface interface {
 	GetTop(ctx context.Context, leaderboard string, page, pageSize int) (*MemberList, error)
 	GetTopPercent(ctx context.Context, leaderboard string, percentage int) (*MemberList, error)
 	Healthcheck(ctx context.Context) (string, error)
-	IncrementScore(ctx context.Context, leaderboard, memberID string, increment, scoreTTL int) (*Member, error)
+	IncrementScore(ctx context.Context, leaderboard, memberID string, increment float64, scoreTTL int) (*Member, error)
 	RemoveMemberFromLeaderboard(ctx context.Context, leaderboard, member string) (*Response, error)
-	UpdateScore(ctx context.Context, leaderboard, memberID string, score, scoreTTL int) (*Member, error)
-	UpdateScores(ctx context.Context, leaderboards []string, memberID string, score, scoreTTL int) (*ScoreList, error)
+	UpdateScore(ctx context.Context, leaderboard, memberID string, score float64, scoreTTL int) (*Member, error)
+	UpdateScores(ctx context.Context, leaderboards []string, memberID string, score float64, scoreTTL int) (*ScoreList, error)
 	UpdateMembersScore(ctx context.Context, leaderboard string, members []*Member, scoreTTL int) (*MemberList, error)
 }
@@ -163,7 +163,7 @@ func (mr *MockPodiumInterfaceMockRecorder) Healthcheck(arg0 interface{}) *gomock
 }
 
 // IncrementScore mocks base method
-func (m *MockPodiumInterface) IncrementScore(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) (*client.MemberList, error) {
+func (m *MockPodiumInterface) IncrementScore(arg0 context.Context, arg1, arg2 string, arg3 float64, arg4 int) (*client.MemberList, error) {
 	ret := m.ctrl.Call(m, "IncrementScore", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*client.MemberList)
 	ret1, _ := ret[1].(error)
@@ -202,7 +202,7 @@ func (mr *MockPodiumInterfaceMockRecorder) UpdateMembersScore(arg0, arg1, arg2,
 }
 
 // UpdateScore mocks base method
-func (m *MockPodiumInterface) UpdateScore(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) (*client.Member, error) {
+func (m *MockPodiumInterface) UpdateScore(arg0 context.Context, arg1, arg2 string, arg3 float64, arg4 int) (*client.Member, error) {
 	ret := m.ctrl.Call(m, "UpdateScore", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*client.Member)
 	ret1, _ := ret[1].(error)
@@ -215,7 +215,7 @@ func (mr *MockPodiumInterfaceMockRecorder) UpdateScore(arg0, arg1, arg2, arg3, a
 }
 
 // UpdateScores mocks base method
-func (m *MockPodiumInterface) UpdateScores(arg0 context.Context, arg1 []string, arg2 string, arg3, arg4 int) (*client.ScoreList, error) {
+func (m *MockPodiumInterface) UpdateScores(arg0 context.Context, arg1 []string, arg2 string, arg3 float64, arg4 int) (*client.ScoreList, error) {
 	ret := m.ctrl.Call(m, "UpdateScores", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*client.ScoreList)
 	ret1, _ := ret[1].(error)
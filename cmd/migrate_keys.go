@@ -0,0 +1,111 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/log"
+	"go.uber.org/zap"
+)
+
+var fromKeySchema int
+var fromKeyPrefix string
+var migratedLeaderboards []string
+var applyMigration bool
+
+// migrateKeysCmd represents the migrate-keys command
+var migrateKeysCmd = &cobra.Command{
+	Use:   "migrate-keys",
+	Short: "moves leaderboards to the configured redis key schema",
+	Long: `moves every leaderboard stored with the key schema and prefix given by flags to the key schema and prefix
+	configured in redis.keys, then indexes the distinct scores of leaderboards that have no index, which dense
+	ranks need. podium API and worker must be stopped while keys are migrated. Nothing is moved nor indexed
+	unless --apply is given, leaderboards that would be are only counted`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ll := zap.InfoLevel
+		if debug {
+			ll = zap.DebugLevel
+		}
+		logger := log.CreateLoggerWithLevel(ll, log.LoggerOptions{WriteSyncer: os.Stdout})
+		logger = logger.With(
+			zap.String("source", "migrate-keys"),
+		)
+
+		defer logger.Sync()
+
+		c, err := config.GetDefaultConfig(ConfigFile)
+		if err != nil {
+			logger.Fatal("Could not load configuration.", zap.Error(err))
+		}
+		c.SetDefault("redis.keys.schema", int(database.KeySchemaV1))
+		c.SetDefault("redis.keys.prefix", "")
+
+		from := database.Keys{
+			Version: database.KeySchemaVersion(fromKeySchema),
+			Prefix:  fromKeyPrefix,
+		}
+
+		redisDatabase := database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: c.GetBool("redis.cluster.enabled"),
+			Addrs:          c.GetStringSlice("redis.addrs"),
+			Host:           c.GetString("redis.host"),
+			Port:           c.GetInt("redis.port"),
+			Password:       c.GetString("redis.password"),
+			DB:             c.GetInt("redis.db"),
+			KeySchema:      database.KeySchemaVersion(c.GetInt("redis.keys.schema")),
+			KeyPrefix:      c.GetString("redis.keys.prefix"),
+		})
+
+		logger.Info(
+			"Migrating leaderboard keys...",
+			zap.Int("fromKeySchema", int(from.Version)),
+			zap.String("fromKeyPrefix", from.Prefix),
+			zap.Int("toKeySchema", int(redisDatabase.Keys.Version)),
+			zap.String("toKeyPrefix", redisDatabase.Keys.Prefix),
+			zap.Strings("leaderboards", migratedLeaderboards),
+			zap.Bool("apply", applyMigration),
+		)
+
+		options := &database.MigrationOptions{
+			Leaderboards: migratedLeaderboards,
+			DryRun:       !applyMigration,
+		}
+		migrated, err := redisDatabase.MigrateKeys(context.Background(), from, options)
+		if err != nil {
+			logger.Fatal("Could not migrate leaderboard keys.", zap.Int("migrated", migrated), zap.Error(err))
+		}
+
+		// leaderboards that would be moved in a dry run aren't there to be counted yet
+		indexed, err := redisDatabase.IndexScores(context.Background(), options)
+		if err != nil {
+			logger.Fatal("Could not index leaderboard scores.", zap.Int("migrated", migrated), zap.Int("indexed", indexed), zap.Error(err))
+		}
+
+		if !applyMigration {
+			logger.Info("Dry run, no leaderboard key was moved, run again with --apply to move them.", zap.Int("leaderboards", migrated), zap.Int("unindexedLeaderboards", indexed))
+			return
+		}
+		logger.Info("Leaderboard keys migrated.", zap.Int("migrated", migrated), zap.Int("indexed", indexed))
+	},
+}
+
+func init() {
+	migrateKeysCmd.Flags().IntVar(&fromKeySchema, "from-schema", int(database.KeySchemaV1), "Key schema version leaderboards are stored with")
+	migrateKeysCmd.Flags().StringVar(&fromKeyPrefix, "from-prefix", "", "Key prefix leaderboards are stored with")
+	migrateKeysCmd.Flags().StringSliceVar(&migratedLeaderboards, "leaderboards", nil, "Glob patterns of the leaderboards to move, required when keys are stored with schema 1 and no prefix")
+	migrateKeysCmd.Flags().BoolVar(&applyMigration, "apply", false, "Move keys instead of only counting the leaderboards that would be moved")
+	migrateKeysCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Debug mode (log=debug)")
+	RootCmd.AddCommand(migrateKeysCmd)
+}
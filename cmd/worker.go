@@ -21,9 +21,9 @@ import (
 // workerCmd represents the worker command
 var workerCmd = &cobra.Command{
 	Use:   "worker",
-	Short: "starts the podium scores expirer worker",
-	Long: `starts the podium worker that expires scores with the specified arguments. you can use environment variables to override
-	configuration keys`,
+	Short: "starts the podium scores expirer and leaderboards rollover worker",
+	Long: `starts the podium worker that expires scores and archives leaderboards final standings when their season ends
+	with the specified arguments. you can use environment variables to override configuration keys`,
 	Run: func(cmd *cobra.Command, args []string) {
 		ll := zap.InfoLevel
 		if debug {
@@ -47,7 +47,14 @@ var workerCmd = &cobra.Command{
 			logger.Fatal("Could not get podium worker.", zap.Error(err))
 		}
 
+		rw, err := worker.GetRolloverWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium rollover worker.", zap.Error(err))
+		}
+
 		expirationsChan := make(chan []*worker.ExpirationResult)
+		rolloversChan := make(chan []*worker.RolloverResult)
 		errChan := make(chan error)
 
 		go func() {
@@ -55,12 +62,15 @@ var workerCmd = &cobra.Command{
 				select {
 				case expirations := <-expirationsChan:
 					logger.Debug("expiration results", zap.Any("result", expirations))
+				case rollovers := <-rolloversChan:
+					logger.Debug("rollover results", zap.Any("result", rollovers))
 				case err := <-errChan:
 					logger.Error("error from worker", zap.Error(err))
 				}
 			}
 		}()
 
+		go rw.Run(rolloversChan, errChan)
 		w.Run(expirationsChan, errChan)
 	},
 }
@@ -29,7 +29,268 @@ func GetDefaultConfig(configFile string) (*viper.Viper, error) {
 
 type (
 	PodiumConfig struct {
-		Enrichment EnrichmentConfig
+		Enrichment        EnrichmentConfig
+		Leaderboards      LeaderboardsConfig
+		SignedSubmissions SignedSubmissionsConfig `mapstructure:"signed_submissions"`
+		Idempotency       IdempotencyConfig
+	}
+
+	LeaderboardsConfig struct {
+		// DefaultUpdatePolicy is how submitted scores are combined with stored ones when neither
+		// the request nor the leaderboard sets an update policy.
+		DefaultUpdatePolicy string `mapstructure:"default_update_policy"`
+
+		// UpdatePolicies maps leaderboard IDs to their default update policy.
+		// Keys are case insensitive glob patterns, like "weekly-*", the most specific one wins.
+		UpdatePolicies map[string]string `mapstructure:"update_policies"`
+
+		// TieBreaks maps leaderboard IDs to the tie-break ordering their members with equal scores by
+		// submission time. Keys are case insensitive glob patterns, like in UpdatePolicies.
+		TieBreaks map[string]TieBreakConfig `mapstructure:"tie_breaks"`
+
+		// CompositeScores maps leaderboard IDs to the criteria of their composite score, in ranking order.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		CompositeScores map[string][]ScoreCriterionConfig `mapstructure:"composite_scores"`
+
+		// ScoreTypes maps leaderboard IDs to the kind of scores they accept, leaderboards matching no pattern
+		// accept integer scores. Keys are case insensitive glob patterns, like in UpdatePolicies.
+		ScoreTypes map[string]ScoreTypeConfig `mapstructure:"score_types"`
+
+		// ScoreRules maps leaderboard IDs to the limits of the scores written to them, leaderboards matching
+		// no pattern accept any score. Keys are case insensitive glob patterns, like in UpdatePolicies.
+		ScoreRules map[string]ScoreRulesConfig `mapstructure:"score_rules"`
+
+		// DefaultRankMode is how members with equal scores are ranked when neither the request nor
+		// the leaderboard sets a rank mode: ordinal, competition or dense.
+		DefaultRankMode string `mapstructure:"default_rank_mode"`
+
+		// RankModes maps leaderboard IDs to their default rank mode.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		RankModes map[string]string `mapstructure:"rank_modes"`
+
+		// DefaultSeason is how seasons are read from leaderboard names and how long their leaderboards are
+		// kept, for leaderboards that match no pattern of Seasons.
+		DefaultSeason SeasonConfig `mapstructure:"default_season"`
+
+		// Seasons maps leaderboard IDs to their season settings, unset ones are taken from DefaultSeason.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		Seasons map[string]SeasonConfig `mapstructure:"seasons"`
+
+		// SeasonFamilies maps season family IDs to how they are resolved to their current seasonal leaderboard.
+		SeasonFamilies map[string]SeasonFamilyConfig `mapstructure:"season_families"`
+
+		// Rewards maps leaderboard IDs to the reward tiers evaluated when their season is archived.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		Rewards map[string]RewardsConfig `mapstructure:"rewards"`
+
+		// Leagues maps leaderboard IDs to how their members are split in buckets ranked on their own.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		Leagues map[string]LeagueConfig `mapstructure:"leagues"`
+
+		// TieredLeagues maps tiered league IDs to their tiers and how members move between them when seasons end.
+		TieredLeagues map[string]TieredLeagueConfig `mapstructure:"tiered_leagues"`
+
+		// Ratings maps leaderboard IDs to how match results submitted to them update member ratings.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		Ratings map[string]RatingConfig `mapstructure:"ratings"`
+
+		// Ladders maps leaderboard IDs to how challenge results reported to them reorder member positions.
+		// Keys are case insensitive glob patterns, like in UpdatePolicies.
+		Ladders map[string]LadderConfig `mapstructure:"ladders"`
+
+		// DefinitionCacheTTL is how long leaderboard definitions are kept in memory once read, definitions
+		// changed through another podium instance are seen after at most this long. Defaults to 10s, zero reads
+		// them on every request.
+		DefinitionCacheTTL time.Duration `mapstructure:"definition_cache_ttl"`
+	}
+
+	ScoreTypeConfig struct {
+		// Type is integer, float or decimal.
+		Type string `mapstructure:"type"`
+
+		// Digits is how many fraction digits the scores of decimal leaderboards have, between 1 and 15.
+		Digits int `mapstructure:"digits"`
+	}
+
+	ScoreRulesConfig struct {
+		// MinScore and MaxScore bound the score a member ends up with, unset means unbounded.
+		MinScore *float64 `mapstructure:"min_score"`
+		MaxScore *float64 `mapstructure:"max_score"`
+
+		// MaxIncrement bounds increments and scores written with the sum update policy, zero means any.
+		MaxIncrement float64 `mapstructure:"max_increment"`
+
+		// MaxDelta bounds how much a member score changes within DeltaWindow, zero means any.
+		MaxDelta    float64       `mapstructure:"max_delta"`
+		DeltaWindow time.Duration `mapstructure:"delta_window"`
+
+		// MaxSubmissions bounds how many scores a member submits within SubmissionWindow, zero means any.
+		MaxSubmissions   int           `mapstructure:"max_submissions"`
+		SubmissionWindow time.Duration `mapstructure:"submission_window"`
+
+		// Quarantine holds writes breaking a rule for review instead of refusing them.
+		Quarantine bool `mapstructure:"quarantine"`
+	}
+
+	LadderConfig struct {
+		// MaxChallengeDistance is how many ranks above the challenger a defender can be, zero means any.
+		MaxChallengeDistance int `mapstructure:"max_challenge_distance"`
+	}
+
+	RatingConfig struct {
+		// Algorithm is the rating algorithm, elo or glicko2. Defaults to elo.
+		Algorithm string `mapstructure:"algorithm"`
+
+		// InitialRating is the rating of members that never played a match. Defaults to 1500.
+		InitialRating float64 `mapstructure:"initial_rating"`
+
+		// KFactor is the largest rating change of an Elo match between two members. Defaults to 32.
+		KFactor float64 `mapstructure:"k_factor"`
+
+		// InitialDeviation is the Glicko-2 rating deviation of members that never played a match. Defaults to 350.
+		InitialDeviation float64 `mapstructure:"initial_deviation"`
+
+		// InitialVolatility is the Glicko-2 volatility of members that never played a match. Defaults to 0.06.
+		InitialVolatility float64 `mapstructure:"initial_volatility"`
+
+		// Tau constrains how fast Glicko-2 volatility changes. Defaults to 0.5.
+		Tau float64 `mapstructure:"tau"`
+	}
+
+	LeagueConfig struct {
+		// BucketSize is how many members a bucket holds before members are assigned to the next one.
+		BucketSize int `mapstructure:"bucket_size"`
+	}
+
+	TieredLeagueConfig struct {
+		// Tiers are ordered from the lowest to the highest, new members play in the lowest one.
+		Tiers []string `mapstructure:"tiers"`
+
+		// BucketSize is how many members of a tier play against each other.
+		BucketSize int `mapstructure:"bucket_size"`
+
+		// Promote is how many of the best members of each bucket move up a tier when the season ends.
+		Promote int `mapstructure:"promote"`
+
+		// Relegate is how many of the worst members of each bucket move down a tier when the season ends.
+		Relegate int `mapstructure:"relegate"`
+
+		// WebhookURL receives the tier movements of each bucket once its season is archived.
+		WebhookURL string `mapstructure:"webhook_url"`
+
+		// SeasonFamilyConfig sets the cadence of seasons and the prefix tiers are appended to, like ranked- for
+		// ranked-gold-year2026week42. Tier leaderboards are always archived.
+		SeasonFamilyConfig `mapstructure:",squash"`
+	}
+
+	RewardsConfig struct {
+		// Order is the order members are ranked in by reward tiers, asc or desc. Defaults to desc.
+		Order string `mapstructure:"order"`
+
+		// Tiers are evaluated in order, each one rewards the ranks not rewarded by the previous ones.
+		Tiers []RewardTierConfig `mapstructure:"tiers"`
+
+		// WebhookURL receives the members of each reward bracket once the leaderboard is archived.
+		WebhookURL string `mapstructure:"webhook_url"`
+	}
+
+	RewardTierConfig struct {
+		// Name identifies the tier in reward brackets.
+		Name string `mapstructure:"name"`
+
+		// MaxRank rewards members ranked from 1 to MaxRank. Only one of MaxRank and TopPercent can be set.
+		MaxRank int `mapstructure:"max_rank"`
+
+		// TopPercent rewards the given percentage of best members, rounded up.
+		TopPercent float64 `mapstructure:"top_percent"`
+	}
+
+	SeasonFamilyConfig struct {
+		// Cadence is how often seasons start: yearly, quarterly, monthly, weekly, daily or hourly.
+		Cadence string `mapstructure:"cadence"`
+
+		// Prefix is prepended to season suffixes to name the seasonal leaderboards. Defaults to the family ID and a dash.
+		Prefix string `mapstructure:"prefix"`
+
+		// SeasonConfig sets the timezone seasons start in and the retention of the seasonal leaderboards,
+		// unset ones are taken from DefaultSeason.
+		SeasonConfig `mapstructure:",squash"`
+	}
+
+	SeasonConfig struct {
+		// Timezone is the IANA time zone of calendar season suffixes, like America/Sao_Paulo. Defaults to UTC.
+		Timezone string `mapstructure:"timezone"`
+
+		// RetentionMultiplier keeps leaderboards for that many times their season length, counted from the
+		// season start. Only one retention can be set, without any leaderboards are kept for twice their season length.
+		RetentionMultiplier float64 `mapstructure:"retention_multiplier"`
+
+		// RetentionGrace keeps leaderboards for that long after their season end.
+		RetentionGrace time.Duration `mapstructure:"retention_grace"`
+
+		// Retention keeps leaderboards for that long after their season start.
+		Retention time.Duration `mapstructure:"retention"`
+
+		// Archive keeps the final standings of leaderboards when their season ends, so they can be read
+		// after leaderboards expire. Defaults to false.
+		Archive *bool `mapstructure:"archive"`
+	}
+
+	ScoreCriterionConfig struct {
+		// Name identifies the criterion in errors.
+		Name string `mapstructure:"name"`
+
+		// Order is asc or desc, how the criterion ranks when the leaderboard is read in desc order. Defaults to desc.
+		Order string `mapstructure:"order"`
+
+		// Bits is how many bits the criterion takes, its values go from 0 to 2^bits-1.
+		Bits uint `mapstructure:"bits"`
+	}
+
+	TieBreakConfig struct {
+		// Order is the leaderboard order, earlier submissions rank higher in it. Defaults to desc.
+		Order string `mapstructure:"order"`
+
+		// Epoch is when submission ticks start to be counted. Defaults to 2020-01-01T00:00:00Z.
+		Epoch time.Time `mapstructure:"epoch"`
+
+		// Resolution is the duration of a submission tick. Defaults to 1s.
+		Resolution time.Duration `mapstructure:"resolution"`
+
+		// Bits is how many bits of the stored score hold the submission tick. Defaults to the most bits leaving
+		// room for the min_score and max_score of the leaderboard score rules, 32 if they don't bound both.
+		Bits uint `mapstructure:"bits"`
+	}
+
+	IdempotencyConfig struct {
+		// Window is how long the response of a write sent with an idempotency key is kept, writes repeated
+		// with the same key within it return that response instead of being applied again. Defaults to 24h,
+		// zero ignores idempotency keys.
+		Window time.Duration `mapstructure:"window"`
+	}
+
+	SignedSubmissionsConfig struct {
+		// Window is how far the timestamp of a signed submission can be from the server clock, nonces are
+		// remembered for twice as long. Defaults to 5m.
+		Window time.Duration `mapstructure:"window"`
+
+		// Tenants maps tenant IDs to how their clients sign score submissions.
+		// The key should be the game tenantID.
+		Tenants map[string]SubmissionSigningConfig `mapstructure:"tenants"`
+
+		// Required refuses unsigned submissions of every tenant, including requests whose tenant ID header
+		// is missing or has no signing key, since clients can send any tenant ID. Defaults to false.
+		Required bool `mapstructure:"required"`
+	}
+
+	SubmissionSigningConfig struct {
+		// Key is the secret submissions are signed with using HMAC-SHA256.
+		Key string `mapstructure:"key"`
+
+		// Required refuses unsigned submissions, otherwise only signed ones are verified. Unsigned submissions
+		// of tenants without a signing key, including requests whose tenant ID header is missing, are refused
+		// too, since clients can send any tenant ID.
+		Required bool `mapstructure:"required"`
 	}
 
 	EnrichmentConfig struct {
@@ -69,6 +330,7 @@ type (
 func DecodeHook() viper.DecoderConfigOption {
 	decodeHook := mapstructure.ComposeDecodeHookFunc(
 		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
 		mapstructure.StringToSliceHookFunc(","),
 		StringToMapStringHookFunc(),
 		StringToMapBoolHookFunc(),
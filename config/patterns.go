@@ -0,0 +1,80 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package config
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchLeaderboardPattern returns the value of the most specific case insensitive glob pattern
+// matching leaderboardID, reporting if any pattern matched.
+func MatchLeaderboardPattern[T any](patterns map[string]T, leaderboardID string) (T, bool) {
+	leaderboardID = strings.ToLower(leaderboardID)
+
+	var value T
+	var matched string
+	for pattern, patternValue := range patterns {
+		if ok, _ := path.Match(pattern, leaderboardID); !ok {
+			continue
+		}
+		if isMoreSpecificPattern(pattern, matched) {
+			matched = pattern
+			value = patternValue
+		}
+	}
+
+	return value, matched != ""
+}
+
+// isMoreSpecificPattern prefers patterns with fewer wildcards, then longer ones, then the smallest one
+// so that the pattern picked doesn't depend on map iteration order.
+func isMoreSpecificPattern(pattern, other string) bool {
+	if other == "" {
+		return true
+	}
+
+	wildcards, otherWildcards := strings.Count(pattern, "*"), strings.Count(other, "*")
+	if wildcards != otherWildcards {
+		return wildcards < otherWildcards
+	}
+	if len(pattern) != len(other) {
+		return len(pattern) > len(other)
+	}
+	return pattern < other
+}
+
+// OverlappingPattern returns a pattern of patterns that can match a leaderboard ID matched by pattern, reporting
+// if there's any.
+func OverlappingPattern[T any](patterns map[string]T, pattern string) (string, bool) {
+	overlapping := OverlappingPatterns(patterns, pattern)
+	if len(overlapping) == 0 {
+		return "", false
+	}
+	return overlapping[0], true
+}
+
+// OverlappingPatterns returns every pattern of patterns that can match a leaderboard ID matched by pattern.
+func OverlappingPatterns[T any](patterns map[string]T, pattern string) []string {
+	pattern = strings.ToLower(pattern)
+
+	overlapping := []string{}
+	for other := range patterns {
+		if ok, _ := path.Match(other, pattern); ok {
+			overlapping = append(overlapping, other)
+			continue
+		}
+		if ok, _ := path.Match(pattern, other); ok {
+			overlapping = append(overlapping, other)
+		}
+	}
+
+	return overlapping
+}
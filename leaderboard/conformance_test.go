@@ -0,0 +1,31 @@
+package leaderboard_test
+
+import (
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/dbtest"
+	"github.com/topfreegames/podium/leaderboard/v2/testing"
+
+	. "github.com/onsi/gomega"
+)
+
+var _ = dbtest.DescribeConformance("Redis Database", func() dbtest.Backend {
+	redisDatabase, err := GetDefaultRedis()
+	Expect(err).NotTo(HaveOccurred())
+	return redisDatabase
+})
+
+var _ = dbtest.DescribeConformance("Redis Database with key schema v2", func() dbtest.Backend {
+	config, err := testing.GetDefaultConfig("../config/test.yaml")
+	Expect(err).NotTo(HaveOccurred())
+
+	return database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: config.GetBool("redis.cluster.enabled"),
+		Addrs:          config.GetStringSlice("redis.addrs"),
+		Host:           config.GetString("redis.host"),
+		Port:           config.GetInt("redis.port"),
+		Password:       config.GetString("redis.password"),
+		DB:             config.GetInt("redis.db"),
+		KeySchema:      database.KeySchemaV2,
+		KeyPrefix:      "dbtest:",
+	})
+})
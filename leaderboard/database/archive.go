@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+)
+
+// Archive interface standardize calls that keep the final standings of seasonal leaderboards
+type Archive interface {
+	GetLeaderboardsToArchive(ctx context.Context, maxTime time.Time, amount int) ([]string, error)
+	ArchiveLeaderboard(ctx context.Context, leaderboard string, options *ArchiveOptions) (*LeaderboardArchive, error)
+	GetLeaderboardArchive(ctx context.Context, leaderboard string) (*LeaderboardArchive, error)
+	GetArchivedOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error)
+	GetArchivedMembers(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error)
+	GetRewardDeliveries(ctx context.Context, maxTime time.Time, amount int) ([]string, error)
+	CompleteRewardDelivery(ctx context.Context, leaderboard string) error
+	RetryRewardDelivery(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error)
+}
+
+// ArchiveOptions define what is evaluated when a leaderboard is archived
+type ArchiveOptions struct {
+	// RewardTiers are evaluated against the archived members, in RewardOrder, to assign their reward brackets
+	RewardTiers []rewards.Tier
+	// RewardOrder is the order members are ranked in by reward brackets, asc or desc
+	RewardOrder string
+	// DeliverRewards schedules the reward brackets to be delivered when any was assigned
+	DeliverRewards bool
+	// MoveTiers schedules the tier movements of the archived members, see Tiers
+	MoveTiers bool
+}
+
+// LeaderboardArchive describe the final standings of a leaderboard season, they are never changed nor expired
+type LeaderboardArchive struct {
+	ID             string            `json:"id"`
+	SeasonEnd      time.Time         `json:"seasonEnd"`
+	ArchivedAt     time.Time         `json:"archivedAt"`
+	TotalMembers   int               `json:"totalMembers"`
+	RewardOrder    string            `json:"rewardOrder,omitempty"`
+	RewardBrackets []rewards.Bracket `json:"rewardBrackets,omitempty"`
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/archive.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockArchive is a mock of Archive interface.
+type MockArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveMockRecorder
+}
+
+// MockArchiveMockRecorder is the mock recorder for MockArchive.
+type MockArchiveMockRecorder struct {
+	mock *MockArchive
+}
+
+// NewMockArchive creates a new mock instance.
+func NewMockArchive(ctrl *gomock.Controller) *MockArchive {
+	mock := &MockArchive{ctrl: ctrl}
+	mock.recorder = &MockArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchive) EXPECT() *MockArchiveMockRecorder {
+	return m.recorder
+}
+
+// ArchiveLeaderboard mocks base method.
+func (m *MockArchive) ArchiveLeaderboard(ctx context.Context, leaderboard string, options *ArchiveOptions) (*LeaderboardArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveLeaderboard", ctx, leaderboard, options)
+	ret0, _ := ret[0].(*LeaderboardArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveLeaderboard indicates an expected call of ArchiveLeaderboard.
+func (mr *MockArchiveMockRecorder) ArchiveLeaderboard(ctx, leaderboard, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveLeaderboard", reflect.TypeOf((*MockArchive)(nil).ArchiveLeaderboard), ctx, leaderboard, options)
+}
+
+// CompleteRewardDelivery mocks base method.
+func (m *MockArchive) CompleteRewardDelivery(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteRewardDelivery", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteRewardDelivery indicates an expected call of CompleteRewardDelivery.
+func (mr *MockArchiveMockRecorder) CompleteRewardDelivery(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteRewardDelivery", reflect.TypeOf((*MockArchive)(nil).CompleteRewardDelivery), ctx, leaderboard)
+}
+
+// GetArchivedMembers mocks base method.
+func (m *MockArchive) GetArchivedMembers(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, order}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetArchivedMembers", varargs...)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedMembers indicates an expected call of GetArchivedMembers.
+func (mr *MockArchiveMockRecorder) GetArchivedMembers(ctx, leaderboard, order interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, order}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedMembers", reflect.TypeOf((*MockArchive)(nil).GetArchivedMembers), varargs...)
+}
+
+// GetArchivedOrderedMembers mocks base method.
+func (m *MockArchive) GetArchivedOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedOrderedMembers", ctx, leaderboard, start, stop, order)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedOrderedMembers indicates an expected call of GetArchivedOrderedMembers.
+func (mr *MockArchiveMockRecorder) GetArchivedOrderedMembers(ctx, leaderboard, start, stop, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedOrderedMembers", reflect.TypeOf((*MockArchive)(nil).GetArchivedOrderedMembers), ctx, leaderboard, start, stop, order)
+}
+
+// GetLeaderboardArchive mocks base method.
+func (m *MockArchive) GetLeaderboardArchive(ctx context.Context, leaderboard string) (*LeaderboardArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardArchive", ctx, leaderboard)
+	ret0, _ := ret[0].(*LeaderboardArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardArchive indicates an expected call of GetLeaderboardArchive.
+func (mr *MockArchiveMockRecorder) GetLeaderboardArchive(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardArchive", reflect.TypeOf((*MockArchive)(nil).GetLeaderboardArchive), ctx, leaderboard)
+}
+
+// GetLeaderboardsToArchive mocks base method.
+func (m *MockArchive) GetLeaderboardsToArchive(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardsToArchive", ctx, maxTime, amount)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardsToArchive indicates an expected call of GetLeaderboardsToArchive.
+func (mr *MockArchiveMockRecorder) GetLeaderboardsToArchive(ctx, maxTime, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardsToArchive", reflect.TypeOf((*MockArchive)(nil).GetLeaderboardsToArchive), ctx, maxTime, amount)
+}
+
+// GetRewardDeliveries mocks base method.
+func (m *MockArchive) GetRewardDeliveries(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardDeliveries", ctx, maxTime, amount)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardDeliveries indicates an expected call of GetRewardDeliveries.
+func (mr *MockArchiveMockRecorder) GetRewardDeliveries(ctx, maxTime, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardDeliveries", reflect.TypeOf((*MockArchive)(nil).GetRewardDeliveries), ctx, maxTime, amount)
+}
+
+// RetryRewardDelivery mocks base method.
+func (m *MockArchive) RetryRewardDelivery(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryRewardDelivery", ctx, leaderboard, maxAttempts, retryInterval)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryRewardDelivery indicates an expected call of RetryRewardDelivery.
+func (mr *MockArchiveMockRecorder) RetryRewardDelivery(ctx, leaderboard, maxAttempts, retryInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryRewardDelivery", reflect.TypeOf((*MockArchive)(nil).RetryRewardDelivery), ctx, leaderboard, maxAttempts, retryInterval)
+}
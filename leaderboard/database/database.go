@@ -7,6 +7,9 @@ import (
 
 // Database interface standardize database calls
 type Database interface {
+	CountMembersAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error)
+	CountScoresAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error)
+	GetIdempotentMembers(ctx context.Context, leaderboard, idempotencyKey string) ([]*Member, error)
 	GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error)
 	GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboard string, min, max string, offset, count int) ([]string, error)
 	GetMembers(ctx context.Context, leaderboard, order string, includeTTL bool, members ...string) ([]*Member, error)
@@ -20,12 +23,89 @@ type Database interface {
 	SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error
 	SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error
 	SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error
+	UpsertMembers(ctx context.Context, leaderboard string, databaseMembers []*Member, options *UpsertOptions) ([]*Member, error)
 }
 
 // Member is a struct to be used by users operations
 type Member struct {
-	Member string
-	Score  float64
-	Rank   int64
-	TTL    time.Time
+	Member       string
+	Score        float64
+	Rank         int64
+	PreviousRank int64
+	TTL          time.Time
+	// ScoreChanged reports if UpsertMembers changed the stored score, new members always count as changed
+	ScoreChanged bool
+	// QuarantineRule is the score rule UpsertMembers held member for review for, empty if member was written
+	QuarantineRule string
+}
+
+// Update policies define how UpsertMembers combine a new score with the stored one
+const (
+	// UpdatePolicyLast replaces the stored score
+	UpdatePolicyLast = "last"
+	// UpdatePolicyBest keeps the best score, the highest one in desc order and the lowest one in asc order
+	UpdatePolicyBest = "best"
+	// UpdatePolicyWorst keeps the worst score, the opposite of UpdatePolicyBest
+	UpdatePolicyWorst = "worst"
+	// UpdatePolicySum adds the new score to the stored one
+	UpdatePolicySum = "sum"
+)
+
+// IsValidUpdatePolicy reports if policy is one of the update policies, empty means UpdatePolicyLast
+func IsValidUpdatePolicy(policy string) bool {
+	switch policy {
+	case "", UpdatePolicyLast, UpdatePolicyBest, UpdatePolicyWorst, UpdatePolicySum:
+		return true
+	}
+	return false
+}
+
+// UpsertOptions define how UpsertMembers writes members and reports them back
+type UpsertOptions struct {
+	// Order used to report members rank, asc or desc, it also defines which score is the best one
+	Order string
+	// UpdatePolicy is how the given score is combined with the stored one, empty means UpdatePolicyLast
+	UpdatePolicy string
+	// ScoreScale, when greater than 1, means scores are stored as score*ScoreScale plus a tie-breaker lower
+	// than ScoreScale, UpdatePolicySum then adds scores and keeps the tie-breaker of the given one
+	ScoreScale float64
+	// PreviousRank reports members rank before the write, -1 if member wasn't on leaderboard
+	PreviousRank bool
+	// TTL is when members should expire, zero value means members never expire
+	TTL time.Time
+	// ExpireAt is when leaderboard should expire if it has no expiration yet, zero value means it never expires
+	ExpireAt time.Time
+	// SeasonEnd is when leaderboard season ends and its final standings should be archived,
+	// zero value means leaderboard is never archived
+	SeasonEnd time.Time
+	// IdempotencyKey, when set, makes the write happen once: members written with the same key are returned
+	// as they were written the first time, without writing them again, until IdempotencyWindow has passed
+	IdempotencyKey    string
+	IdempotencyWindow time.Duration
+	// Preconditions maps members to what their stored score must be for the write to happen, nothing is
+	// written if any of them doesn't hold
+	Preconditions map[string]*Precondition
+	// SumRange, when set, is the range UpdatePolicySum results must be in, compared with stored scores divided
+	// by ScoreScale and rounded down when ScoreScale is greater than 1, nothing is written if any isn't
+	SumRange *ScoreRange
+	// ScoreRules, when set, are checked before anything is written, preconditions and SumRange only apply to
+	// members they don't hold for review
+	ScoreRules *ScoreRules
+}
+
+// ScoreRange is an inclusive range of scores
+type ScoreRange struct {
+	Min float64
+	Max float64
+}
+
+// Precondition is what a member stored score must be for UpsertMembers to write it
+type Precondition struct {
+	// ExpectedScore is the score member must have, compared with stored scores divided by ScoreScale and
+	// rounded down when ScoreScale is greater than 1
+	ExpectedScore *float64
+	// Absent requires member not to be on leaderboard
+	Absent bool
+	// Present requires member to be on leaderboard
+	Present bool
 }
@@ -0,0 +1,1328 @@
+// Package dbtest has a conformance suite that every database.Database, database.Expiration, database.Registry,
+// database.Leagues, database.Ratings and database.Ladders implementation must pass to be used as a podium backend, it is written
+// against Redis semantics
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// Backend is a database that also implements expiration, registry, leagues, ratings and ladders calls
+type Backend interface {
+	database.Database
+	database.Expiration
+	database.Registry
+	database.Leagues
+	database.Ratings
+	database.Ladders
+	database.Submissions
+	database.Nonces
+}
+
+// NewBackend return a backend that must not have any of conformance suite leaderboards
+type NewBackend func() Backend
+
+const (
+	leaderboard        = "dbtest-leaderboard"
+	anotherLeaderboard = "dbtest-another-leaderboard"
+	largeLeaderboard   = "dbtest-large-leaderboard"
+	largeTotal         = 1500
+)
+
+var suiteLeaderboards = []string{leaderboard, anotherLeaderboard, largeLeaderboard}
+
+// DescribeConformance register ginkgo specs checking backends returned by newBackend behave as the redis one,
+// leaderboards written by specs are removed after each one of them
+func DescribeConformance(name string, newBackend NewBackend) bool {
+	return Describe(fmt.Sprintf("%s conformance", name), func() {
+		var backend Backend
+		ctx := context.Background()
+
+		BeforeEach(func() {
+			backend = newBackend()
+
+			err := backend.SetMembers(ctx, leaderboard, []*database.Member{
+				{Member: "member1", Score: 10},
+				{Member: "member2", Score: 20},
+				{Member: "member3", Score: 30},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			for _, suiteLeaderboard := range suiteLeaderboards {
+				removeLeaderboard(ctx, backend, suiteLeaderboard)
+				_ = backend.RemoveLeaderboardDefinition(ctx, suiteLeaderboard)
+				Expect(backend.RemoveLeague(ctx, suiteLeaderboard)).To(Succeed())
+			}
+		})
+
+		Describe("Healthcheck", func() {
+			It("Should not return error", func() {
+				Expect(backend.Healthcheck(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("Ranks", func() {
+			It("Should rank members in both orders", func() {
+				members, err := backend.GetOrderedMembers(ctx, leaderboard, 0, -1, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member3", Score: 30, Rank: 0},
+					{Member: "member2", Score: 20, Rank: 1},
+					{Member: "member1", Score: 10, Rank: 2},
+				}))
+
+				members, err = backend.GetOrderedMembers(ctx, leaderboard, 0, -1, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 10, Rank: 0},
+					{Member: "member2", Score: 20, Rank: 1},
+					{Member: "member3", Score: 30, Rank: 2},
+				}))
+
+				rank, err := backend.GetRank(ctx, leaderboard, "member3", "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rank).To(Equal(0))
+
+				rank, err = backend.GetRank(ctx, leaderboard, "member3", "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rank).To(Equal(2))
+			})
+
+			It("Should return the same rank GetRank and GetMembers report", func() {
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1", "member2", "member3")
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, member := range members {
+					rank, err := backend.GetRank(ctx, leaderboard, member.Member, "desc")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(rank).To(BeEquivalentTo(member.Rank))
+				}
+			})
+
+			It("Should order members with same score by member in asc and reverse it in desc", func() {
+				err := backend.SetMembers(ctx, leaderboard, []*database.Member{
+					{Member: "b", Score: 20},
+					{Member: "a", Score: 20},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.GetOrderedMembers(ctx, leaderboard, 1, 3, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(memberIDs(members)).To(Equal([]string{"a", "b", "member2"}))
+
+				members, err = backend.GetOrderedMembers(ctx, leaderboard, 1, 3, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(memberIDs(members)).To(Equal([]string{"member2", "b", "a"}))
+
+				rank, err := backend.GetRank(ctx, leaderboard, "a", "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rank).To(Equal(3))
+			})
+
+			It("Should move member when score is set or incremented", func() {
+				err := backend.SetMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 25}})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.IncrementMemberScore(ctx, leaderboard, "member2", 20)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.IncrementMemberScore(ctx, leaderboard, "member4", -5)
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.GetOrderedMembers(ctx, leaderboard, 0, -1, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member2", Score: 40, Rank: 0},
+					{Member: "member3", Score: 30, Rank: 1},
+					{Member: "member1", Score: 25, Rank: 2},
+					{Member: "member4", Score: -5, Rank: 3},
+				}))
+			})
+
+			It("Should return error InvalidOrder", func() {
+				_, err := backend.GetRank(ctx, leaderboard, "member1", "invalid")
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+
+				_, err = backend.GetMembers(ctx, leaderboard, "invalid", false, "member1")
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+
+				_, err = backend.GetOrderedMembers(ctx, leaderboard, 0, -1, "invalid")
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+			})
+		})
+
+		Describe("Counting ahead", func() {
+			BeforeEach(func() {
+				err := backend.SetMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member4", Score: 20},
+					{Member: "member5", Score: 20},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should count members and distinct scores better than each score in both orders", func() {
+				counts, err := backend.CountMembersAhead(ctx, leaderboard, "desc", 30, 20, 15, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{0, 1, 4, 4}))
+
+				counts, err = backend.CountScoresAhead(ctx, leaderboard, "desc", 30, 20, 15, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{0, 1, 2, 2}))
+
+				counts, err = backend.CountMembersAhead(ctx, leaderboard, "asc", 30, 20, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{4, 1, 0}))
+
+				counts, err = backend.CountScoresAhead(ctx, leaderboard, "asc", 30, 20, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{2, 1, 0}))
+			})
+
+			It("Should keep distinct scores while members are written and removed", func() {
+				err := backend.IncrementMemberScore(ctx, leaderboard, "member3", 10)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.RemoveMembers(ctx, leaderboard, "member4")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member6", Score: 35},
+				}, &database.UpsertOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+
+				counts, err := backend.CountScoresAhead(ctx, leaderboard, "desc", 40, 35, 30, 20, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{0, 1, 2, 2, 3}))
+
+				err = backend.SetMembers(ctx, leaderboard, []*database.Member{{Member: "member5", Score: 40}})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.RemoveMembers(ctx, leaderboard, "member6")
+				Expect(err).NotTo(HaveOccurred())
+
+				counts, err = backend.CountScoresAhead(ctx, leaderboard, "desc", 40, 20, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{0, 1, 2}))
+			})
+
+			It("Should forget distinct scores of removed leaderboards", func() {
+				err := backend.RemoveLeaderboard(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+
+				counts, err := backend.CountScoresAhead(ctx, leaderboard, "desc", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{0}))
+
+				err = backend.SetMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 5}})
+				Expect(err).NotTo(HaveOccurred())
+
+				counts, err = backend.CountScoresAhead(ctx, leaderboard, "desc", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(Equal([]int{1}))
+			})
+
+			It("Should return error InvalidOrder", func() {
+				_, err := backend.CountMembersAhead(ctx, leaderboard, "invalid", 10)
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+
+				_, err = backend.CountScoresAhead(ctx, leaderboard, "invalid", 10)
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+			})
+		})
+
+		Describe("Missing members", func() {
+			It("Should return nil in GetMembers for members not in leaderboard", func() {
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "invalid", "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					nil,
+					{Member: "member1", Score: 10, Rank: 2},
+				}))
+
+				members, err = backend.GetMembers(ctx, anotherLeaderboard, "desc", true, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{nil}))
+			})
+
+			It("Should return MemberNotFoundError in GetRank", func() {
+				_, err := backend.GetRank(ctx, leaderboard, "invalid", "desc")
+				Expect(err).To(Equal(database.NewMemberNotFoundError(leaderboard, "invalid")))
+
+				_, err = backend.GetRank(ctx, anotherLeaderboard, "member1", "asc")
+				Expect(err).To(Equal(database.NewMemberNotFoundError(anotherLeaderboard, "member1")))
+			})
+
+			It("Should treat missing leaderboards as empty", func() {
+				total, err := backend.GetTotalMembers(ctx, anotherLeaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(0))
+
+				members, err := backend.GetOrderedMembers(ctx, anotherLeaderboard, 0, 10, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(BeEmpty())
+
+				ids, err := backend.GetMemberIDsWithScoreInsideRange(ctx, anotherLeaderboard, "-inf", "+inf", 0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids).To(BeEmpty())
+
+				Expect(backend.RemoveMembers(ctx, anotherLeaderboard, "member1")).To(Succeed())
+				Expect(backend.RemoveLeaderboard(ctx, anotherLeaderboard)).To(Succeed())
+			})
+
+			It("Should ignore missing members when removing", func() {
+				err := backend.RemoveMembers(ctx, leaderboard, "member1", "invalid")
+				Expect(err).NotTo(HaveOccurred())
+
+				total, err := backend.GetTotalMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(2))
+			})
+
+			It("Should remove leaderboard with all its members", func() {
+				err := backend.RemoveLeaderboard(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+
+				total, err := backend.GetTotalMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(0))
+			})
+		})
+
+		Describe("Score ranges", func() {
+			It("Should return members inside score range from highest to lowest", func() {
+				ids, err := backend.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", "20", 0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids).To(Equal([]string{"member2", "member1"}))
+
+				ids, err = backend.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", "(20", 0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids).To(Equal([]string{"member1"}))
+
+				ids, err = backend.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "15", "+inf", 1, 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids).To(Equal([]string{"member2"}))
+			})
+
+			It("Should return GeneralError if range is not a float", func() {
+				_, err := backend.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", "invalid", 0, 1)
+				Expect(err).To(BeAssignableToTypeOf(&database.GeneralError{}))
+			})
+		})
+
+		Describe("Large ranges", func() {
+			BeforeEach(func() {
+				members := make([]*database.Member, 0, largeTotal)
+				for i := 0; i < largeTotal; i++ {
+					members = append(members, &database.Member{Member: largeMemberID(i), Score: float64(i)})
+				}
+
+				err := backend.SetMembers(ctx, largeLeaderboard, members)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should page through every member in both orders", func() {
+				total, err := backend.GetTotalMembers(ctx, largeLeaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(largeTotal))
+
+				const pageSize = 200
+				for start := 0; start < largeTotal; start += pageSize {
+					members, err := backend.GetOrderedMembers(ctx, largeLeaderboard, start, start+pageSize-1, "desc")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(members).To(HaveLen(int(math.Min(pageSize, float64(largeTotal-start)))))
+
+					for i, member := range members {
+						rank := start + i
+						Expect(member.Rank).To(BeEquivalentTo(rank))
+						Expect(member.Member).To(Equal(largeMemberID(largeTotal - 1 - rank)))
+					}
+				}
+
+				members, err := backend.GetOrderedMembers(ctx, largeLeaderboard, largeTotal-2, largeTotal+100, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(memberIDs(members)).To(Equal([]string{largeMemberID(largeTotal - 2), largeMemberID(largeTotal - 1)}))
+
+				members, err = backend.GetOrderedMembers(ctx, largeLeaderboard, largeTotal, largeTotal+100, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(BeEmpty())
+			})
+
+			It("Should report ranks of members spread across leaderboard", func() {
+				ids := []string{largeMemberID(0), largeMemberID(largeTotal / 2), largeMemberID(largeTotal - 1)}
+				members, err := backend.GetMembers(ctx, largeLeaderboard, "asc", false, ids...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: ids[0], Score: 0, Rank: 0},
+					{Member: ids[1], Score: largeTotal / 2, Rank: largeTotal / 2},
+					{Member: ids[2], Score: largeTotal - 1, Rank: largeTotal - 1},
+				}))
+
+				err = backend.RemoveMembers(ctx, largeLeaderboard, largeMemberID(largeTotal-1))
+				Expect(err).NotTo(HaveOccurred())
+
+				rank, err := backend.GetRank(ctx, largeLeaderboard, largeMemberID(0), "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rank).To(Equal(largeTotal - 2))
+			})
+
+			It("Should limit score ranges with offset and count", func() {
+				ids, err := backend.GetMemberIDsWithScoreInsideRange(ctx, largeLeaderboard, "-inf", "999", 10, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids).To(Equal([]string{largeMemberID(989), largeMemberID(988), largeMemberID(987)}))
+			})
+		})
+
+		Describe("Leaderboard expiration", func() {
+			It("Should return TTLNotFoundError if leaderboard has no expiration", func() {
+				_, err := backend.GetLeaderboardExpiration(ctx, leaderboard)
+				Expect(err).To(Equal(database.NewTTLNotFoundError(leaderboard)))
+			})
+
+			It("Should return GeneralError if leaderboard does not exist", func() {
+				_, err := backend.GetLeaderboardExpiration(ctx, anotherLeaderboard)
+				Expect(err).To(BeAssignableToTypeOf(&database.GeneralError{}))
+
+				err = backend.SetLeaderboardExpiration(ctx, anotherLeaderboard, time.Now().Add(time.Hour))
+				Expect(err).To(BeAssignableToTypeOf(&database.GeneralError{}))
+			})
+
+			It("Should report time left until leaderboard expires", func() {
+				err := backend.SetLeaderboardExpiration(ctx, leaderboard, time.Now().Add(time.Hour))
+				Expect(err).NotTo(HaveOccurred())
+
+				expiration, err := backend.GetLeaderboardExpiration(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Duration(expiration)).To(BeNumerically("~", time.Hour, 2*time.Second))
+			})
+
+			It("Should remove leaderboard once expiration time is reached", func() {
+				err := backend.SetLeaderboardExpiration(ctx, leaderboard, time.Now().Add(-time.Second))
+				Expect(err).NotTo(HaveOccurred())
+
+				total, err := backend.GetTotalMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(0))
+
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{nil}))
+			})
+		})
+
+		Describe("Members TTL", func() {
+			It("Should report members TTL only when asked", func() {
+				ttl := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+				err := backend.SetMembersTTL(ctx, leaderboard, []*database.Member{{Member: "member1", TTL: ttl}})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", true, "member1", "member2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].TTL).To(Equal(ttl))
+				Expect(members[1].TTL).To(BeZero())
+
+				members, err = backend.GetMembers(ctx, leaderboard, "desc", false, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].TTL).To(BeZero())
+			})
+
+			It("Should return members to expire ordered by TTL and limited by amount", func() {
+				now := time.Now()
+				err := backend.SetMembersTTL(ctx, leaderboard, []*database.Member{
+					{Member: "member1", TTL: now.Add(-time.Minute)},
+					{Member: "member2", TTL: now.Add(-time.Hour)},
+					{Member: "member3", TTL: now.Add(time.Hour)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.GetMembersToExpire(ctx, leaderboard, 10, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]string{"member2", "member1"}))
+
+				members, err = backend.GetMembersToExpire(ctx, leaderboard, 1, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]string{"member2"}))
+			})
+
+			It("Should return LeaderboardWithoutMemberToExpireError if no member has TTL", func() {
+				_, err := backend.GetMembersToExpire(ctx, leaderboard, 10, time.Now())
+				Expect(err).To(Equal(database.NewLeaderboardWithoutMemberToExpireError(leaderboard)))
+			})
+
+			It("Should expire members from leaderboard and TTL set", func() {
+				now := time.Now()
+				err := backend.SetMembersTTL(ctx, leaderboard, []*database.Member{
+					{Member: "member1", TTL: now.Add(-time.Minute)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.ExpireMembers(ctx, leaderboard, []string{"member1"})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", true, "member1", "member2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					nil,
+					{Member: "member2", Score: 20, Rank: 1},
+				}))
+
+				_, err = backend.GetMembersToExpire(ctx, leaderboard, 10, now)
+				Expect(err).To(Equal(database.NewLeaderboardWithoutMemberToExpireError(leaderboard)))
+			})
+		})
+
+		Describe("Expiration set", func() {
+			It("Should list leaderboards with members TTL until they are removed from expire list", func() {
+				leaderboards, err := backend.GetExpirationLeaderboards(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(leaderboards).NotTo(ContainElement(leaderboard))
+
+				err = backend.SetMembersTTL(ctx, leaderboard, []*database.Member{
+					{Member: "member1", TTL: time.Now().Add(time.Hour)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = backend.UpsertMembers(ctx, anotherLeaderboard, []*database.Member{
+					{Member: "member1", Score: 1},
+				}, &database.UpsertOptions{Order: "desc", TTL: time.Now().Add(time.Hour)})
+				Expect(err).NotTo(HaveOccurred())
+
+				leaderboards, err = backend.GetExpirationLeaderboards(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(leaderboards).To(ContainElements(leaderboard, anotherLeaderboard))
+
+				err = backend.RemoveLeaderboardFromExpireList(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+
+				leaderboards, err = backend.GetExpirationLeaderboards(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(leaderboards).NotTo(ContainElement(leaderboard))
+				Expect(leaderboards).To(ContainElement(anotherLeaderboard))
+			})
+		})
+
+		Describe("UpsertMembers", func() {
+			It("Should write members only if all their preconditions hold", func() {
+				expectedScore := 10.0
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 50},
+					{Member: "member2", Score: 60},
+					{Member: "member4", Score: 5},
+				}, &database.UpsertOptions{Order: "desc", Preconditions: map[string]*database.Precondition{
+					"member1": {ExpectedScore: &expectedScore},
+					"member2": {Present: true},
+					"member4": {Absent: true},
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(HaveLen(3))
+
+				for _, test := range []struct {
+					member       string
+					precondition *database.Precondition
+					currentScore *float64
+				}{
+					{"member1", &database.Precondition{ExpectedScore: &expectedScore}, &members[0].Score},
+					{"member4", &database.Precondition{Absent: true}, &members[2].Score},
+					{"member5", &database.Precondition{Present: true}, nil},
+					{"member5", &database.Precondition{ExpectedScore: &expectedScore}, nil},
+				} {
+					_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+						{Member: "member3", Score: 100},
+						{Member: test.member, Score: 100},
+					}, &database.UpsertOptions{Order: "desc", Preconditions: map[string]*database.Precondition{
+						test.member: test.precondition,
+					}})
+					Expect(err).To(Equal(database.NewPreconditionFailedError(leaderboard, test.member, test.currentScore)))
+				}
+
+				stored, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member3", "member5")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored[0].Score).To(Equal(float64(30)))
+				Expect(stored[1]).To(BeNil())
+			})
+
+			It("Should compare expected scores with stored scores divided by score scale", func() {
+				expectedScore := 3.0
+				_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member4", Score: 3*16 + 5},
+				}, &database.UpsertOptions{Order: "desc", ScoreScale: 16})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member4", Score: 4*16 + 2},
+				}, &database.UpsertOptions{Order: "desc", ScoreScale: 16, Preconditions: map[string]*database.Precondition{
+					"member4": {ExpectedScore: &expectedScore},
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(float64(4*16 + 2)))
+			})
+
+			It("Should return the first write of an idempotency key until its window passes", func() {
+				options := &database.UpsertOptions{
+					Order:             "desc",
+					UpdatePolicy:      database.UpdatePolicySum,
+					IdempotencyKey:    idempotencyKey(),
+					IdempotencyWindow: 100 * time.Millisecond,
+				}
+				for i := 0; i < 2; i++ {
+					members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+						{Member: "member1", Score: 5},
+					}, options)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(members).To(Equal([]*database.Member{
+						{Member: "member1", Score: 15, Rank: 2, PreviousRank: -1, ScoreChanged: true},
+					}))
+				}
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(float64(20)))
+
+				time.Sleep(150 * time.Millisecond)
+				members, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+				}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(float64(25)))
+			})
+
+			It("Should get the members of a write made with an idempotency key", func() {
+				key := idempotencyKey()
+				members, err := backend.GetIdempotentMembers(ctx, leaderboard, key)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(BeNil())
+
+				ttl := time.Now().Add(time.Hour).Truncate(time.Second)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, TTL: ttl, IdempotencyKey: key, IdempotencyWindow: time.Minute})
+				Expect(err).NotTo(HaveOccurred())
+
+				members, err = backend.GetIdempotentMembers(ctx, leaderboard, key)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 15, Rank: 2, PreviousRank: -1, ScoreChanged: true, TTL: ttl},
+				}))
+			})
+
+			It("Should forget writes made with an idempotency key when their leaderboard is removed", func() {
+				key := idempotencyKey()
+				options := &database.UpsertOptions{Order: "desc", IdempotencyKey: key, IdempotencyWindow: time.Minute}
+				_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 5}}, options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(backend.RemoveLeaderboard(ctx, leaderboard)).To(Succeed())
+
+				members, err := backend.GetIdempotentMembers(ctx, leaderboard, key)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(BeNil())
+
+				members, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 7}}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 7, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should set scores and report rank and previous rank", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 50},
+					{Member: "member4", Score: 5},
+				}, &database.UpsertOptions{Order: "desc", PreviousRank: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 50, Rank: 0, PreviousRank: 2, ScoreChanged: true},
+					{Member: "member4", Score: 5, Rank: 3, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should increment scores and report ranks after every member is written", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 15},
+					{Member: "member2", Score: 15},
+				}, &database.UpsertOptions{Order: "asc", UpdatePolicy: database.UpdatePolicySum})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 25, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member2", Score: 35, Rank: 2, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should add scaled scores and keep the given tie-breaker when score scale is set", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 3*16 + 5},
+					{Member: "member2", Score: 0*16 + 9},
+					{Member: "member4", Score: 2*16 + 7},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, ScoreScale: 16})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 3*16 + 5, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member2", Score: 20, Rank: 3, PreviousRank: -1, ScoreChanged: false},
+					{Member: "member4", Score: 2*16 + 7, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+				}))
+
+				members, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: -1*16 + 2},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, ScoreScale: 16})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 2*16 + 2, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should write nothing if a sum is out of the sum range", func() {
+				for _, test := range []struct {
+					scale     float64
+					sumRange  *database.ScoreRange
+					increment float64
+					sum       float64
+				}{
+					{1, &database.ScoreRange{Min: -20, Max: 20}, 6, 21},
+					{16, &database.ScoreRange{Min: 0, Max: 10}, 6 * 16, 11},
+				} {
+					_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+						{Member: "member4", Score: 5},
+						{Member: "member1", Score: test.increment},
+						{Member: "member1", Score: 5 * test.scale},
+					}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, ScoreScale: test.scale, SumRange: test.sumRange})
+					Expect(err).To(Equal(database.NewSumOutOfRangeError(leaderboard, "member1", test.sum)))
+
+					members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1", "member4")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(members[0].Score).To(Equal(10.0))
+					Expect(members[1]).To(BeNil())
+				}
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 10},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, SumRange: &database.ScoreRange{Min: -20, Max: 20}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(20.0))
+			})
+
+			It("Should keep best score, the highest in desc and the lowest in asc", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+					{Member: "member2", Score: 25},
+					{Member: "member4", Score: 1},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyBest})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 10, Rank: 2, PreviousRank: -1, ScoreChanged: false},
+					{Member: "member2", Score: 25, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member4", Score: 1, Rank: 3, PreviousRank: -1, ScoreChanged: true},
+				}))
+
+				members, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+					{Member: "member3", Score: 35},
+				}, &database.UpsertOptions{Order: "asc", UpdatePolicy: database.UpdatePolicyBest})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 5, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member3", Score: 30, Rank: 3, PreviousRank: -1, ScoreChanged: false},
+				}))
+			})
+
+			It("Should keep worst score, the lowest in desc and the highest in asc", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 5},
+					{Member: "member2", Score: 25},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyWorst})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 5, Rank: 2, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member2", Score: 20, Rank: 1, PreviousRank: -1, ScoreChanged: false},
+				}))
+
+				members, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member3", Score: 35},
+				}, &database.UpsertOptions{Order: "asc", UpdatePolicy: database.UpdatePolicyWorst})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member3", Score: 35, Rank: 2, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should report unchanged score when last write has the stored score", func() {
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 10},
+					{Member: "member2", Score: 15},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyLast})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 10, Rank: 2, PreviousRank: -1, ScoreChanged: false},
+					{Member: "member2", Score: 15, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+				}))
+			})
+
+			It("Should return error InvalidUpdatePolicy", func() {
+				_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 1},
+				}, &database.UpsertOptions{Order: "desc", UpdatePolicy: "invalid"})
+				Expect(err).To(Equal(database.NewInvalidUpdatePolicyError("invalid")))
+
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(BeEquivalentTo(10))
+			})
+
+			It("Should set members TTL and leaderboard expiration only if it has none", func() {
+				ttl := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+				expireAt := time.Now().Add(time.Hour)
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 1},
+				}, &database.UpsertOptions{Order: "desc", TTL: ttl, ExpireAt: expireAt})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].TTL).To(Equal(ttl))
+
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 1},
+				}, &database.UpsertOptions{Order: "desc", ExpireAt: expireAt.Add(time.Hour)})
+				Expect(err).NotTo(HaveOccurred())
+
+				expiration, err := backend.GetLeaderboardExpiration(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Duration(expiration)).To(BeNumerically("~", time.Hour, 2*time.Second))
+
+				stored, err := backend.GetMembers(ctx, leaderboard, "desc", true, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored[0].TTL).To(Equal(ttl))
+			})
+
+			It("Should return error InvalidOrder", func() {
+				_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{
+					{Member: "member1", Score: 1},
+				}, &database.UpsertOptions{Order: "invalid"})
+				Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+			})
+		})
+
+		Describe("Registry", func() {
+			definition := func(id string) *database.LeaderboardDefinition {
+				return &database.LeaderboardDefinition{
+					ID:              id,
+					DisplayName:     "Weekly ranking",
+					Order:           "asc",
+					UpdatePolicy:    database.UpdatePolicyBest,
+					DefaultPageSize: 10,
+					MaxPageSize:     50,
+					StartTime:       time.Unix(1700000000, 0).UTC(),
+					EndTime:         time.Unix(1700604800, 0).UTC(),
+					Retention:       24 * time.Hour,
+					CreatedAt:       time.Unix(1690000000, 0).UTC(),
+					UpdatedAt:       time.Unix(1690000000, 0).UTC(),
+				}
+			}
+
+			It("Should create and get leaderboard definition", func() {
+				err := backend.CreateLeaderboardDefinition(ctx, definition(leaderboard))
+				Expect(err).NotTo(HaveOccurred())
+
+				stored, err := backend.GetLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(Equal(definition(leaderboard)))
+			})
+
+			It("Should return error LeaderboardDefinitionAlreadyExists if leaderboard is registered", func() {
+				err := backend.CreateLeaderboardDefinition(ctx, definition(leaderboard))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.CreateLeaderboardDefinition(ctx, &database.LeaderboardDefinition{ID: leaderboard})
+				Expect(err).To(Equal(database.NewLeaderboardDefinitionAlreadyExistsError(leaderboard)))
+
+				stored, err := backend.GetLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(Equal(definition(leaderboard)))
+			})
+
+			It("Should return error LeaderboardDefinitionNotFound if leaderboard isn't registered", func() {
+				_, err := backend.GetLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).To(Equal(database.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+
+				err = backend.UpdateLeaderboardDefinition(ctx, definition(leaderboard))
+				Expect(err).To(Equal(database.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+
+				err = backend.RemoveLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).To(Equal(database.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+			})
+
+			It("Should update leaderboard definition", func() {
+				err := backend.CreateLeaderboardDefinition(ctx, definition(leaderboard))
+				Expect(err).NotTo(HaveOccurred())
+
+				updated := definition(leaderboard)
+				updated.Order = "desc"
+				updated.EndTime = time.Time{}
+				err = backend.UpdateLeaderboardDefinition(ctx, updated)
+				Expect(err).NotTo(HaveOccurred())
+
+				stored, err := backend.GetLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(Equal(updated))
+			})
+
+			It("Should list leaderboard definitions ordered by id", func() {
+				for _, id := range []string{leaderboard, anotherLeaderboard} {
+					err := backend.CreateLeaderboardDefinition(ctx, definition(id))
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				definitions, err := backend.ListLeaderboardDefinitions(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				var ids []string
+				for _, stored := range definitions {
+					ids = append(ids, stored.ID)
+				}
+				Expect(ids).To(ContainElements(anotherLeaderboard, leaderboard))
+				Expect(sort.StringsAreSorted(ids)).To(BeTrue())
+			})
+
+			It("Should remove leaderboard definition keeping leaderboard members", func() {
+				err := backend.CreateLeaderboardDefinition(ctx, definition(leaderboard))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.RemoveLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = backend.GetLeaderboardDefinition(ctx, leaderboard)
+				Expect(err).To(Equal(database.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+
+				total, err := backend.GetTotalMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(3))
+			})
+		})
+
+		Describe("Leagues", func() {
+			It("Should fill buckets in order keeping members in their first bucket", func() {
+				buckets, err := backend.AssignLeagueBuckets(ctx, leaderboard, []string{"member1", "member2", "member3"}, 2, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buckets).To(Equal(map[string]int{"member1": 0, "member2": 0, "member3": 1}))
+
+				buckets, err = backend.AssignLeagueBuckets(ctx, leaderboard, []string{"member4", "member1", "member5"}, 2, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buckets).To(Equal(map[string]int{"member4": 1, "member1": 0, "member5": 2}))
+
+				count, err := backend.GetLeagueBucketsCount(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(3))
+			})
+
+			It("Should leave out members without bucket", func() {
+				_, err := backend.AssignLeagueBuckets(ctx, leaderboard, []string{"member1"}, 2, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+
+				buckets, err := backend.GetLeagueBuckets(ctx, leaderboard, "member1", "member2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buckets).To(Equal(map[string]int{"member1": 0}))
+
+				buckets, err = backend.GetLeagueBuckets(ctx, anotherLeaderboard, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buckets).To(BeEmpty())
+			})
+
+			It("Should forget buckets of removed and expired leagues", func() {
+				_, err := backend.AssignLeagueBuckets(ctx, leaderboard, []string{"member1"}, 2, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = backend.AssignLeagueBuckets(ctx, anotherLeaderboard, []string{"member1"}, 2, time.Now().Add(-time.Second))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = backend.RemoveLeague(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+
+				count, err := backend.GetLeagueBucketsCount(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(0))
+
+				buckets, err := backend.GetLeagueBuckets(ctx, anotherLeaderboard, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buckets).To(BeEmpty())
+			})
+		})
+
+		Describe("Ratings", func() {
+			It("Should write ratings with their score and report ranks", func() {
+				members, err := backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+					{Member: "member4", Rating: &database.Rating{Rating: 1484, Matches: 1}, Score: 1484},
+				}, &database.RatingOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.RatedMember{
+					{
+						Member: &database.Member{Member: "member1", Score: 1516, Rank: 0, PreviousRank: 2, ScoreChanged: true},
+						Rating: &database.Rating{Rating: 1516, Matches: 1},
+					},
+					{
+						Member: &database.Member{Member: "member4", Score: 1484, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+						Rating: &database.Rating{Rating: 1484, Matches: 1},
+					},
+				}))
+
+				members, err = backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1530, Deviation: 200, Volatility: 0.06, Matches: 2}, Score: 1530},
+				}, &database.RatingOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.RatedMember{{
+					Member:         &database.Member{Member: "member1", Score: 1530, Rank: 0, PreviousRank: 0, ScoreChanged: true},
+					PreviousRating: &database.Rating{Rating: 1516, Matches: 1},
+					Rating:         &database.Rating{Rating: 1530, Deviation: 200, Volatility: 0.06, Matches: 2},
+				}}))
+
+				ratings, err := backend.GetRatings(ctx, leaderboard, "member1", "member2", "member4")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ratings).To(Equal(map[string]*database.Rating{
+					"member1": {Rating: 1530, Deviation: 200, Volatility: 0.06, Matches: 2},
+					"member4": {Rating: 1484, Matches: 1},
+				}))
+			})
+
+			It("Should return the first write of an idempotency key instead of writing again", func() {
+				options := &database.RatingOptions{Order: "desc", IdempotencyKey: idempotencyKey(), IdempotencyWindow: time.Minute}
+				written, err := backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+				}, options)
+				Expect(err).NotTo(HaveOccurred())
+
+				replayed, err := backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1530, Matches: 2}, Score: 1530},
+				}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(replayed).To(Equal(written))
+
+				ratings, err := backend.GetRatings(ctx, leaderboard, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ratings["member1"]).To(Equal(&database.Rating{Rating: 1516, Matches: 1}))
+			})
+
+			It("Should return error RatingConflict without writing if a rating changed since it was read", func() {
+				_, err := backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+				}, &database.RatingOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member2", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+					{Member: "member1", Rating: &database.Rating{Rating: 1484, Matches: 1}, Score: 1484},
+				}, &database.RatingOptions{Order: "desc"})
+				Expect(err).To(Equal(database.NewRatingConflictError(leaderboard)))
+
+				members, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1", "member2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(float64(1516)))
+				Expect(members[1].Score).To(Equal(float64(20)))
+			})
+
+			It("Should forget ratings of removed members", func() {
+				_, err := backend.WriteRatings(ctx, leaderboard, []*database.RatingUpdate{
+					{Member: "member1", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+				}, &database.RatingOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(backend.RemoveMembers(ctx, leaderboard, "member1")).To(Succeed())
+
+				ratings, err := backend.GetRatings(ctx, leaderboard, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ratings).To(BeEmpty())
+			})
+		})
+
+		Describe("Ladders", func() {
+			It("Should join new members at the bottom and move winning challengers up", func() {
+				members, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger: "member1",
+					Defender:   "member2",
+				}, &database.LadderOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 2, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member2", Score: 1, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+				}))
+
+				members, err = backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger:    "member3",
+					Defender:      "member2",
+					ChallengerWon: true,
+				}, &database.LadderOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member3", Score: 1, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+					{Member: "member2", Score: 2, Rank: 1, PreviousRank: 0, ScoreChanged: true},
+				}))
+
+				ladder, err := backend.GetOrderedMembers(ctx, anotherLeaderboard, 0, -1, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ladder).To(Equal([]*database.Member{
+					{Member: "member3", Score: 1, Rank: 0},
+					{Member: "member2", Score: 2, Rank: 1},
+					{Member: "member1", Score: 3, Rank: 2},
+				}))
+			})
+
+			It("Should return the first report of an idempotency key instead of reordering again", func() {
+				options := &database.LadderOptions{IdempotencyKey: idempotencyKey(), IdempotencyWindow: time.Minute}
+				_, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger: "member1",
+					Defender:   "member2",
+				}, &database.LadderOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				for i := 0; i < 2; i++ {
+					members, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+						Challenger:    "member3",
+						Defender:      "member1",
+						ChallengerWon: true,
+					}, options)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(members).To(Equal([]*database.Member{
+						{Member: "member3", Score: 2, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+						{Member: "member1", Score: 3, Rank: 2, PreviousRank: 1, ScoreChanged: true},
+					}))
+				}
+
+				ladder, err := backend.GetOrderedMembers(ctx, anotherLeaderboard, 0, -1, "asc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ladder).To(Equal([]*database.Member{
+					{Member: "member2", Score: 1, Rank: 0},
+					{Member: "member3", Score: 2, Rank: 1},
+					{Member: "member1", Score: 3, Rank: 2},
+				}))
+			})
+
+			It("Should keep positions if the defender won", func() {
+				for i := 0; i < 2; i++ {
+					_, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+						Challenger: "member1",
+						Defender:   "member2",
+					}, &database.LadderOptions{})
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				members, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger: "member1",
+					Defender:   "member2",
+				}, &database.LadderOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 2, Rank: 1, PreviousRank: 1},
+					{Member: "member2", Score: 1, Rank: 0, PreviousRank: 0},
+				}))
+			})
+
+			It("Should return error ChallengeOutOfRange without writing if the defender isn't ranked above within max distance", func() {
+				_, err := backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger: "member2",
+					Defender:   "member1",
+				}, &database.LadderOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger:    "member3",
+					Defender:      "member1",
+					ChallengerWon: true,
+					MaxDistance:   1,
+				}, &database.LadderOptions{})
+				Expect(err).To(Equal(database.NewChallengeOutOfRangeError(anotherLeaderboard, "member3", "member1")))
+
+				_, err = backend.ReportChallenge(ctx, anotherLeaderboard, &database.Challenge{
+					Challenger:    "member1",
+					Defender:      "member2",
+					ChallengerWon: true,
+				}, &database.LadderOptions{})
+				Expect(err).To(Equal(database.NewChallengeOutOfRangeError(anotherLeaderboard, "member1", "member2")))
+
+				total, err := backend.GetTotalMembers(ctx, anotherLeaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(2))
+			})
+		})
+
+		Describe("Submissions", func() {
+			It("Should check score rules against windows that restart once they are older than their duration", func() {
+				now := time.Now()
+				maxScore := 100.0
+				options := &database.UpsertOptions{Order: "desc", ScoreRules: &database.ScoreRules{
+					MaxScore:         &maxScore,
+					MaxDelta:         10,
+					DeltaWindow:      time.Hour,
+					MaxSubmissions:   2,
+					SubmissionWindow: time.Minute,
+					Now:              now,
+				}}
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 15}, {Member: "member4", Score: 5}}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(HaveLen(2))
+				Expect(members[0].Score).To(Equal(float64(15)))
+				Expect(members[1].Score).To(Equal(float64(5)))
+
+				options.ScoreRules.Now = now.Add(30 * time.Second)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 25}}, options)
+				Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member1", "max_delta", 15, 10)))
+
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 16}}, options)
+				Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member1", "max_submissions", 3, 2)))
+
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 200}}, options)
+				Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member1", "max_score", 200, 100)))
+
+				options.ScoreRules.Now = now.Add(time.Minute)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 18}}, options)
+				Expect(err).NotTo(HaveOccurred())
+
+				options.ScoreRules.Now = now.Add(time.Hour)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 30}}, options)
+				Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member1", "max_delta", 12, 10)))
+
+				stored, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored[0].Score).To(Equal(float64(18)))
+			})
+
+			It("Should check the submitted score of members without a score whatever the update policy", func() {
+				maxScore := 40.0
+				for _, updatePolicy := range []string{"last", "best", "worst", "sum"} {
+					options := &database.UpsertOptions{Order: "asc", UpdatePolicy: updatePolicy, ScoreRules: &database.ScoreRules{MaxScore: &maxScore, Now: time.Now()}}
+					_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member4", Score: 50}}, options)
+					Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member4", "max_score", 50, 40)))
+				}
+			})
+
+			It("Should check score rules on scores divided by their scale and unit", func() {
+				options := &database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, ScoreScale: 10, ScoreRules: &database.ScoreRules{
+					MaxIncrement: 0.5,
+					ScoreUnit:    10,
+					Now:          time.Now(),
+				}}
+
+				_, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member2", Score: 103}}, options)
+				Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member2", "max_increment", 1, 0.5)))
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member2", Score: 53}}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members[0].Score).To(Equal(float64(73)))
+			})
+
+			It("Should hold writes breaking a rule for review, keeping the latest one of each member", func() {
+				submittedAt := time.Unix(1600000000, 0).UTC()
+				maxScore := 100.0
+				options := &database.UpsertOptions{Order: "desc", PreviousRank: true, ScoreRules: &database.ScoreRules{
+					MaxScore:   &maxScore,
+					Quarantine: true,
+					Now:        submittedAt,
+				}}
+
+				members, err := backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 200}, {Member: "member4", Score: 50}}, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(Equal([]*database.Member{
+					{Member: "member1", Score: 200, Rank: -1, PreviousRank: -1, QuarantineRule: "max_score"},
+					{Member: "member4", Score: 50, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+				}))
+
+				options.ScoreRules.Now = submittedAt.Add(time.Second)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member2", Score: 150.5}}, options)
+				Expect(err).NotTo(HaveOccurred())
+				options.ScoreRules.Now = submittedAt.Add(2 * time.Second)
+				_, err = backend.UpsertMembers(ctx, leaderboard, []*database.Member{{Member: "member1", Score: 300}}, options)
+				Expect(err).NotTo(HaveOccurred())
+
+				entries, err := backend.GetQuarantinedMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries).To(Equal([]*database.QuarantineEntry{
+					{Member: "member2", Score: 150.5, Rule: "max_score", SubmittedAt: submittedAt.Add(time.Second)},
+					{Member: "member1", Score: 300, Rule: "max_score", SubmittedAt: submittedAt.Add(2 * time.Second)},
+				}))
+
+				stored, err := backend.GetMembers(ctx, leaderboard, "desc", false, "member1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored[0].Score).To(Equal(float64(10)))
+
+				Expect(backend.RemoveLeaderboard(ctx, leaderboard)).To(Succeed())
+				entries, err = backend.GetQuarantinedMembers(ctx, leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+		})
+
+		Describe("Nonces", func() {
+			It("Should claim each nonce of a tenant once until it expires", func() {
+				nonce := fmt.Sprintf("dbtest-nonce-%d", time.Now().UnixNano())
+
+				claimed, err := backend.ClaimNonce(ctx, "tenant1", nonce, 100*time.Millisecond)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+
+				claimed, err = backend.ClaimNonce(ctx, "tenant1", nonce, 100*time.Millisecond)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeFalse())
+
+				claimed, err = backend.ClaimNonce(ctx, "tenant2", nonce, 100*time.Millisecond)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+
+				time.Sleep(150 * time.Millisecond)
+				claimed, err = backend.ClaimNonce(ctx, "tenant1", nonce, 100*time.Millisecond)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+			})
+
+			It("Should claim a released nonce again", func() {
+				nonce := fmt.Sprintf("dbtest-nonce-%d", time.Now().UnixNano())
+
+				claimed, err := backend.ClaimNonce(ctx, "tenant1", nonce, time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+
+				Expect(backend.ReleaseNonce(ctx, "tenant1", nonce)).To(Succeed())
+				Expect(backend.ReleaseNonce(ctx, "tenant2", nonce)).To(Succeed())
+
+				claimed, err = backend.ClaimNonce(ctx, "tenant1", nonce, time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+				Expect(backend.ReleaseNonce(ctx, "tenant1", nonce)).To(Succeed())
+			})
+		})
+	})
+}
+
+// removeLeaderboard delete leaderboard, its members TTL and its expire list entry using only backend calls
+func removeLeaderboard(ctx context.Context, backend Backend, leaderboard string) {
+	members, err := backend.GetMembersToExpire(ctx, leaderboard, math.MaxInt32, time.Unix(math.MaxInt32, 0))
+	if err == nil && len(members) > 0 {
+		Expect(backend.ExpireMembers(ctx, leaderboard, members)).To(Succeed())
+	}
+
+	Expect(backend.RemoveLeaderboardFromExpireList(ctx, leaderboard)).To(Succeed())
+	Expect(backend.RemoveLeaderboard(ctx, leaderboard)).To(Succeed())
+}
+
+// idempotencyKey return a key no other spec run used, so a spec never replays a write kept by another run
+func idempotencyKey() string {
+	return fmt.Sprintf("dbtest-idempotency-%d", time.Now().UnixNano())
+}
+
+func largeMemberID(i int) string {
+	return "member" + strconv.Itoa(100000+i)
+}
+
+func memberIDs(members []*database.Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.Member)
+	}
+	return ids
+}
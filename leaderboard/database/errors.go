@@ -1,6 +1,9 @@
 package database
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // GeneralError create a redis error that is not handled
 type GeneralError struct {
@@ -32,6 +35,22 @@ func NewInvalidOrderError(order string) *InvalidOrderError {
 	}
 }
 
+// InvalidUpdatePolicyError is an error throw when update policy is not one of the update policies
+type InvalidUpdatePolicyError struct {
+	policy string
+}
+
+func (iupe *InvalidUpdatePolicyError) Error() string {
+	return fmt.Sprintf("invalid update policy: %s", iupe.policy)
+}
+
+// NewInvalidUpdatePolicyError create a new InvalidUpdatePolicyError
+func NewInvalidUpdatePolicyError(policy string) *InvalidUpdatePolicyError {
+	return &InvalidUpdatePolicyError{
+		policy: policy,
+	}
+}
+
 // MemberNotFoundError is an error throw when leaderboard not have member
 type MemberNotFoundError struct {
 	leaderboard string
@@ -81,3 +100,195 @@ func NewLeaderboardWithoutMemberToExpireError(leaderboard string) *LeaderboardWi
 func (lwmtee *LeaderboardWithoutMemberToExpireError) Error() string {
 	return fmt.Sprintf("leaderboard %s without member to expire", lwmtee.leaderboard)
 }
+
+// LeaderboardDefinitionNotFoundError is an error throw when leaderboard isn't registered
+type LeaderboardDefinitionNotFoundError struct {
+	leaderboard string
+}
+
+// NewLeaderboardDefinitionNotFoundError create a new LeaderboardDefinitionNotFoundError
+func NewLeaderboardDefinitionNotFoundError(leaderboard string) *LeaderboardDefinitionNotFoundError {
+	return &LeaderboardDefinitionNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (ldnfe *LeaderboardDefinitionNotFoundError) Error() string {
+	return fmt.Sprintf("leaderboard %s definition not found", ldnfe.leaderboard)
+}
+
+// LeaderboardDefinitionAlreadyExistsError is an error throw when leaderboard is already registered
+type LeaderboardDefinitionAlreadyExistsError struct {
+	leaderboard string
+}
+
+// NewLeaderboardDefinitionAlreadyExistsError create a new LeaderboardDefinitionAlreadyExistsError
+func NewLeaderboardDefinitionAlreadyExistsError(leaderboard string) *LeaderboardDefinitionAlreadyExistsError {
+	return &LeaderboardDefinitionAlreadyExistsError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (ldaee *LeaderboardDefinitionAlreadyExistsError) Error() string {
+	return fmt.Sprintf("leaderboard %s definition already exists", ldaee.leaderboard)
+}
+
+// LeaderboardArchiveNotFoundError is an error throw when leaderboard wasn't archived
+type LeaderboardArchiveNotFoundError struct {
+	leaderboard string
+}
+
+// NewLeaderboardArchiveNotFoundError create a new LeaderboardArchiveNotFoundError
+func NewLeaderboardArchiveNotFoundError(leaderboard string) *LeaderboardArchiveNotFoundError {
+	return &LeaderboardArchiveNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (lanfe *LeaderboardArchiveNotFoundError) Error() string {
+	return fmt.Sprintf("leaderboard %s archive not found", lanfe.leaderboard)
+}
+
+// RatingConflictError is an error throw when member ratings changed since they were read
+type RatingConflictError struct {
+	leaderboard string
+}
+
+// NewRatingConflictError create a new RatingConflictError
+func NewRatingConflictError(leaderboard string) *RatingConflictError {
+	return &RatingConflictError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (rce *RatingConflictError) Error() string {
+	return fmt.Sprintf("leaderboard %s ratings changed since they were read", rce.leaderboard)
+}
+
+// ChallengeOutOfRangeError is an error throw when a defender isn't ranked above a challenger within the ladder max distance
+type ChallengeOutOfRangeError struct {
+	leaderboard string
+	challenger  string
+	defender    string
+}
+
+// NewChallengeOutOfRangeError create a new ChallengeOutOfRangeError
+func NewChallengeOutOfRangeError(leaderboard, challenger, defender string) *ChallengeOutOfRangeError {
+	return &ChallengeOutOfRangeError{
+		leaderboard: leaderboard,
+		challenger:  challenger,
+		defender:    defender,
+	}
+}
+
+func (core *ChallengeOutOfRangeError) Error() string {
+	return fmt.Sprintf("member %s can't challenge %s in leaderboard %s", core.challenger, core.defender, core.leaderboard)
+}
+
+// PreconditionFailedError is an error throw when a member stored score doesn't match the precondition of its write
+type PreconditionFailedError struct {
+	leaderboard  string
+	member       string
+	currentScore *float64
+}
+
+// NewPreconditionFailedError create a new PreconditionFailedError, currentScore is nil if member is absent
+func NewPreconditionFailedError(leaderboard, member string, currentScore *float64) *PreconditionFailedError {
+	return &PreconditionFailedError{
+		leaderboard:  leaderboard,
+		member:       member,
+		currentScore: currentScore,
+	}
+}
+
+// Member return the member whose precondition failed
+func (pfe *PreconditionFailedError) Member() string {
+	return pfe.member
+}
+
+// CurrentScore return the stored score of the member, false if member is absent
+func (pfe *PreconditionFailedError) CurrentScore() (float64, bool) {
+	if pfe.currentScore == nil {
+		return 0, false
+	}
+	return *pfe.currentScore, true
+}
+
+// SumOutOfRangeError is an error throw when a sum results in a score out of the SumRange of its write
+type SumOutOfRangeError struct {
+	leaderboard string
+	member      string
+	sum         float64
+}
+
+// NewSumOutOfRangeError create a new SumOutOfRangeError
+func NewSumOutOfRangeError(leaderboard, member string, sum float64) *SumOutOfRangeError {
+	return &SumOutOfRangeError{
+		leaderboard: leaderboard,
+		member:      member,
+		sum:         sum,
+	}
+}
+
+// Sum return the score, divided by the score scale, the sum would have written
+func (sore *SumOutOfRangeError) Sum() float64 {
+	return sore.sum
+}
+
+func (sore *SumOutOfRangeError) Error() string {
+	return fmt.Sprintf("sum of member %s in leaderboard %s is out of range: %s", sore.member, sore.leaderboard,
+		strconv.FormatFloat(sore.sum, 'f', -1, 64))
+}
+
+func (pfe *PreconditionFailedError) Error() string {
+	if pfe.currentScore == nil {
+		return fmt.Sprintf("precondition of member %s failed in leaderboard %s: member is absent", pfe.member, pfe.leaderboard)
+	}
+	return fmt.Sprintf("precondition of member %s failed in leaderboard %s: stored score is %s", pfe.member, pfe.leaderboard,
+		strconv.FormatFloat(*pfe.currentScore, 'f', -1, 64))
+}
+
+// ScoreRuleViolationError is an error throw when a member write breaks the ScoreRules of its write
+type ScoreRuleViolationError struct {
+	leaderboard string
+	member      string
+	rule        string
+	value       float64
+	limit       float64
+}
+
+// NewScoreRuleViolationError create a new ScoreRuleViolationError
+func NewScoreRuleViolationError(leaderboard, member, rule string, value, limit float64) *ScoreRuleViolationError {
+	return &ScoreRuleViolationError{
+		leaderboard: leaderboard,
+		member:      member,
+		rule:        rule,
+		value:       value,
+		limit:       limit,
+	}
+}
+
+// Member return the member whose write broke the rule
+func (srve *ScoreRuleViolationError) Member() string {
+	return srve.member
+}
+
+// Rule return the score rule broken
+func (srve *ScoreRuleViolationError) Rule() string {
+	return srve.rule
+}
+
+// Value return what broke the rule, in the units of the rule limit
+func (srve *ScoreRuleViolationError) Value() float64 {
+	return srve.value
+}
+
+// Limit return the limit of the rule
+func (srve *ScoreRuleViolationError) Limit() float64 {
+	return srve.limit
+}
+
+func (srve *ScoreRuleViolationError) Error() string {
+	return fmt.Sprintf("score of member %s in leaderboard %s breaks rule %s: %s exceeds %s", srve.member, srve.leaderboard, srve.rule,
+		strconv.FormatFloat(srve.value, 'f', -1, 64), strconv.FormatFloat(srve.limit, 'f', -1, 64))
+}
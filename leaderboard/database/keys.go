@@ -0,0 +1,216 @@
+package database
+
+import "strings"
+
+// KeySchemaVersion identify how leaderboard ids are mapped to redis keys
+type KeySchemaVersion int
+
+const (
+	// KeySchemaV1 store leaderboard with its own id as key and members ttl in "<id>:ttl",
+	// a leaderboard named "foo:ttl" collides with ttl set of "foo" and both keys can be in different cluster slots
+	KeySchemaV1 KeySchemaVersion = 1
+	// KeySchemaV2 wrap leaderboard id in a hash tag, "{<id>}" and "{<id>}:ttl", so every key of a leaderboard
+	// is stored in the same cluster slot and internal suffixes never collide with a leaderboard id
+	KeySchemaV2 KeySchemaVersion = 2
+)
+
+const (
+	ttlSuffix     string = ":ttl"
+	scoresSuffix  string = ":scores"
+	archiveSuffix string = ":archive"
+	bucketsSuffix string = ":buckets"
+	tiersSuffix   string = ":tiers"
+	ratingsSuffix string = ":ratings"
+
+	submissionsSuffix string = ":submissions"
+	quarantineSuffix  string = ":quarantine"
+	idempotencySuffix string = ":idempotency"
+)
+
+// Keys build redis keys used to store leaderboards, zero value is KeySchemaV1 without prefix
+type Keys struct {
+	// Version is the key schema version, anything different from KeySchemaV2 is handled as KeySchemaV1
+	Version KeySchemaVersion
+	// Prefix is prepended to every key so podium can share a redis with other services,
+	// it must not contain "{" or "}" otherwise it would be used as hash tag
+	Prefix string
+}
+
+// Leaderboard return the sorted set key that store leaderboard members
+func (k Keys) Leaderboard(leaderboard string) string {
+	if k.Version == KeySchemaV2 {
+		return k.Prefix + "{" + leaderboard + "}"
+	}
+
+	return k.Prefix + leaderboard
+}
+
+// LeaderboardTTL return the sorted set key that store when leaderboard members expire
+func (k Keys) LeaderboardTTL(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + ttlSuffix
+}
+
+// LeaderboardScores return the sorted set key that store every distinct score of a leaderboard,
+// used to compute dense ranks
+func (k Keys) LeaderboardScores(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + scoresSuffix
+}
+
+// LeaderboardArchive return the sorted set key that store the final standings of a leaderboard season
+func (k Keys) LeaderboardArchive(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + archiveSuffix
+}
+
+// LeaderboardRatings return the hash key that store the rating of each member of a rating leaderboard
+func (k Keys) LeaderboardRatings(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + ratingsSuffix
+}
+
+// LeaderboardSubmissions return the hash key that store the recent submissions of each leaderboard member
+func (k Keys) LeaderboardSubmissions(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + submissionsSuffix
+}
+
+// LeaderboardQuarantine return the hash key that store the score writes of each member held for review
+func (k Keys) LeaderboardQuarantine(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + quarantineSuffix
+}
+
+// LeaderboardIdempotency return the key that store the result of a leaderboard write made with an idempotency key
+func (k Keys) LeaderboardIdempotency(leaderboard, key string) string {
+	return k.LeaderboardIdempotencyKeys(leaderboard) + ":" + key
+}
+
+// LeaderboardIdempotencyKeys return the sorted set key that list the idempotency keys of a leaderboard whose
+// results are kept, scored by when they're forgotten in unix milliseconds
+func (k Keys) LeaderboardIdempotencyKeys(leaderboard string) string {
+	return k.Leaderboard(leaderboard) + idempotencySuffix
+}
+
+// LeagueBuckets return the sorted set key that store the bucket of each league member
+func (k Keys) LeagueBuckets(league string) string {
+	return k.Leaderboard(league) + bucketsSuffix
+}
+
+// TierHistory return the hash key that store the tier history of each tiered league member
+func (k Keys) TierHistory(competition string) string {
+	return k.Leaderboard(competition) + tiersSuffix
+}
+
+// ExpirationSet return the set key that list every leaderboard ttl key
+func (k Keys) ExpirationSet() string {
+	return k.Prefix + ExpirationSet
+}
+
+// LeaderboardDefinitions return the hash key that store leaderboard definitions
+func (k Keys) LeaderboardDefinitions() string {
+	return k.Prefix + LeaderboardDefinitions
+}
+
+// LeaderboardArchives return the hash key that store archived leaderboards metadata
+func (k Keys) LeaderboardArchives() string {
+	return k.Prefix + LeaderboardArchives
+}
+
+// SeasonRollovers return the sorted set key that list leaderboards to archive scored by their season end
+func (k Keys) SeasonRollovers() string {
+	return k.Prefix + SeasonRollovers
+}
+
+// RewardDeliveries return the sorted set key that list leaderboards whose reward brackets must be delivered
+func (k Keys) RewardDeliveries() string {
+	return k.Prefix + RewardDeliveries
+}
+
+// RewardDeliveryAttempts return the hash key that count failed reward deliveries
+func (k Keys) RewardDeliveryAttempts() string {
+	return k.Prefix + RewardDeliveryAttempts
+}
+
+// Leagues return the set key that list leagues whose members were assigned to buckets
+func (k Keys) Leagues() string {
+	return k.Prefix + LeagueSet
+}
+
+// TieredLeagues return the set key that list tiered leagues whose members have a tier history
+func (k Keys) TieredLeagues() string {
+	return k.Prefix + TieredLeagueSet
+}
+
+// TierMovementJobs return the sorted set key that list archived leaderboards whose tier movements must be recorded
+func (k Keys) TierMovementJobs() string {
+	return k.Prefix + TierMovementJobs
+}
+
+// TierMovementAttempts return the hash key that count failed tier movement jobs
+func (k Keys) TierMovementAttempts() string {
+	return k.Prefix + TierMovementAttempts
+}
+
+// SubmissionNonce return the key that remember nonce of a tenant signed score submission
+func (k Keys) SubmissionNonce(tenant, nonce string) string {
+	return k.Prefix + SubmissionNonces + ":" + tenant + ":" + nonce
+}
+
+// ParseLeaderboard return leaderboard id stored in key, false if key isn't a leaderboard key
+func (k Keys) ParseLeaderboard(key string) (string, bool) {
+	if !strings.HasPrefix(key, k.Prefix) {
+		return "", false
+	}
+	key = strings.TrimPrefix(key, k.Prefix)
+
+	if k.Version == KeySchemaV2 {
+		if len(key) < 2 || !strings.HasPrefix(key, "{") || !strings.HasSuffix(key, "}") {
+			return "", false
+		}
+		return key[1 : len(key)-1], true
+	}
+
+	return key, true
+}
+
+// ParseLeaderboardTTL return leaderboard id of a ttl key, false if key isn't a ttl key
+func (k Keys) ParseLeaderboardTTL(key string) (string, bool) {
+	if !strings.HasSuffix(key, ttlSuffix) {
+		return "", false
+	}
+
+	return k.ParseLeaderboard(strings.TrimSuffix(key, ttlSuffix))
+}
+
+// ParseLeaderboardScores return leaderboard id of a distinct scores key, false if key isn't a distinct scores key
+func (k Keys) ParseLeaderboardScores(key string) (string, bool) {
+	if !strings.HasSuffix(key, scoresSuffix) {
+		return "", false
+	}
+
+	return k.ParseLeaderboard(strings.TrimSuffix(key, scoresSuffix))
+}
+
+// ParseLeaderboardArchive return leaderboard id of an archive key, false if key isn't an archive key
+func (k Keys) ParseLeaderboardArchive(key string) (string, bool) {
+	if !strings.HasSuffix(key, archiveSuffix) {
+		return "", false
+	}
+
+	return k.ParseLeaderboard(strings.TrimSuffix(key, archiveSuffix))
+}
+
+// ParseLeaderboardIdempotencyKeys return leaderboard id of an idempotency keys index, false if key isn't an
+// idempotency keys index
+func (k Keys) ParseLeaderboardIdempotencyKeys(key string) (string, bool) {
+	if !strings.HasSuffix(key, idempotencySuffix) {
+		return "", false
+	}
+
+	return k.ParseLeaderboard(strings.TrimSuffix(key, idempotencySuffix))
+}
+
+// ParseLeagueBuckets return league id of a league buckets key, false if key isn't a league buckets key
+func (k Keys) ParseLeagueBuckets(key string) (string, bool) {
+	if !strings.HasSuffix(key, bucketsSuffix) {
+		return "", false
+	}
+
+	return k.ParseLeaderboard(strings.TrimSuffix(key, bucketsSuffix))
+}
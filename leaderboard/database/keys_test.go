@@ -0,0 +1,124 @@
+package database_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+var _ = Describe("Keys", func() {
+	Describe("When schema is V1", func() {
+		keys := database.Keys{Version: database.KeySchemaV1}
+
+		It("Should use leaderboard id as key", func() {
+			Expect(keys.Leaderboard("foo")).To(Equal("foo"))
+			Expect(keys.LeaderboardTTL("foo")).To(Equal("foo:ttl"))
+			Expect(keys.LeaderboardScores("foo")).To(Equal("foo:scores"))
+			Expect(keys.ExpirationSet()).To(Equal(database.ExpirationSet))
+			Expect(keys.LeaderboardDefinitions()).To(Equal(database.LeaderboardDefinitions))
+			Expect(keys.LeaderboardArchive("foo")).To(Equal("foo:archive"))
+			Expect(keys.LeaderboardArchives()).To(Equal(database.LeaderboardArchives))
+			Expect(keys.SeasonRollovers()).To(Equal(database.SeasonRollovers))
+			Expect(keys.RewardDeliveries()).To(Equal(database.RewardDeliveries))
+			Expect(keys.RewardDeliveryAttempts()).To(Equal(database.RewardDeliveryAttempts))
+			Expect(keys.LeagueBuckets("foo")).To(Equal("foo:buckets"))
+			Expect(keys.Leagues()).To(Equal(database.LeagueSet))
+			Expect(keys.TierHistory("foo")).To(Equal("foo:tiers"))
+			Expect(keys.LeaderboardRatings("foo")).To(Equal("foo:ratings"))
+			Expect(keys.LeaderboardSubmissions("foo")).To(Equal("foo:submissions"))
+			Expect(keys.LeaderboardQuarantine("foo")).To(Equal("foo:quarantine"))
+			Expect(keys.LeaderboardIdempotency("foo", "retry-1")).To(Equal("foo:idempotency:retry-1"))
+			Expect(keys.LeaderboardIdempotencyKeys("foo")).To(Equal("foo:idempotency"))
+			Expect(keys.SubmissionNonce("tenant", "nonce")).To(Equal("submission-nonces:tenant:nonce"))
+			Expect(keys.TieredLeagues()).To(Equal(database.TieredLeagueSet))
+			Expect(keys.TierMovementJobs()).To(Equal(database.TierMovementJobs))
+		})
+
+		It("Should be the zero value schema", func() {
+			Expect(database.Keys{}.Leaderboard("foo")).To(Equal("foo"))
+			Expect(database.Keys{}.LeaderboardTTL("foo")).To(Equal("foo:ttl"))
+		})
+
+		It("Should parse leaderboard from ttl key", func() {
+			leaderboard, ok := keys.ParseLeaderboardTTL("foo:ttl")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo"))
+
+			_, ok = keys.ParseLeaderboardTTL("foo")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("When schema is V2", func() {
+		keys := database.Keys{Version: database.KeySchemaV2, Prefix: "podium:"}
+
+		It("Should wrap leaderboard id in hash tag after prefix", func() {
+			Expect(keys.Leaderboard("foo")).To(Equal("podium:{foo}"))
+			Expect(keys.LeaderboardTTL("foo")).To(Equal("podium:{foo}:ttl"))
+			Expect(keys.LeaderboardScores("foo")).To(Equal("podium:{foo}:scores"))
+			Expect(keys.ExpirationSet()).To(Equal("podium:expiration-sets"))
+			Expect(keys.LeaderboardDefinitions()).To(Equal("podium:leaderboard-definitions"))
+			Expect(keys.LeaderboardArchive("foo")).To(Equal("podium:{foo}:archive"))
+			Expect(keys.LeaderboardArchives()).To(Equal("podium:leaderboard-archives"))
+			Expect(keys.SeasonRollovers()).To(Equal("podium:season-rollovers"))
+			Expect(keys.RewardDeliveries()).To(Equal("podium:reward-deliveries"))
+			Expect(keys.RewardDeliveryAttempts()).To(Equal("podium:reward-delivery-attempts"))
+			Expect(keys.LeagueBuckets("foo")).To(Equal("podium:{foo}:buckets"))
+			Expect(keys.Leagues()).To(Equal("podium:leagues"))
+			Expect(keys.TierHistory("foo")).To(Equal("podium:{foo}:tiers"))
+			Expect(keys.LeaderboardRatings("foo")).To(Equal("podium:{foo}:ratings"))
+			Expect(keys.LeaderboardSubmissions("foo")).To(Equal("podium:{foo}:submissions"))
+			Expect(keys.LeaderboardQuarantine("foo")).To(Equal("podium:{foo}:quarantine"))
+			Expect(keys.LeaderboardIdempotency("foo", "retry-1")).To(Equal("podium:{foo}:idempotency:retry-1"))
+			Expect(keys.LeaderboardIdempotencyKeys("foo")).To(Equal("podium:{foo}:idempotency"))
+			Expect(keys.SubmissionNonce("tenant", "nonce")).To(Equal("podium:submission-nonces:tenant:nonce"))
+			Expect(keys.TieredLeagues()).To(Equal("podium:tiered-leagues"))
+			Expect(keys.TierMovementJobs()).To(Equal("podium:tier-movements"))
+		})
+
+		It("Should not collide leaderboard named with internal suffix", func() {
+			Expect(keys.Leaderboard("foo:ttl")).NotTo(Equal(keys.LeaderboardTTL("foo")))
+			Expect(keys.Leaderboard("foo}:ttl")).NotTo(Equal(keys.LeaderboardTTL("foo")))
+		})
+
+		It("Should parse leaderboard keys", func() {
+			leaderboard, ok := keys.ParseLeaderboard("podium:{foo:ttl}")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo:ttl"))
+
+			leaderboard, ok = keys.ParseLeaderboardTTL("podium:{foo}:ttl")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo"))
+
+			leaderboard, ok = keys.ParseLeaderboardScores("podium:{foo}:scores")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo"))
+
+			leaderboard, ok = keys.ParseLeaderboardArchive("podium:{foo}:archive")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo"))
+
+			leaderboard, ok = keys.ParseLeaderboardIdempotencyKeys("podium:{foo}:idempotency")
+			Expect(ok).To(BeTrue())
+			Expect(leaderboard).To(Equal("foo"))
+
+			_, ok = keys.ParseLeaderboard("podium:{foo}:ttl")
+			Expect(ok).To(BeFalse())
+
+			_, ok = keys.ParseLeaderboard("{foo}")
+			Expect(ok).To(BeFalse())
+
+			_, ok = keys.ParseLeaderboard("podium:foo")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("RedisOptions", func() {
+	It("Should require key schema V2 on cluster", func() {
+		Expect(database.RedisOptions{ClusterEnabled: true, KeySchema: database.KeySchemaV1}.Validate()).To(HaveOccurred())
+		Expect(database.RedisOptions{ClusterEnabled: true}.Validate()).To(HaveOccurred())
+		Expect(database.RedisOptions{ClusterEnabled: true, KeySchema: database.KeySchemaV2}.Validate()).To(Succeed())
+		Expect(database.RedisOptions{KeySchema: database.KeySchemaV1}.Validate()).To(Succeed())
+	})
+})
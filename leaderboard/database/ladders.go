@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Ladders interface standardize calls that reorder the positions of ladder leaderboard members, where scores
+// are positions starting at 1 and lower positions rank higher
+type Ladders interface {
+	ReportChallenge(ctx context.Context, leaderboard string, challenge *Challenge, options *LadderOptions) ([]*Member, error)
+}
+
+// Challenge is the result of a challenger challenging a defender ranked above it, members that aren't in
+// the ladder join it at the bottom, the defender first
+type Challenge struct {
+	Challenger    string
+	Defender      string
+	ChallengerWon bool
+	// MaxDistance is how many ranks above the challenger the defender can be, zero means any
+	MaxDistance int
+}
+
+// LadderOptions are the options to report challenges, ExpireAt, SeasonEnd and idempotency fields work like in
+// UpsertOptions
+type LadderOptions struct {
+	ExpireAt          time.Time
+	SeasonEnd         time.Time
+	IdempotencyKey    string
+	IdempotencyWindow time.Duration
+}
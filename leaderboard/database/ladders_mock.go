@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/ladders.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLadders is a mock of Ladders interface.
+type MockLadders struct {
+	ctrl     *gomock.Controller
+	recorder *MockLaddersMockRecorder
+}
+
+// MockLaddersMockRecorder is the mock recorder for MockLadders.
+type MockLaddersMockRecorder struct {
+	mock *MockLadders
+}
+
+// NewMockLadders creates a new mock instance.
+func NewMockLadders(ctrl *gomock.Controller) *MockLadders {
+	mock := &MockLadders{ctrl: ctrl}
+	mock.recorder = &MockLaddersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLadders) EXPECT() *MockLaddersMockRecorder {
+	return m.recorder
+}
+
+// ReportChallenge mocks base method.
+func (m *MockLadders) ReportChallenge(ctx context.Context, leaderboard string, challenge *Challenge, options *LadderOptions) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportChallenge", ctx, leaderboard, challenge, options)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportChallenge indicates an expected call of ReportChallenge.
+func (mr *MockLaddersMockRecorder) ReportChallenge(ctx, leaderboard, challenge, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportChallenge", reflect.TypeOf((*MockLadders)(nil).ReportChallenge), ctx, leaderboard, challenge, options)
+}
@@ -0,0 +1,14 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Leagues interface standardize calls that split the members of a league in buckets of fixed size
+type Leagues interface {
+	AssignLeagueBuckets(ctx context.Context, league string, members []string, bucketSize int, expireAt time.Time) (map[string]int, error)
+	GetLeagueBuckets(ctx context.Context, league string, members ...string) (map[string]int, error)
+	GetLeagueBucketsCount(ctx context.Context, league string) (int, error)
+	RemoveLeague(ctx context.Context, league string) error
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/league.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeagues is a mock of Leagues interface.
+type MockLeagues struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaguesMockRecorder
+}
+
+// MockLeaguesMockRecorder is the mock recorder for MockLeagues.
+type MockLeaguesMockRecorder struct {
+	mock *MockLeagues
+}
+
+// NewMockLeagues creates a new mock instance.
+func NewMockLeagues(ctrl *gomock.Controller) *MockLeagues {
+	mock := &MockLeagues{ctrl: ctrl}
+	mock.recorder = &MockLeaguesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeagues) EXPECT() *MockLeaguesMockRecorder {
+	return m.recorder
+}
+
+// AssignLeagueBuckets mocks base method.
+func (m *MockLeagues) AssignLeagueBuckets(ctx context.Context, league string, members []string, bucketSize int, expireAt time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignLeagueBuckets", ctx, league, members, bucketSize, expireAt)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignLeagueBuckets indicates an expected call of AssignLeagueBuckets.
+func (mr *MockLeaguesMockRecorder) AssignLeagueBuckets(ctx, league, members, bucketSize, expireAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignLeagueBuckets", reflect.TypeOf((*MockLeagues)(nil).AssignLeagueBuckets), ctx, league, members, bucketSize, expireAt)
+}
+
+// GetLeagueBuckets mocks base method.
+func (m *MockLeagues) GetLeagueBuckets(ctx context.Context, league string, members ...string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, league}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLeagueBuckets", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeagueBuckets indicates an expected call of GetLeagueBuckets.
+func (mr *MockLeaguesMockRecorder) GetLeagueBuckets(ctx, league interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, league}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeagueBuckets", reflect.TypeOf((*MockLeagues)(nil).GetLeagueBuckets), varargs...)
+}
+
+// GetLeagueBucketsCount mocks base method.
+func (m *MockLeagues) GetLeagueBucketsCount(ctx context.Context, league string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeagueBucketsCount", ctx, league)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeagueBucketsCount indicates an expected call of GetLeagueBucketsCount.
+func (mr *MockLeaguesMockRecorder) GetLeagueBucketsCount(ctx, league interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeagueBucketsCount", reflect.TypeOf((*MockLeagues)(nil).GetLeagueBucketsCount), ctx, league)
+}
+
+// RemoveLeague mocks base method.
+func (m *MockLeagues) RemoveLeague(ctx context.Context, league string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLeague", ctx, league)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLeague indicates an expected call of RemoveLeague.
+func (mr *MockLeaguesMockRecorder) RemoveLeague(ctx, league interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLeague", reflect.TypeOf((*MockLeagues)(nil).RemoveLeague), ctx, league)
+}
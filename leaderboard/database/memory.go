@@ -0,0 +1,670 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/memory"
+)
+
+// Memory is a type that implements Database, Expiration, Registry, Leagues, Ratings, Ladders, Submissions and Nonces interfaces keeping leaderboards in process memory,
+// with the same rank, order, TTL and expiration semantics of Redis. It doesn't implement Archive and Tiers, so seasonal
+// leaderboards aren't archived and tiered league members always play in their lowest tier
+type Memory struct {
+	mutex        sync.RWMutex
+	leaderboards map[string]*memoryLeaderboard
+	// ttls keep members expiration time of each leaderboard, like "<leaderboard>:ttl" keys in redis
+	ttls map[string]*memory.SortedSet
+	// expirationSet list leaderboards with members to expire, like ExpirationSet in redis
+	expirationSet map[string]bool
+	// definitions keep registered leaderboard definitions, like LeaderboardDefinitions hash in redis
+	definitions map[string]*LeaderboardDefinition
+	// leagues keep the bucket of each league member, like "<league>:buckets" keys in redis
+	leagues map[string]*memoryLeague
+	// submissions keep the recent submissions of each leaderboard member, like "<leaderboard>:submissions" keys in redis
+	submissions map[string]map[string]*memorySubmissions
+	// quarantines keep the score writes held for review, like "<leaderboard>:quarantine" keys in redis
+	quarantines map[string]map[string]*QuarantineEntry
+	// nonces keep when each claimed nonce is forgotten, like SubmissionNonces keys in redis
+	nonces map[string]time.Time
+	// idempotentWrites keep the result of writes made with an idempotency key by leaderboard, like
+	// "<leaderboard>:idempotency:<key>" keys in redis
+	idempotentWrites map[string]map[string]*memoryIdempotentWrite
+}
+
+type memoryLeaderboard struct {
+	members *memory.SortedSet
+	// scores list every distinct member score, like "<leaderboard>:scores" keys in redis
+	scores      *memory.SortedSet
+	scoreCounts map[float64]int
+	// ratings keep the rating of each member, like "<leaderboard>:ratings" keys in redis
+	ratings  map[string]*Rating
+	expireAt time.Time
+}
+
+func newMemoryLeaderboard() *memoryLeaderboard {
+	return &memoryLeaderboard{
+		members:     memory.NewSortedSet(),
+		scores:      memory.NewSortedSet(),
+		scoreCounts: map[float64]int{},
+		ratings:     map[string]*Rating{},
+	}
+}
+
+// add set member score keeping distinct scores up to date
+func (l *memoryLeaderboard) add(member string, score float64) {
+	if current, ok := l.members.Score(member); ok {
+		l.unindexScore(current)
+	}
+	l.members.Add(member, score)
+	l.indexScore(score)
+}
+
+// incrBy add increment to member score keeping distinct scores up to date
+func (l *memoryLeaderboard) incrBy(member string, increment float64) {
+	current, _ := l.members.Score(member)
+	l.add(member, current+increment)
+}
+
+// remove delete member keeping distinct scores up to date and forgetting its rating
+func (l *memoryLeaderboard) remove(member string) {
+	if current, ok := l.members.Score(member); ok {
+		l.members.Remove(member)
+		l.unindexScore(current)
+	}
+	delete(l.ratings, member)
+}
+
+func (l *memoryLeaderboard) indexScore(score float64) {
+	l.scoreCounts[score]++
+	l.scores.Add(formatScore(score), score)
+}
+
+func (l *memoryLeaderboard) unindexScore(score float64) {
+	l.scoreCounts[score]--
+	if l.scoreCounts[score] <= 0 {
+		delete(l.scoreCounts, score)
+		l.scores.Remove(formatScore(score))
+	}
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
+
+var _ Database = &Memory{}
+
+// NewMemoryDatabase create a database that keeps leaderboards in memory
+func NewMemoryDatabase() *Memory {
+	return &Memory{
+		leaderboards:     map[string]*memoryLeaderboard{},
+		ttls:             map[string]*memory.SortedSet{},
+		expirationSet:    map[string]bool{},
+		definitions:      map[string]*LeaderboardDefinition{},
+		leagues:          map[string]*memoryLeague{},
+		submissions:      map[string]map[string]*memorySubmissions{},
+		quarantines:      map[string]map[string]*QuarantineEntry{},
+		nonces:           map[string]time.Time{},
+		idempotentWrites: map[string]map[string]*memoryIdempotentWrite{},
+	}
+}
+
+// getLeaderboard return leaderboard if it exists and isn't expired, must be called holding mutex
+func (m *Memory) getLeaderboard(leaderboard string) *memoryLeaderboard {
+	storedLeaderboard, ok := m.leaderboards[leaderboard]
+	if !ok {
+		return nil
+	}
+
+	if !storedLeaderboard.expireAt.IsZero() && !storedLeaderboard.expireAt.After(time.Now()) {
+		return nil
+	}
+
+	return storedLeaderboard
+}
+
+// getOrCreateLeaderboard return leaderboard replacing it if expired, must be called holding write mutex
+func (m *Memory) getOrCreateLeaderboard(leaderboard string) *memoryLeaderboard {
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		storedLeaderboard = newMemoryLeaderboard()
+		m.leaderboards[leaderboard] = storedLeaderboard
+	}
+
+	return storedLeaderboard
+}
+
+// removeIfEmpty delete leaderboard without members, as redis does with empty sorted sets
+func (m *Memory) removeIfEmpty(leaderboard string) {
+	if storedLeaderboard, ok := m.leaderboards[leaderboard]; ok && storedLeaderboard.members.Len() == 0 {
+		delete(m.leaderboards, leaderboard)
+	}
+
+	if ttl, ok := m.ttls[leaderboard]; ok && ttl.Len() == 0 {
+		delete(m.ttls, leaderboard)
+	}
+}
+
+func (m *Memory) rank(storedLeaderboard *memoryLeaderboard, member, order string) (int64, bool) {
+	if order == "asc" {
+		return storedLeaderboard.members.Rank(member)
+	}
+	return storedLeaderboard.members.RevRank(member)
+}
+
+// countAhead return how many entries of sortedSet have a better score than each score in parameter
+func countAhead(sortedSet *memory.SortedSet, order string, scores []float64) []int {
+	counts := make([]int, len(scores))
+	for i, score := range scores {
+		if order == "asc" {
+			counts[i] = int(sortedSet.Count(
+				memory.ScoreBound{Value: math.Inf(-1)},
+				memory.ScoreBound{Value: score, Exclusive: true},
+			))
+		} else {
+			counts[i] = int(sortedSet.Count(
+				memory.ScoreBound{Value: score, Exclusive: true},
+				memory.ScoreBound{Value: math.Inf(1)},
+			))
+		}
+	}
+	return counts
+}
+
+// CountMembersAhead return how many members have a better score than each score in parameter
+func (m *Memory) CountMembersAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return make([]int, len(scores)), nil
+	}
+
+	return countAhead(storedLeaderboard.members, order, scores), nil
+}
+
+// CountScoresAhead return how many distinct scores are better than each score in parameter
+func (m *Memory) CountScoresAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return make([]int, len(scores)), nil
+	}
+
+	return countAhead(storedLeaderboard.scores, order, scores), nil
+}
+
+// GetLeaderboardExpiration return leaderboard expiration time
+func (m *Memory) GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return int64(-1), NewGeneralError(fmt.Sprintf("key %s not found", leaderboard))
+	}
+
+	if storedLeaderboard.expireAt.IsZero() {
+		return int64(-1), NewTTLNotFoundError(leaderboard)
+	}
+
+	return int64(time.Until(storedLeaderboard.expireAt).Round(time.Second)), nil
+}
+
+// GetMembers return members from leaderboard
+func (m *Memory) GetMembers(ctx context.Context, leaderboard, order string, includeTTL bool, members ...string) ([]*Member, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	ttl := m.ttls[leaderboard]
+
+	membersToReturn := make([]*Member, 0, len(members))
+	for _, member := range members {
+		if storedLeaderboard == nil {
+			membersToReturn = append(membersToReturn, nil)
+			continue
+		}
+
+		score, ok := storedLeaderboard.members.Score(member)
+		if !ok {
+			membersToReturn = append(membersToReturn, nil)
+			continue
+		}
+
+		rank, _ := m.rank(storedLeaderboard, member, order)
+
+		var memberTTL time.Time
+		if includeTTL && ttl != nil {
+			if expireAt, ok := ttl.Score(member); ok {
+				memberTTL = time.Unix(int64(expireAt), 0)
+			}
+		}
+
+		membersToReturn = append(membersToReturn, &Member{
+			Member: member,
+			Score:  score,
+			Rank:   rank,
+			TTL:    memberTTL,
+		})
+	}
+
+	return membersToReturn, nil
+}
+
+// GetMemberIDsWithScoreInsideRange find members with score close to
+func (m *Memory) GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboard string, min, max string, offset, count int) ([]string, error) {
+	minBound, err := memory.ParseScoreBound(min)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	maxBound, err := memory.ParseScoreBound(max)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return []string{}, nil
+	}
+
+	rangeOffset, rangeCount := memoryRangeLimit(offset, count)
+	members := storedLeaderboard.members.RevRangeByScore(minBound, maxBound, rangeOffset, rangeCount)
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.Member)
+	}
+
+	return memberIDs, nil
+}
+
+// GetOrderedMembers return members between start and stop ranks in the given order
+func (m *Memory) GetOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return []*Member{}, nil
+	}
+
+	var storedMembers []*memory.Member
+	switch order {
+	case "asc":
+		storedMembers = storedLeaderboard.members.Range(int64(start), int64(stop))
+	case "desc":
+		storedMembers = storedLeaderboard.members.RevRange(int64(start), int64(stop))
+	}
+
+	members := make([]*Member, 0, len(storedMembers))
+	for i, member := range storedMembers {
+		members = append(members, &Member{
+			Member: member.Member,
+			Score:  member.Score,
+			Rank:   int64(start + i),
+		})
+	}
+
+	return members, nil
+}
+
+// GetRank find member positon on leaderboard
+func (m *Memory) GetRank(ctx context.Context, leaderboard, member, order string) (int, error) {
+	if order != "asc" && order != "desc" {
+		return -1, NewInvalidOrderError(order)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return -1, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	rank, ok := m.rank(storedLeaderboard, member, order)
+	if !ok {
+		return -1, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	return int(rank), nil
+}
+
+// GetTotalMembers return total members in a leaderboard
+func (m *Memory) GetTotalMembers(ctx context.Context, leaderboard string) (int, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return 0, nil
+	}
+
+	return int(storedLeaderboard.members.Len()), nil
+}
+
+// Healthcheck always succeed since there is no external service to check
+func (m *Memory) Healthcheck(ctx context.Context) error {
+	return nil
+}
+
+// IncrementMemberScore add to member score the value in parameter
+func (m *Memory) IncrementMemberScore(ctx context.Context, leaderboard, member string, increment float64) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.getOrCreateLeaderboard(leaderboard).incrBy(member, increment)
+	return nil
+}
+
+// RemoveLeaderboard delete leaderboard, members TTL are kept until expiration worker removes them
+func (m *Memory) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.leaderboards, leaderboard)
+	delete(m.submissions, leaderboard)
+	delete(m.quarantines, leaderboard)
+	delete(m.idempotentWrites, leaderboard)
+	return nil
+}
+
+// RemoveMembers delete members from leaderboard
+func (m *Memory) RemoveMembers(ctx context.Context, leaderboard string, members ...string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return nil
+	}
+
+	for _, member := range members {
+		storedLeaderboard.remove(member)
+	}
+	m.removeIfEmpty(leaderboard)
+
+	return nil
+}
+
+// SetLeaderboardExpiration will set leaderboard expiration time
+func (m *Memory) SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return NewGeneralError(fmt.Sprintf("key %s not found", leaderboard))
+	}
+
+	storedLeaderboard.expireAt = expireAt
+	return nil
+}
+
+// SetMembers will set member score
+func (m *Memory) SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	storedLeaderboard := m.getOrCreateLeaderboard(leaderboard)
+	for _, member := range databaseMembers {
+		storedLeaderboard.add(member.Member, member.Score)
+	}
+
+	return nil
+}
+
+// SetMembersTTL set members ttl and add leaderboard to expiration set
+func (m *Memory) SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, member := range databaseMembers {
+		m.setMemberTTL(leaderboard, member.Member, member.TTL)
+	}
+
+	return nil
+}
+
+// setMemberTTL must be called holding write mutex
+func (m *Memory) setMemberTTL(leaderboard, member string, expireAt time.Time) {
+	ttl, ok := m.ttls[leaderboard]
+	if !ok {
+		ttl = memory.NewSortedSet()
+		m.ttls[leaderboard] = ttl
+	}
+
+	ttl.Add(member, float64(expireAt.Unix()))
+	m.expirationSet[leaderboard] = true
+}
+
+// UpsertMembers write members score following update policy, apply members TTL and leaderboard expiration
+// and report members score and rank, all while holding database lock
+func (m *Memory) UpsertMembers(ctx context.Context, leaderboard string, databaseMembers []*Member, options *UpsertOptions) ([]*Member, error) {
+	if options.Order != "asc" && options.Order != "desc" {
+		return nil, NewInvalidOrderError(options.Order)
+	}
+
+	if !IsValidUpdatePolicy(options.UpdatePolicy) {
+		return nil, NewInvalidUpdatePolicyError(options.UpdatePolicy)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if write := m.idempotentWrite(leaderboard, options.IdempotencyKey); write != nil {
+		return copyMembers(write.members), nil
+	}
+
+	quarantined, err := m.checkScoreRules(leaderboard, databaseMembers, options)
+	if err != nil {
+		return nil, err
+	}
+	writes := make([]*Member, 0, len(databaseMembers))
+	for i, member := range databaseMembers {
+		if quarantined[i] == "" {
+			writes = append(writes, member)
+		}
+	}
+
+	if err := m.checkPreconditions(leaderboard, writes, options); err != nil {
+		return nil, err
+	}
+
+	if err := m.checkSumRange(leaderboard, writes, options); err != nil {
+		return nil, err
+	}
+
+	storedLeaderboard := m.getOrCreateLeaderboard(leaderboard)
+
+	previousRanks := make([]int64, 0, len(writes))
+	for _, member := range writes {
+		previousRank := int64(-1)
+		if options.PreviousRank {
+			if rank, ok := m.rank(storedLeaderboard, member.Member, options.Order); ok {
+				previousRank = rank
+			}
+		}
+		previousRanks = append(previousRanks, previousRank)
+	}
+
+	changed := make([]bool, 0, len(writes))
+	for _, member := range writes {
+		current, ok := storedLeaderboard.members.Score(member.Member)
+
+		switch {
+		case options.UpdatePolicy == UpdatePolicySum && options.ScoreScale > 1:
+			increment := math.Floor(member.Score / options.ScoreScale)
+			switch {
+			case !ok:
+				storedLeaderboard.add(member.Member, member.Score)
+			case increment != 0:
+				tie := member.Score - increment*options.ScoreScale
+				storedLeaderboard.add(member.Member, (math.Floor(current/options.ScoreScale)+increment)*options.ScoreScale+tie)
+			}
+			changed = append(changed, !ok || increment != 0)
+		case options.UpdatePolicy == UpdatePolicySum:
+			storedLeaderboard.incrBy(member.Member, member.Score)
+			changed = append(changed, !ok || member.Score != 0)
+		case !ok || shouldReplaceScore(options.UpdatePolicy, options.Order, current, member.Score):
+			storedLeaderboard.add(member.Member, member.Score)
+			changed = append(changed, true)
+		default:
+			changed = append(changed, false)
+		}
+	}
+
+	if !options.ExpireAt.IsZero() && storedLeaderboard.expireAt.IsZero() {
+		storedLeaderboard.expireAt = time.Unix(options.ExpireAt.Unix(), 0)
+	}
+
+	if !options.TTL.IsZero() {
+		for _, member := range writes {
+			m.setMemberTTL(leaderboard, member.Member, options.TTL)
+		}
+	}
+
+	members := make([]*Member, 0, len(databaseMembers))
+	written := 0
+	for i, member := range databaseMembers {
+		if rule := quarantined[i]; rule != "" {
+			members = append(members, &Member{Member: member.Member, Score: member.Score, Rank: -1, PreviousRank: -1, QuarantineRule: rule})
+			continue
+		}
+
+		score, _ := storedLeaderboard.members.Score(member.Member)
+		rank, _ := m.rank(storedLeaderboard, member.Member, options.Order)
+
+		upsertedMember := &Member{
+			Member:       member.Member,
+			Score:        score,
+			Rank:         rank,
+			PreviousRank: previousRanks[written],
+			ScoreChanged: changed[written],
+		}
+		if !options.TTL.IsZero() {
+			upsertedMember.TTL = options.TTL
+		}
+
+		members = append(members, upsertedMember)
+		written++
+	}
+
+	m.rememberWrite(leaderboard, options.IdempotencyKey, options.IdempotencyWindow, &memoryIdempotentWrite{members: copyMembers(members)})
+
+	return members, nil
+}
+
+// checkPreconditions return PreconditionFailedError if the stored score of any member doesn't match its precondition
+func (m *Memory) checkPreconditions(leaderboard string, databaseMembers []*Member, options *UpsertOptions) error {
+	for _, member := range databaseMembers {
+		precondition := options.Preconditions[member.Member]
+		if precondition == nil {
+			continue
+		}
+
+		var current *float64
+		if storedLeaderboard := m.getLeaderboard(leaderboard); storedLeaderboard != nil {
+			if score, ok := storedLeaderboard.members.Score(member.Member); ok {
+				current = &score
+			}
+		}
+
+		holds := current != nil
+		switch {
+		case precondition.Absent:
+			holds = current == nil
+		case precondition.ExpectedScore != nil && holds:
+			stored := *current
+			if options.ScoreScale > 1 {
+				stored = math.Floor(stored / options.ScoreScale)
+			}
+			holds = stored == *precondition.ExpectedScore
+		}
+		if !holds {
+			return NewPreconditionFailedError(leaderboard, member.Member, current)
+		}
+	}
+	return nil
+}
+
+// checkSumRange return SumOutOfRangeError if summing the score of any member results in a score out of SumRange
+func (m *Memory) checkSumRange(leaderboard string, databaseMembers []*Member, options *UpsertOptions) error {
+	if options.UpdatePolicy != UpdatePolicySum || options.SumRange == nil {
+		return nil
+	}
+
+	sums := map[string]float64{}
+	for _, member := range databaseMembers {
+		increment := member.Score
+		if options.ScoreScale > 1 {
+			increment = math.Floor(increment / options.ScoreScale)
+		}
+
+		sum, ok := sums[member.Member]
+		if !ok {
+			if storedLeaderboard := m.getLeaderboard(leaderboard); storedLeaderboard != nil {
+				sum, _ = storedLeaderboard.members.Score(member.Member)
+			}
+			if options.ScoreScale > 1 {
+				sum = math.Floor(sum / options.ScoreScale)
+			}
+		}
+
+		sum += increment
+		if sum < options.SumRange.Min || sum > options.SumRange.Max {
+			return NewSumOutOfRangeError(leaderboard, member.Member, sum)
+		}
+		sums[member.Member] = sum
+	}
+	return nil
+}
+
+// shouldReplaceScore reports if score must replace current one under update policy
+func shouldReplaceScore(updatePolicy, order string, current, score float64) bool {
+	higherIsBetter := order == "desc"
+
+	switch updatePolicy {
+	case UpdatePolicyBest:
+		return (higherIsBetter && score > current) || (!higherIsBetter && score < current)
+	case UpdatePolicyWorst:
+		return (higherIsBetter && score < current) || (!higherIsBetter && score > current)
+	}
+	return score != current
+}
+
+// memoryRangeLimit translate offset and count the way redis client does, both zero means no limit
+func memoryRangeLimit(offset, count int) (int64, int64) {
+	if offset == 0 && count == 0 {
+		return 0, -1
+	}
+	return int64(offset), int64(count)
+}
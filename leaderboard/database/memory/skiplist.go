@@ -0,0 +1,214 @@
+package memory
+
+import "math/rand"
+
+const (
+	skiplistMaxLevel    = 32
+	skiplistProbability = 0.25
+)
+
+type skiplistLevel struct {
+	forward *skiplistNode
+	// span is how many nodes are skipped when following forward, used to compute ranks
+	span int64
+}
+
+type skiplistNode struct {
+	member   string
+	score    float64
+	backward *skiplistNode
+	levels   []skiplistLevel
+}
+
+// skiplist is the same indexed skip list redis use in sorted sets, nodes are ordered by score
+// and then by member and every level keep spans so ranks are found in O(log n)
+type skiplist struct {
+	head   *skiplistNode
+	tail   *skiplistNode
+	length int64
+	level  int
+	random *rand.Rand
+}
+
+func newSkiplist(random *rand.Rand) *skiplist {
+	return &skiplist{
+		head:   &skiplistNode{levels: make([]skiplistLevel, skiplistMaxLevel)},
+		level:  1,
+		random: random,
+	}
+}
+
+// before report if node is placed before score and member
+func (n *skiplistNode) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+func (sl *skiplist) randomLevel() int {
+	level := 1
+	for level < skiplistMaxLevel && sl.random.Float64() < skiplistProbability {
+		level++
+	}
+	return level
+}
+
+func (sl *skiplist) insert(score float64, member string) {
+	var update [skiplistMaxLevel]*skiplistNode
+	var rank [skiplistMaxLevel]int64
+
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		if i != sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			rank[i] += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	level := sl.randomLevel()
+	if level > sl.level {
+		for i := sl.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = sl.head
+			update[i].levels[i].span = sl.length
+		}
+		sl.level = level
+	}
+
+	x = &skiplistNode{member: member, score: score, levels: make([]skiplistLevel, level)}
+	for i := 0; i < level; i++ {
+		x.levels[i].forward = update[i].levels[i].forward
+		update[i].levels[i].forward = x
+
+		x.levels[i].span = update[i].levels[i].span - (rank[0] - rank[i])
+		update[i].levels[i].span = (rank[0] - rank[i]) + 1
+	}
+
+	for i := level; i < sl.level; i++ {
+		update[i].levels[i].span++
+	}
+
+	if update[0] != sl.head {
+		x.backward = update[0]
+	}
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x
+	} else {
+		sl.tail = x
+	}
+	sl.length++
+}
+
+func (sl *skiplist) delete(score float64, member string) bool {
+	var update [skiplistMaxLevel]*skiplistNode
+
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	x = x.levels[0].forward
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+
+	for i := 0; i < sl.level; i++ {
+		if update[i].levels[i].forward == x {
+			update[i].levels[i].span += x.levels[i].span - 1
+			update[i].levels[i].forward = x.levels[i].forward
+		} else {
+			update[i].levels[i].span--
+		}
+	}
+
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x.backward
+	} else {
+		sl.tail = x.backward
+	}
+
+	for sl.level > 1 && sl.head.levels[sl.level-1].forward == nil {
+		sl.level--
+	}
+	sl.length--
+
+	return true
+}
+
+// rank return 1-based position of member, 0 if it is not in skiplist
+func (sl *skiplist) rank(score float64, member string) int64 {
+	var rank int64
+
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil &&
+			(x.levels[i].forward.before(score, member) ||
+				(x.levels[i].forward.score == score && x.levels[i].forward.member == member)) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+
+		if x != sl.head && x.member == member {
+			return rank
+		}
+	}
+
+	return 0
+}
+
+// byRank return node at 1-based position, nil if out of range
+func (sl *skiplist) byRank(rank int64) *skiplistNode {
+	var traversed int64
+
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && traversed+x.levels[i].span <= rank {
+			traversed += x.levels[i].span
+			x = x.levels[i].forward
+		}
+
+		if traversed == rank {
+			return x
+		}
+	}
+
+	return nil
+}
+
+// firstInRange return lowest node with score inside range, nil if there is none
+func (sl *skiplist) firstInRange(min, max ScoreBound) *skiplistNode {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && !min.isMinOf(x.levels[i].forward.score) {
+			x = x.levels[i].forward
+		}
+	}
+
+	x = x.levels[0].forward
+	if x == nil || !max.isMaxOf(x.score) {
+		return nil
+	}
+
+	return x
+}
+
+// lastInRange return highest node with score inside range, nil if there is none
+func (sl *skiplist) lastInRange(min, max ScoreBound) *skiplistNode {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && max.isMaxOf(x.levels[i].forward.score) {
+			x = x.levels[i].forward
+		}
+	}
+
+	if x == sl.head || !min.isMinOf(x.score) {
+		return nil
+	}
+
+	return x
+}
@@ -0,0 +1,241 @@
+package memory
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Member is a struct to be used by sorted set range operations
+type Member struct {
+	Member string
+	Score  float64
+}
+
+// SortedSet keep members ordered by score and then by member, with the same ordering and
+// range semantics of a redis sorted set. It isn't safe for concurrent use
+type SortedSet struct {
+	scores   map[string]float64
+	skiplist *skiplist
+}
+
+// NewSortedSet returns a new empty sorted set
+func NewSortedSet() *SortedSet {
+	return &SortedSet{
+		scores:   map[string]float64{},
+		skiplist: newSkiplist(rand.New(rand.NewSource(time.Now().UnixNano()))),
+	}
+}
+
+// Len return how many members are in sorted set
+func (s *SortedSet) Len() int64 {
+	return s.skiplist.length
+}
+
+// Add set member score, like redis ZADD
+func (s *SortedSet) Add(member string, score float64) {
+	if current, ok := s.scores[member]; ok {
+		if current == score {
+			return
+		}
+		s.skiplist.delete(current, member)
+	}
+
+	s.scores[member] = score
+	s.skiplist.insert(score, member)
+}
+
+// IncrBy add increment to member score and return the new score, like redis ZINCRBY
+func (s *SortedSet) IncrBy(member string, increment float64) float64 {
+	score := s.scores[member] + increment
+	s.Add(member, score)
+	return score
+}
+
+// Remove delete member and report if it was in sorted set, like redis ZREM
+func (s *SortedSet) Remove(member string) bool {
+	score, ok := s.scores[member]
+	if !ok {
+		return false
+	}
+
+	delete(s.scores, member)
+	return s.skiplist.delete(score, member)
+}
+
+// Score return member score, like redis ZSCORE
+func (s *SortedSet) Score(member string) (float64, bool) {
+	score, ok := s.scores[member]
+	return score, ok
+}
+
+// Rank return member 0-based position in ascending order, like redis ZRANK
+func (s *SortedSet) Rank(member string) (int64, bool) {
+	score, ok := s.scores[member]
+	if !ok {
+		return -1, false
+	}
+
+	return s.skiplist.rank(score, member) - 1, true
+}
+
+// RevRank return member 0-based position in descending order, like redis ZREVRANK
+func (s *SortedSet) RevRank(member string) (int64, bool) {
+	score, ok := s.scores[member]
+	if !ok {
+		return -1, false
+	}
+
+	return s.skiplist.length - s.skiplist.rank(score, member), true
+}
+
+// Range return members between start and stop positions in ascending order, like redis ZRANGE
+// it is inclusive and negative positions are counted from the end
+func (s *SortedSet) Range(start, stop int64) []*Member {
+	start, stop, ok := s.normalizeRange(start, stop)
+	if !ok {
+		return []*Member{}
+	}
+
+	members := make([]*Member, 0, stop-start+1)
+	for x := s.skiplist.byRank(start + 1); x != nil && int64(len(members)) < stop-start+1; x = x.levels[0].forward {
+		members = append(members, &Member{Member: x.member, Score: x.score})
+	}
+
+	return members
+}
+
+// RevRange return members between start and stop positions in descending order, like redis ZREVRANGE
+func (s *SortedSet) RevRange(start, stop int64) []*Member {
+	start, stop, ok := s.normalizeRange(start, stop)
+	if !ok {
+		return []*Member{}
+	}
+
+	members := make([]*Member, 0, stop-start+1)
+	for x := s.skiplist.byRank(s.skiplist.length - start); x != nil && int64(len(members)) < stop-start+1; x = x.backward {
+		members = append(members, &Member{Member: x.member, Score: x.score})
+	}
+
+	return members
+}
+
+func (s *SortedSet) normalizeRange(start, stop int64) (int64, int64, bool) {
+	length := s.skiplist.length
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	if start > stop || start >= length {
+		return 0, 0, false
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+
+	return start, stop, true
+}
+
+// RangeByScore return members with score between min and max in ascending order, like redis
+// ZRANGEBYSCORE, skipping offset members and returning at most count members, negative count means all
+func (s *SortedSet) RangeByScore(min, max ScoreBound, offset, count int64) []*Member {
+	return collectRange(s.skiplist.firstInRange(min, max), func(x *skiplistNode) *skiplistNode {
+		x = x.levels[0].forward
+		if x == nil || !max.isMaxOf(x.score) {
+			return nil
+		}
+		return x
+	}, offset, count)
+}
+
+// RevRangeByScore return members with score between min and max in descending order, like redis ZREVRANGEBYSCORE
+func (s *SortedSet) RevRangeByScore(min, max ScoreBound, offset, count int64) []*Member {
+	return collectRange(s.skiplist.lastInRange(min, max), func(x *skiplistNode) *skiplistNode {
+		x = x.backward
+		if x == nil || !min.isMinOf(x.score) {
+			return nil
+		}
+		return x
+	}, offset, count)
+}
+
+// Count return how many members have score between min and max, like redis ZCOUNT
+func (s *SortedSet) Count(min, max ScoreBound) int64 {
+	first := s.skiplist.firstInRange(min, max)
+	if first == nil {
+		return 0
+	}
+
+	last := s.skiplist.lastInRange(min, max)
+	return s.skiplist.rank(last.score, last.member) - s.skiplist.rank(first.score, first.member) + 1
+}
+
+func collectRange(first *skiplistNode, next func(*skiplistNode) *skiplistNode, offset, count int64) []*Member {
+	members := []*Member{}
+	if offset < 0 {
+		return members
+	}
+
+	x := first
+	for ; x != nil && offset > 0; offset-- {
+		x = next(x)
+	}
+
+	for ; x != nil && count != 0; count-- {
+		members = append(members, &Member{Member: x.member, Score: x.score})
+		x = next(x)
+	}
+
+	return members
+}
+
+// ScoreBound is a limit of a score range
+type ScoreBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// ParseScoreBound parse a score limit using redis syntax, "-inf", "+inf", "1.5" or "(1.5" for exclusive limits
+func ParseScoreBound(bound string) (ScoreBound, error) {
+	exclusive := strings.HasPrefix(bound, "(")
+	value := strings.TrimPrefix(bound, "(")
+
+	switch value {
+	case "-inf":
+		return ScoreBound{Value: math.Inf(-1), Exclusive: exclusive}, nil
+	case "+inf", "inf":
+		return ScoreBound{Value: math.Inf(1), Exclusive: exclusive}, nil
+	}
+
+	score, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(score) {
+		return ScoreBound{}, fmt.Errorf("min or max is not a float: %s", bound)
+	}
+
+	return ScoreBound{Value: score, Exclusive: exclusive}, nil
+}
+
+// isMinOf report if score is above bound when it is used as range minimum
+func (b ScoreBound) isMinOf(score float64) bool {
+	if b.Exclusive {
+		return score > b.Value
+	}
+	return score >= b.Value
+}
+
+// isMaxOf report if score is below bound when it is used as range maximum
+func (b ScoreBound) isMaxOf(score float64) bool {
+	if b.Exclusive {
+		return score < b.Value
+	}
+	return score <= b.Value
+}
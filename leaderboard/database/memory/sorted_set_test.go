@@ -0,0 +1,197 @@
+package memory_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database/memory"
+)
+
+var _ = Describe("Sorted Set", func() {
+	var sortedSet *memory.SortedSet
+
+	BeforeEach(func() {
+		sortedSet = memory.NewSortedSet()
+		sortedSet.Add("c", 2)
+		sortedSet.Add("a", 1)
+		sortedSet.Add("b", 2)
+		sortedSet.Add("d", 3)
+	})
+
+	Describe("Add", func() {
+		It("Should order members by score and then by member", func() {
+			Expect(sortedSet.Range(0, -1)).To(Equal([]*memory.Member{
+				{Member: "a", Score: 1},
+				{Member: "b", Score: 2},
+				{Member: "c", Score: 2},
+				{Member: "d", Score: 3},
+			}))
+		})
+
+		It("Should replace member score", func() {
+			sortedSet.Add("a", 4)
+
+			Expect(sortedSet.Len()).To(BeEquivalentTo(4))
+			rank, ok := sortedSet.Rank("a")
+			Expect(ok).To(BeTrue())
+			Expect(rank).To(BeEquivalentTo(3))
+		})
+	})
+
+	Describe("IncrBy", func() {
+		It("Should add increment to member score", func() {
+			Expect(sortedSet.IncrBy("a", 2)).To(Equal(3.0))
+			Expect(sortedSet.IncrBy("new", 5)).To(Equal(5.0))
+
+			Expect(sortedSet.RevRange(0, 1)).To(Equal([]*memory.Member{
+				{Member: "new", Score: 5},
+				{Member: "d", Score: 3},
+			}))
+		})
+	})
+
+	Describe("Remove", func() {
+		It("Should remove member", func() {
+			Expect(sortedSet.Remove("b")).To(BeTrue())
+			Expect(sortedSet.Remove("b")).To(BeFalse())
+
+			_, ok := sortedSet.Score("b")
+			Expect(ok).To(BeFalse())
+			rank, _ := sortedSet.Rank("c")
+			Expect(rank).To(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("Rank and RevRank", func() {
+		It("Should return member position in both orders", func() {
+			rank, ok := sortedSet.Rank("b")
+			Expect(ok).To(BeTrue())
+			Expect(rank).To(BeEquivalentTo(1))
+
+			rank, ok = sortedSet.RevRank("b")
+			Expect(ok).To(BeTrue())
+			Expect(rank).To(BeEquivalentTo(2))
+		})
+
+		It("Should return false if member is not in sorted set", func() {
+			_, ok := sortedSet.Rank("invalid")
+			Expect(ok).To(BeFalse())
+
+			_, ok = sortedSet.RevRank("invalid")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Range and RevRange", func() {
+		It("Should handle negative and out of range positions", func() {
+			Expect(sortedSet.Range(-2, -1)).To(Equal([]*memory.Member{
+				{Member: "c", Score: 2},
+				{Member: "d", Score: 3},
+			}))
+			Expect(sortedSet.RevRange(3, 10)).To(Equal([]*memory.Member{
+				{Member: "a", Score: 1},
+			}))
+			Expect(sortedSet.Range(4, 10)).To(BeEmpty())
+			Expect(sortedSet.Range(2, 1)).To(BeEmpty())
+		})
+	})
+
+	Describe("RangeByScore and RevRangeByScore", func() {
+		It("Should return members inside inclusive range", func() {
+			min, _ := memory.ParseScoreBound("2")
+			max, _ := memory.ParseScoreBound("+inf")
+
+			Expect(sortedSet.RangeByScore(min, max, 0, -1)).To(Equal([]*memory.Member{
+				{Member: "b", Score: 2},
+				{Member: "c", Score: 2},
+				{Member: "d", Score: 3},
+			}))
+		})
+
+		It("Should return members inside exclusive range in reverse order with limit", func() {
+			min, _ := memory.ParseScoreBound("-inf")
+			max, _ := memory.ParseScoreBound("(3")
+
+			Expect(sortedSet.RevRangeByScore(min, max, 1, 2)).To(Equal([]*memory.Member{
+				{Member: "b", Score: 2},
+				{Member: "a", Score: 1},
+			}))
+		})
+
+		It("Should return empty list if no score is inside range", func() {
+			min, _ := memory.ParseScoreBound("(3")
+			max, _ := memory.ParseScoreBound("inf")
+
+			Expect(sortedSet.RangeByScore(min, max, 0, -1)).To(BeEmpty())
+			Expect(sortedSet.RevRangeByScore(min, max, 0, -1)).To(BeEmpty())
+		})
+	})
+
+	Describe("Count", func() {
+		It("Should count members inside inclusive and exclusive ranges", func() {
+			Expect(sortedSet.Count(memory.ScoreBound{Value: 2}, memory.ScoreBound{Value: 2})).To(BeEquivalentTo(2))
+			Expect(sortedSet.Count(memory.ScoreBound{Value: 1, Exclusive: true}, memory.ScoreBound{Value: math.Inf(1)})).To(BeEquivalentTo(3))
+			Expect(sortedSet.Count(memory.ScoreBound{Value: math.Inf(-1)}, memory.ScoreBound{Value: 3, Exclusive: true})).To(BeEquivalentTo(3))
+			Expect(sortedSet.Count(memory.ScoreBound{Value: 4}, memory.ScoreBound{Value: math.Inf(1)})).To(BeEquivalentTo(0))
+		})
+	})
+
+	Describe("ParseScoreBound", func() {
+		It("Should parse redis score syntax", func() {
+			Expect(memory.ParseScoreBound("-inf")).To(Equal(memory.ScoreBound{Value: math.Inf(-1)}))
+			Expect(memory.ParseScoreBound("(10")).To(Equal(memory.ScoreBound{Value: 10, Exclusive: true}))
+		})
+
+		It("Should return error if bound is not a float", func() {
+			_, err := memory.ParseScoreBound("invalid")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("Should keep ranks consistent with a sorted list after random writes", func() {
+		random := rand.New(rand.NewSource(42))
+		sortedSet = memory.NewSortedSet()
+		expected := map[string]float64{}
+
+		for i := 0; i < 5000; i++ {
+			member := fmt.Sprintf("member-%d", random.Intn(500))
+			switch random.Intn(3) {
+			case 0:
+				score := float64(random.Intn(100))
+				sortedSet.Add(member, score)
+				expected[member] = score
+			case 1:
+				expected[member] = sortedSet.IncrBy(member, float64(random.Intn(10)))
+			case 2:
+				sortedSet.Remove(member)
+				delete(expected, member)
+			}
+		}
+
+		members := make([]*memory.Member, 0, len(expected))
+		for member, score := range expected {
+			members = append(members, &memory.Member{Member: member, Score: score})
+		}
+		sort.Slice(members, func(i, j int) bool {
+			if members[i].Score != members[j].Score {
+				return members[i].Score < members[j].Score
+			}
+			return members[i].Member < members[j].Member
+		})
+
+		Expect(sortedSet.Range(0, -1)).To(Equal(members))
+		for i, member := range members {
+			rank, ok := sortedSet.Rank(member.Member)
+			Expect(ok).To(BeTrue())
+			Expect(rank).To(BeEquivalentTo(i))
+
+			revRank, ok := sortedSet.RevRank(member.Member)
+			Expect(ok).To(BeTrue())
+			Expect(revRank).To(BeEquivalentTo(len(members) - 1 - i))
+		}
+	})
+})
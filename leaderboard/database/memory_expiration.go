@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/memory"
+)
+
+var _ Expiration = &Memory{}
+
+// GetExpirationLeaderboards return leaderboards registerd with members to expire
+func (m *Memory) GetExpirationLeaderboards(ctx context.Context) ([]string, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	expirationLeaderboards := make([]string, 0, len(m.expirationSet))
+	for leaderboard := range m.expirationSet {
+		expirationLeaderboards = append(expirationLeaderboards, leaderboard)
+	}
+	sort.Strings(expirationLeaderboards)
+
+	return expirationLeaderboards, nil
+}
+
+// GetMembersToExpire get members in the leaderboard to expire
+func (m *Memory) GetMembersToExpire(ctx context.Context, leaderboard string, amount int, maxTime time.Time) ([]string, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	ttl, ok := m.ttls[leaderboard]
+	if !ok {
+		return nil, NewLeaderboardWithoutMemberToExpireError(leaderboard)
+	}
+
+	min, _ := memory.ParseScoreBound("-inf")
+	max, _ := memory.ParseScoreBound(strconv.FormatInt(maxTime.Unix(), 10))
+	offset, count := memoryRangeLimit(0, amount)
+
+	expiredMembers := ttl.RangeByScore(min, max, offset, count)
+
+	members := make([]string, 0, len(expiredMembers))
+	for _, member := range expiredMembers {
+		members = append(members, member.Member)
+	}
+
+	return members, nil
+}
+
+// RemoveLeaderboardFromExpireList remove from leaderboard expiration list the leaderboard
+func (m *Memory) RemoveLeaderboardFromExpireList(ctx context.Context, leaderboard string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.expirationSet, leaderboard)
+	return nil
+}
+
+// ExpireMembers remove members from leaderboard
+func (m *Memory) ExpireMembers(ctx context.Context, leaderboard string, members []string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if storedLeaderboard := m.getLeaderboard(leaderboard); storedLeaderboard != nil {
+		for _, member := range members {
+			storedLeaderboard.remove(member)
+		}
+	}
+
+	if ttl, ok := m.ttls[leaderboard]; ok {
+		for _, member := range members {
+			ttl.Remove(member)
+		}
+	}
+
+	m.removeIfEmpty(leaderboard)
+	return nil
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// memoryIdempotentWrite is the result of a write made with an idempotency key, like
+// "<leaderboard>:idempotency:<key>" keys in redis
+type memoryIdempotentWrite struct {
+	members      []*Member
+	ratedMembers []*RatedMember
+	expireAt     time.Time
+}
+
+// idempotentWrite return the write made with key on leaderboard while it's kept, nil if there is none
+func (m *Memory) idempotentWrite(leaderboard, key string) *memoryIdempotentWrite {
+	if key == "" {
+		return nil
+	}
+
+	now := time.Now()
+	writes := m.idempotentWrites[leaderboard]
+	for storedKey, write := range writes {
+		if !write.expireAt.After(now) {
+			delete(writes, storedKey)
+		}
+	}
+
+	return writes[key]
+}
+
+// rememberWrite keep the result of a write made with key on leaderboard for window
+func (m *Memory) rememberWrite(leaderboard, key string, window time.Duration, write *memoryIdempotentWrite) {
+	if key == "" || window <= 0 {
+		return
+	}
+
+	if _, ok := m.idempotentWrites[leaderboard]; !ok {
+		m.idempotentWrites[leaderboard] = map[string]*memoryIdempotentWrite{}
+	}
+
+	write.expireAt = time.Now().Add(window)
+	m.idempotentWrites[leaderboard][key] = write
+}
+
+// GetIdempotentMembers return the members reported by the UpsertMembers call made with idempotencyKey on
+// leaderboard, nil if it isn't kept
+func (m *Memory) GetIdempotentMembers(ctx context.Context, leaderboard, idempotencyKey string) ([]*Member, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	write := m.idempotentWrite(leaderboard, idempotencyKey)
+	if write == nil || write.members == nil {
+		return nil, nil
+	}
+	return copyMembers(write.members), nil
+}
+
+func copyMembers(members []*Member) []*Member {
+	copied := make([]*Member, 0, len(members))
+	for _, member := range members {
+		copiedMember := *member
+		copied = append(copied, &copiedMember)
+	}
+	return copied
+}
+
+func copyRatedMembers(members []*RatedMember) []*RatedMember {
+	copied := make([]*RatedMember, 0, len(members))
+	for _, member := range members {
+		copiedMember := &RatedMember{Member: copyMembers([]*Member{member.Member})[0]}
+		if member.PreviousRating != nil {
+			previousRating := *member.PreviousRating
+			copiedMember.PreviousRating = &previousRating
+		}
+		rating := *member.Rating
+		copiedMember.Rating = &rating
+		copied = append(copied, copiedMember)
+	}
+	return copied
+}
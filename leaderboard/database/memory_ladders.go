@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/memory"
+)
+
+var _ Ladders = &Memory{}
+
+// ReportChallenge reorder ladder positions by a challenge result and return the challenger and the defender
+// score and rank in ascending position order, it returns ChallengeOutOfRangeError without writing anything
+// if the defender isn't ranked above the challenger within the challenge max distance
+func (m *Memory) ReportChallenge(ctx context.Context, leaderboard string, challenge *Challenge, options *LadderOptions) ([]*Member, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if write := m.idempotentWrite(leaderboard, options.IdempotencyKey); write != nil {
+		return copyMembers(write.members), nil
+	}
+
+	storedLeaderboard := m.getOrCreateLeaderboard(leaderboard)
+
+	previousDefenderRank, defenderFound := storedLeaderboard.members.Rank(challenge.Defender)
+	previousChallengerRank, challengerFound := storedLeaderboard.members.Rank(challenge.Challenger)
+	previousDefenderPosition, _ := storedLeaderboard.members.Score(challenge.Defender)
+	previousChallengerPosition, _ := storedLeaderboard.members.Score(challenge.Challenger)
+
+	nextRank := storedLeaderboard.members.Len()
+	defenderRank := previousDefenderRank
+	if !defenderFound {
+		previousDefenderRank = -1
+		defenderRank = nextRank
+		nextRank++
+	}
+	challengerRank := previousChallengerRank
+	if !challengerFound {
+		previousChallengerRank = -1
+		challengerRank = nextRank
+	}
+
+	distance := challengerRank - defenderRank
+	if distance <= 0 || (challenge.MaxDistance > 0 && distance > int64(challenge.MaxDistance)) {
+		m.removeIfEmpty(leaderboard)
+		return nil, NewChallengeOutOfRangeError(leaderboard, challenge.Challenger, challenge.Defender)
+	}
+
+	join := func(member string) {
+		position := 1.0
+		if last := storedLeaderboard.members.RevRange(0, 0); len(last) > 0 {
+			position = last[0].Score + 1
+		}
+		storedLeaderboard.add(member, position)
+	}
+	if !defenderFound {
+		join(challenge.Defender)
+	}
+	if !challengerFound {
+		join(challenge.Challenger)
+	}
+
+	if challenge.ChallengerWon {
+		defenderPosition, _ := storedLeaderboard.members.Score(challenge.Defender)
+		challengerPosition, _ := storedLeaderboard.members.Score(challenge.Challenger)
+		shifted := storedLeaderboard.members.RangeByScore(
+			memory.ScoreBound{Value: defenderPosition},
+			memory.ScoreBound{Value: challengerPosition, Exclusive: true},
+			0, -1,
+		)
+		storedLeaderboard.add(challenge.Challenger, defenderPosition)
+		for _, member := range shifted {
+			storedLeaderboard.add(member.Member, member.Score+1)
+		}
+	}
+
+	if !options.ExpireAt.IsZero() && storedLeaderboard.expireAt.IsZero() {
+		storedLeaderboard.expireAt = time.Unix(options.ExpireAt.Unix(), 0)
+	}
+
+	members := make([]*Member, 0, 2)
+	for _, previous := range []struct {
+		member   string
+		found    bool
+		rank     int64
+		position float64
+	}{
+		{challenge.Challenger, challengerFound, previousChallengerRank, previousChallengerPosition},
+		{challenge.Defender, defenderFound, previousDefenderRank, previousDefenderPosition},
+	} {
+		position, _ := storedLeaderboard.members.Score(previous.member)
+		rank, _ := storedLeaderboard.members.Rank(previous.member)
+		members = append(members, &Member{
+			Member:       previous.member,
+			Score:        position,
+			Rank:         rank,
+			PreviousRank: previous.rank,
+			ScoreChanged: !previous.found || position != previous.position,
+		})
+	}
+
+	m.rememberWrite(leaderboard, options.IdempotencyKey, options.IdempotencyWindow, &memoryIdempotentWrite{members: copyMembers(members)})
+
+	return members, nil
+}
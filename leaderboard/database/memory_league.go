@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+var _ Leagues = &Memory{}
+
+type memoryLeague struct {
+	buckets  map[string]int
+	expireAt time.Time
+}
+
+// getLeague return league if it exists and isn't expired, must be called holding mutex
+func (m *Memory) getLeague(league string) *memoryLeague {
+	storedLeague, ok := m.leagues[league]
+	if !ok {
+		return nil
+	}
+
+	if !storedLeague.expireAt.IsZero() && !storedLeague.expireAt.After(time.Now()) {
+		return nil
+	}
+
+	return storedLeague
+}
+
+// AssignLeagueBuckets return the bucket of each member, members that aren't in a bucket are assigned to the open
+// one, which is closed once it has bucketSize members, and league buckets expire at expireAt if it isn't zero
+func (m *Memory) AssignLeagueBuckets(ctx context.Context, league string, members []string, bucketSize int, expireAt time.Time) (map[string]int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	storedLeague := m.getLeague(league)
+	if storedLeague == nil {
+		storedLeague = &memoryLeague{buckets: map[string]int{}}
+		m.leagues[league] = storedLeague
+	}
+
+	buckets := make(map[string]int, len(members))
+	for _, member := range members {
+		bucket, ok := storedLeague.buckets[member]
+		if !ok {
+			bucket = len(storedLeague.buckets) / bucketSize
+			storedLeague.buckets[member] = bucket
+			if !expireAt.IsZero() && storedLeague.expireAt.IsZero() {
+				storedLeague.expireAt = time.Unix(expireAt.Unix(), 0)
+			}
+		}
+		buckets[member] = bucket
+	}
+
+	return buckets, nil
+}
+
+// GetLeagueBuckets return the bucket of each member, members that aren't in a bucket are left out
+func (m *Memory) GetLeagueBuckets(ctx context.Context, league string, members ...string) (map[string]int, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	buckets := make(map[string]int, len(members))
+	storedLeague := m.getLeague(league)
+	if storedLeague == nil {
+		return buckets, nil
+	}
+
+	for _, member := range members {
+		if bucket, ok := storedLeague.buckets[member]; ok {
+			buckets[member] = bucket
+		}
+	}
+
+	return buckets, nil
+}
+
+// GetLeagueBucketsCount return how many buckets league has
+func (m *Memory) GetLeagueBucketsCount(ctx context.Context, league string) (int, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storedLeague := m.getLeague(league)
+	if storedLeague == nil {
+		return 0, nil
+	}
+
+	count := 0
+	for _, bucket := range storedLeague.buckets {
+		if bucket+1 > count {
+			count = bucket + 1
+		}
+	}
+
+	return count, nil
+}
+
+// RemoveLeague forget the bucket of every league member
+func (m *Memory) RemoveLeague(ctx context.Context, league string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.leagues, league)
+	return nil
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+var _ Nonces = &Memory{}
+
+// ClaimNonce remember nonce of tenant for ttl, returning false if it was already remembered
+func (m *Memory) ClaimNonce(ctx context.Context, tenant, nonce string, ttl time.Duration) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	for key, expireAt := range m.nonces {
+		if !expireAt.After(now) {
+			delete(m.nonces, key)
+		}
+	}
+
+	key := tenant + ":" + nonce
+	if _, ok := m.nonces[key]; ok {
+		return false, nil
+	}
+	m.nonces[key] = now.Add(ttl)
+
+	return true, nil
+}
+
+// ReleaseNonce forget nonce of tenant, so it can be claimed again
+func (m *Memory) ReleaseNonce(ctx context.Context, tenant, nonce string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.nonces, tenant+":"+nonce)
+
+	return nil
+}
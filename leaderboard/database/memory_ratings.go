@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+var _ Ratings = &Memory{}
+
+// GetRatings return the rating of each member, members that were never rated are left out
+func (m *Memory) GetRatings(ctx context.Context, leaderboard string, members ...string) (map[string]*Rating, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	ratings := make(map[string]*Rating, len(members))
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+	if storedLeaderboard == nil {
+		return ratings, nil
+	}
+
+	for _, member := range members {
+		if rating, ok := storedLeaderboard.ratings[member]; ok {
+			storedRating := *rating
+			ratings[member] = &storedRating
+		}
+	}
+
+	return ratings, nil
+}
+
+// WriteRatings write members rating and score and return members score, rank and rating, it returns
+// RatingConflictError without writing anything if any member rating was written since the one its update
+// was computed from
+func (m *Memory) WriteRatings(ctx context.Context, leaderboard string, updates []*RatingUpdate, options *RatingOptions) ([]*RatedMember, error) {
+	if options.Order != "asc" && options.Order != "desc" {
+		return nil, NewInvalidOrderError(options.Order)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if write := m.idempotentWrite(leaderboard, options.IdempotencyKey); write != nil {
+		return copyRatedMembers(write.ratedMembers), nil
+	}
+
+	storedLeaderboard := m.getOrCreateLeaderboard(leaderboard)
+
+	previousRatings := make([]*Rating, 0, len(updates))
+	for _, update := range updates {
+		matches := 0
+		var previousRating *Rating
+		if rating, ok := storedLeaderboard.ratings[update.Member]; ok {
+			matches = rating.Matches
+			storedRating := *rating
+			previousRating = &storedRating
+		}
+		if matches != update.Rating.Matches-1 {
+			m.removeIfEmpty(leaderboard)
+			return nil, NewRatingConflictError(leaderboard)
+		}
+		previousRatings = append(previousRatings, previousRating)
+	}
+
+	previousRanks := make([]int64, 0, len(updates))
+	for _, update := range updates {
+		previousRank := int64(-1)
+		if rank, ok := m.rank(storedLeaderboard, update.Member, options.Order); ok {
+			previousRank = rank
+		}
+		previousRanks = append(previousRanks, previousRank)
+	}
+
+	changed := make([]bool, 0, len(updates))
+	for _, update := range updates {
+		current, ok := storedLeaderboard.members.Score(update.Member)
+		if !ok || current != update.Score {
+			storedLeaderboard.add(update.Member, update.Score)
+		}
+		rating := *update.Rating
+		storedLeaderboard.ratings[update.Member] = &rating
+		changed = append(changed, !ok || current != update.Score)
+	}
+
+	if !options.ExpireAt.IsZero() && storedLeaderboard.expireAt.IsZero() {
+		storedLeaderboard.expireAt = time.Unix(options.ExpireAt.Unix(), 0)
+	}
+
+	members := make([]*RatedMember, 0, len(updates))
+	for i, update := range updates {
+		rank, _ := m.rank(storedLeaderboard, update.Member, options.Order)
+		rating := *update.Rating
+		members = append(members, &RatedMember{
+			Member: &Member{
+				Member:       update.Member,
+				Score:        update.Score,
+				Rank:         rank,
+				PreviousRank: previousRanks[i],
+				ScoreChanged: changed[i],
+			},
+			PreviousRating: previousRatings[i],
+			Rating:         &rating,
+		})
+	}
+
+	m.rememberWrite(leaderboard, options.IdempotencyKey, options.IdempotencyWindow, &memoryIdempotentWrite{ratedMembers: copyRatedMembers(members)})
+
+	return members, nil
+}
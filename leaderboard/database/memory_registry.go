@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"sort"
+)
+
+var _ Registry = &Memory{}
+
+// CreateLeaderboardDefinition register a leaderboard definition, fail if leaderboard is already registered
+func (m *Memory) CreateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.definitions[definition.ID]; ok {
+		return NewLeaderboardDefinitionAlreadyExistsError(definition.ID)
+	}
+
+	stored := *definition
+	m.definitions[definition.ID] = &stored
+	return nil
+}
+
+// GetLeaderboardDefinition return leaderboard definition
+func (m *Memory) GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*LeaderboardDefinition, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	stored, ok := m.definitions[leaderboard]
+	if !ok {
+		return nil, NewLeaderboardDefinitionNotFoundError(leaderboard)
+	}
+
+	definition := *stored
+	return &definition, nil
+}
+
+// ListLeaderboardDefinitions return every leaderboard definition ordered by leaderboard id
+func (m *Memory) ListLeaderboardDefinitions(ctx context.Context) ([]*LeaderboardDefinition, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	definitions := make([]*LeaderboardDefinition, 0, len(m.definitions))
+	for _, stored := range m.definitions {
+		definition := *stored
+		definitions = append(definitions, &definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].ID < definitions[j].ID
+	})
+
+	return definitions, nil
+}
+
+// RemoveLeaderboardDefinition unregister a leaderboard, its members are kept
+func (m *Memory) RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.definitions[leaderboard]; !ok {
+		return NewLeaderboardDefinitionNotFoundError(leaderboard)
+	}
+
+	delete(m.definitions, leaderboard)
+	return nil
+}
+
+// UpdateLeaderboardDefinition replace a leaderboard definition, fail if leaderboard isn't registered
+func (m *Memory) UpdateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.definitions[definition.ID]; !ok {
+		return NewLeaderboardDefinitionNotFoundError(definition.ID)
+	}
+
+	stored := *definition
+	m.definitions[definition.ID] = &stored
+	return nil
+}
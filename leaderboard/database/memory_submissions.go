@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+var _ Submissions = &Memory{}
+
+type memorySubmissions struct {
+	windowStart time.Time
+	submissions int
+	deltaStart  time.Time
+	baseScore   float64
+}
+
+// checkScoreRules count a submission of each member and return the rules broken by the members held for
+// review, by their index in databaseMembers, or the first ScoreRuleViolationError if rules don't hold them
+func (m *Memory) checkScoreRules(leaderboard string, databaseMembers []*Member, options *UpsertOptions) (map[int]string, error) {
+	rules := options.ScoreRules
+	if rules == nil {
+		return nil, nil
+	}
+
+	unit := rules.ScoreUnit
+	if unit <= 0 {
+		unit = 1
+	}
+	ruleScore := func(stored float64) float64 {
+		if options.ScoreScale > 1 {
+			stored = math.Floor(stored / options.ScoreScale)
+		}
+		return stored / unit
+	}
+
+	storedSubmissions, ok := m.submissions[leaderboard]
+	if !ok {
+		storedSubmissions = map[string]*memorySubmissions{}
+		m.submissions[leaderboard] = storedSubmissions
+	}
+	storedLeaderboard := m.getLeaderboard(leaderboard)
+
+	quarantined := map[int]string{}
+	var violation error
+	for i, member := range databaseMembers {
+		stored, present := float64(0), false
+		if storedLeaderboard != nil {
+			stored, present = storedLeaderboard.members.Score(member.Member)
+		}
+		submissions := trackSubmission(storedSubmissions, member.Member, stored, rules)
+
+		submitted := ruleScore(member.Score)
+		score := submitted
+		if present {
+			score = resultingRuleScore(ruleScore(stored), submitted, options)
+		}
+
+		rule, value, limit := rules.check(score, submitted, ruleScore(submissions.baseScore), submissions.submissions, options.UpdatePolicy)
+		switch {
+		case rule == "":
+		case rules.Quarantine:
+			quarantine, ok := m.quarantines[leaderboard]
+			if !ok {
+				quarantine = map[string]*QuarantineEntry{}
+				m.quarantines[leaderboard] = quarantine
+			}
+			quarantine[member.Member] = &QuarantineEntry{Member: member.Member, Score: score, Rule: rule, SubmittedAt: rules.Now}
+			quarantined[i] = rule
+		case violation == nil:
+			violation = NewScoreRuleViolationError(leaderboard, member.Member, rule, value, limit)
+		}
+	}
+
+	if violation != nil {
+		return nil, violation
+	}
+	return quarantined, nil
+}
+
+// trackSubmission count a submission of member, whose stored score is score, in the windows of rules
+func trackSubmission(storedSubmissions map[string]*memorySubmissions, member string, score float64, rules *ScoreRules) *memorySubmissions {
+	submissions, ok := storedSubmissions[member]
+	if !ok {
+		submissions = &memorySubmissions{baseScore: score}
+		if rules.SubmissionWindow > 0 || rules.DeltaWindow > 0 {
+			storedSubmissions[member] = submissions
+		}
+	}
+
+	if rules.SubmissionWindow > 0 {
+		if rules.Now.Sub(submissions.windowStart) >= rules.SubmissionWindow {
+			submissions.windowStart = rules.Now
+			submissions.submissions = 0
+		}
+		submissions.submissions++
+	}
+	if rules.DeltaWindow == 0 || rules.Now.Sub(submissions.deltaStart) >= rules.DeltaWindow {
+		submissions.deltaStart = rules.Now
+		submissions.baseScore = score
+	}
+
+	return submissions
+}
+
+// resultingRuleScore return the score a member with current score ends up with after score is written
+func resultingRuleScore(current, score float64, options *UpsertOptions) float64 {
+	switch options.UpdatePolicy {
+	case UpdatePolicySum:
+		return current + score
+	case UpdatePolicyBest, UpdatePolicyWorst:
+		if !shouldReplaceScore(options.UpdatePolicy, options.Order, current, score) {
+			return current
+		}
+	}
+	return score
+}
+
+// check return the first rule broken by a submission of submitted leaving a member with score, along with
+// what broke it and the rule limit, an empty rule if none is broken
+func (r *ScoreRules) check(score, submitted, baseScore float64, submissions int, updatePolicy string) (string, float64, float64) {
+	switch {
+	case r.MinScore != nil && score < *r.MinScore:
+		return ScoreRuleMinScore, score, *r.MinScore
+	case r.MaxScore != nil && score > *r.MaxScore:
+		return ScoreRuleMaxScore, score, *r.MaxScore
+	case r.MaxIncrement > 0 && updatePolicy == UpdatePolicySum && math.Abs(submitted) > r.MaxIncrement:
+		return ScoreRuleMaxIncrement, math.Abs(submitted), r.MaxIncrement
+	case r.MaxDelta > 0 && math.Abs(score-baseScore) > r.MaxDelta:
+		return ScoreRuleMaxDelta, math.Abs(score - baseScore), r.MaxDelta
+	case r.MaxSubmissions > 0 && submissions > r.MaxSubmissions:
+		return ScoreRuleMaxSubmissions, float64(submissions), float64(r.MaxSubmissions)
+	}
+	return "", 0, 0
+}
+
+// GetQuarantinedMembers return the score writes held for review, oldest first
+func (m *Memory) GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*QuarantineEntry, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	entries := make([]*QuarantineEntry, 0, len(m.quarantines[leaderboard]))
+	for _, entry := range m.quarantines[leaderboard] {
+		storedEntry := *entry
+		entries = append(entries, &storedEntry)
+	}
+	sortQuarantineEntries(entries)
+
+	return entries, nil
+}
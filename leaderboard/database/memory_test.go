@@ -0,0 +1,10 @@
+package database_test
+
+import (
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/dbtest"
+)
+
+var _ = dbtest.DescribeConformance("Memory Database", func() dbtest.Backend {
+	return database.NewMemoryDatabase()
+})
@@ -35,6 +35,61 @@ func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
+// CountMembersAhead mocks base method.
+func (m *MockDatabase) CountMembersAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, order}
+	for _, a := range scores {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountMembersAhead", varargs...)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMembersAhead indicates an expected call of CountMembersAhead.
+func (mr *MockDatabaseMockRecorder) CountMembersAhead(ctx, leaderboard, order interface{}, scores ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, order}, scores...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMembersAhead", reflect.TypeOf((*MockDatabase)(nil).CountMembersAhead), varargs...)
+}
+
+// CountScoresAhead mocks base method.
+func (m *MockDatabase) CountScoresAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, order}
+	for _, a := range scores {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountScoresAhead", varargs...)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountScoresAhead indicates an expected call of CountScoresAhead.
+func (mr *MockDatabaseMockRecorder) CountScoresAhead(ctx, leaderboard, order interface{}, scores ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, order}, scores...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountScoresAhead", reflect.TypeOf((*MockDatabase)(nil).CountScoresAhead), varargs...)
+}
+
+// GetIdempotentMembers mocks base method.
+func (m *MockDatabase) GetIdempotentMembers(ctx context.Context, leaderboard, idempotencyKey string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotentMembers", ctx, leaderboard, idempotencyKey)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotentMembers indicates an expected call of GetIdempotentMembers.
+func (mr *MockDatabaseMockRecorder) GetIdempotentMembers(ctx, leaderboard, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotentMembers", reflect.TypeOf((*MockDatabase)(nil).GetIdempotentMembers), ctx, leaderboard, idempotencyKey)
+}
+
 // GetLeaderboardExpiration mocks base method.
 func (m *MockDatabase) GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -232,3 +287,18 @@ func (mr *MockDatabaseMockRecorder) SetMembersTTL(ctx, leaderboard, databaseMemb
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMembersTTL", reflect.TypeOf((*MockDatabase)(nil).SetMembersTTL), ctx, leaderboard, databaseMembers)
 }
+
+// UpsertMembers mocks base method.
+func (m *MockDatabase) UpsertMembers(ctx context.Context, leaderboard string, databaseMembers []*Member, options *UpsertOptions) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMembers", ctx, leaderboard, databaseMembers, options)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertMembers indicates an expected call of UpsertMembers.
+func (mr *MockDatabaseMockRecorder) UpsertMembers(ctx, leaderboard, databaseMembers, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMembers", reflect.TypeOf((*MockDatabase)(nil).UpsertMembers), ctx, leaderboard, databaseMembers, options)
+}
@@ -0,0 +1,12 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Nonces interface standardize calls that remember the nonces of signed requests, so each one is accepted once
+type Nonces interface {
+	ClaimNonce(ctx context.Context, tenant, nonce string, ttl time.Duration) (bool, error)
+	ReleaseNonce(ctx context.Context, tenant, nonce string) error
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/nonces.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNonces is a mock of Nonces interface.
+type MockNonces struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoncesMockRecorder
+}
+
+// MockNoncesMockRecorder is the mock recorder for MockNonces.
+type MockNoncesMockRecorder struct {
+	mock *MockNonces
+}
+
+// NewMockNonces creates a new mock instance.
+func NewMockNonces(ctrl *gomock.Controller) *MockNonces {
+	mock := &MockNonces{ctrl: ctrl}
+	mock.recorder = &MockNoncesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonces) EXPECT() *MockNoncesMockRecorder {
+	return m.recorder
+}
+
+// ClaimNonce mocks base method.
+func (m *MockNonces) ClaimNonce(ctx context.Context, tenant, nonce string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNonce", ctx, tenant, nonce, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNonce indicates an expected call of ClaimNonce.
+func (mr *MockNoncesMockRecorder) ClaimNonce(ctx, tenant, nonce, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNonce", reflect.TypeOf((*MockNonces)(nil).ClaimNonce), ctx, tenant, nonce, ttl)
+}
+
+// ReleaseNonce mocks base method.
+func (m *MockNonces) ReleaseNonce(ctx context.Context, tenant, nonce string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseNonce", ctx, tenant, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseNonce indicates an expected call of ReleaseNonce.
+func (mr *MockNoncesMockRecorder) ReleaseNonce(ctx, tenant, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseNonce", reflect.TypeOf((*MockNonces)(nil).ReleaseNonce), ctx, tenant, nonce)
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Ratings interface standardize calls that keep the rating of rating leaderboard members alongside their score
+type Ratings interface {
+	GetRatings(ctx context.Context, leaderboard string, members ...string) (map[string]*Rating, error)
+	WriteRatings(ctx context.Context, leaderboard string, updates []*RatingUpdate, options *RatingOptions) ([]*RatedMember, error)
+}
+
+// Rating is the skill estimate of a member, Deviation and Volatility are only kept by Glicko-2 and
+// Matches count how many matches were rated, it's also used to detect concurrent writes
+type Rating struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+	Matches    int     `json:"matches"`
+}
+
+// RatingUpdate is the new rating of a member and the score written to the leaderboard for it, Matches of
+// Rating must follow the ones of the rating it was computed from
+type RatingUpdate struct {
+	Member string
+	Rating *Rating
+	Score  float64
+}
+
+// RatedMember is a member written by WriteRatings with its rating before and after the write, PreviousRating
+// is nil if member was never rated
+type RatedMember struct {
+	Member         *Member
+	PreviousRating *Rating
+	Rating         *Rating
+}
+
+// RatingOptions are the options to write ratings, ExpireAt, SeasonEnd and idempotency fields work like in
+// UpsertOptions
+type RatingOptions struct {
+	Order             string
+	ExpireAt          time.Time
+	SeasonEnd         time.Time
+	IdempotencyKey    string
+	IdempotencyWindow time.Duration
+}
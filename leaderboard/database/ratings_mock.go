@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/ratings.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRatings is a mock of Ratings interface.
+type MockRatings struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingsMockRecorder
+}
+
+// MockRatingsMockRecorder is the mock recorder for MockRatings.
+type MockRatingsMockRecorder struct {
+	mock *MockRatings
+}
+
+// NewMockRatings creates a new mock instance.
+func NewMockRatings(ctrl *gomock.Controller) *MockRatings {
+	mock := &MockRatings{ctrl: ctrl}
+	mock.recorder = &MockRatingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatings) EXPECT() *MockRatingsMockRecorder {
+	return m.recorder
+}
+
+// GetRatings mocks base method.
+func (m *MockRatings) GetRatings(ctx context.Context, leaderboard string, members ...string) (map[string]*Rating, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRatings", varargs...)
+	ret0, _ := ret[0].(map[string]*Rating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatings indicates an expected call of GetRatings.
+func (mr *MockRatingsMockRecorder) GetRatings(ctx, leaderboard interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatings", reflect.TypeOf((*MockRatings)(nil).GetRatings), varargs...)
+}
+
+// WriteRatings mocks base method.
+func (m *MockRatings) WriteRatings(ctx context.Context, leaderboard string, updates []*RatingUpdate, options *RatingOptions) ([]*RatedMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteRatings", ctx, leaderboard, updates, options)
+	ret0, _ := ret[0].([]*RatedMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteRatings indicates an expected call of WriteRatings.
+func (mr *MockRatingsMockRecorder) WriteRatings(ctx, leaderboard, updates, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRatings", reflect.TypeOf((*MockRatings)(nil).WriteRatings), ctx, leaderboard, updates, options)
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
@@ -11,11 +12,45 @@ import (
 // Redis is a type that implements Database interface with redis client
 type Redis struct {
 	redis.Client
+	Keys Keys
+	// Cluster report whether Client is a redis cluster, scripts then only take keys of the leaderboard they
+	// write since global keys, like ExpirationSet, are in other slots
+	Cluster bool
 }
 
 // ExpirationSet is used to list expirations set that worker will use to remove members
 const ExpirationSet string = "expiration-sets"
 
+// LeaderboardDefinitions is the hash that store every registered leaderboard definition by leaderboard id
+const LeaderboardDefinitions string = "leaderboard-definitions"
+
+// LeaderboardArchives is the hash that store archived leaderboards metadata by leaderboard id
+const LeaderboardArchives string = "leaderboard-archives"
+
+// SeasonRollovers is the sorted set of leaderboards to archive when their season ends, scored by season end
+const SeasonRollovers string = "season-rollovers"
+
+// RewardDeliveries is the sorted set of leaderboards whose reward brackets must be delivered, scored by next attempt
+const RewardDeliveries string = "reward-deliveries"
+
+// RewardDeliveryAttempts is the hash that count failed reward deliveries by leaderboard id
+const RewardDeliveryAttempts string = "reward-delivery-attempts"
+
+// LeagueSet is the set of leagues whose members were assigned to buckets
+const LeagueSet string = "leagues"
+
+// TieredLeagueSet is the set of tiered leagues whose members have a tier history
+const TieredLeagueSet string = "tiered-leagues"
+
+// TierMovementJobs is the sorted set of archived leaderboards whose tier movements must be recorded, scored by next attempt
+const TierMovementJobs string = "tier-movements"
+
+// TierMovementAttempts is the hash that count failed tier movement jobs by leaderboard id
+const TierMovementAttempts string = "tier-movement-attempts"
+
+// SubmissionNonces prefix the keys that remember the nonces of signed score submissions until they expire
+const SubmissionNonces string = "submission-nonces"
+
 // RedisOptions is a struct to create a new redis client
 type RedisOptions struct {
 	ClusterEnabled bool
@@ -24,28 +59,104 @@ type RedisOptions struct {
 	Port           int
 	Password       string
 	DB             int
+	KeySchema      KeySchemaVersion
+	KeyPrefix      string
+}
+
+// Validate return an error if options can't be used to create a database, scripts that write a leaderboard take
+// several of its keys, which are only kept in the same cluster slot by KeySchemaV2
+func (options RedisOptions) Validate() error {
+	if options.ClusterEnabled && options.KeySchema != KeySchemaV2 {
+		return fmt.Errorf(
+			"redis cluster requires key schema %d, keys of a leaderboard can be in different slots with key schema %d",
+			KeySchemaV2, options.KeySchema)
+	}
+	return nil
 }
 
 // NewRedisDatabase create a database based on redis
 func NewRedisDatabase(options RedisOptions) *Redis {
+	keys := Keys{
+		Version: options.KeySchema,
+		Prefix:  options.KeyPrefix,
+	}
+
 	if options.ClusterEnabled {
-		return &Redis{redis.NewClusterClient(redis.ClusterOptions{
-			Addrs:    options.Addrs,
+		return &Redis{
+			Client: redis.NewClusterClient(redis.ClusterOptions{
+				Addrs:    options.Addrs,
+				Password: options.Password,
+			}),
+			Keys:    keys,
+			Cluster: true,
+		}
+	}
+
+	return &Redis{
+		Client: redis.NewStandaloneClient(redis.StandaloneOptions{
+			Host:     options.Host,
+			Port:     options.Port,
 			Password: options.Password,
-		})}
+			DB:       options.DB,
+		}),
+		Keys: keys,
 	}
+}
 
-	return &Redis{redis.NewStandaloneClient(redis.StandaloneOptions{
-		Host:     options.Host,
-		Port:     options.Port,
-		Password: options.Password,
-		DB:       options.DB,
-	})}
+// scoreIndexKeys return leaderboard key and its distinct scores key, the keys scripts that keep
+// the distinct scores index need
+func (r *Redis) scoreIndexKeys(leaderboard string) []string {
+	return []string{r.Keys.Leaderboard(leaderboard), r.Keys.LeaderboardScores(leaderboard)}
+}
+
+func (r *Redis) countAhead(ctx context.Context, leaderboard, order, counted string, scores []float64) ([]int, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	args := make([]interface{}, 0, 2+len(scores))
+	args = append(args, order, counted)
+	for _, score := range scores {
+		args = append(args, score)
+	}
+
+	result, err := r.Client.RunScript(ctx, countAheadScript, r.scoreIndexKeys(leaderboard), args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if result == int64(-1) {
+		return nil, NewGeneralError(fmt.Sprintf("distinct scores of leaderboard %s aren't indexed, run migrate-keys to index them", leaderboard))
+	}
+
+	entries, ok := result.([]interface{})
+	if !ok || len(entries) != len(scores) {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected count result %v", result))
+	}
+
+	counts := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		count, _ := entry.(int64)
+		counts = append(counts, int(count))
+	}
+
+	return counts, nil
+}
+
+// CountMembersAhead return how many members have a better score than each score in parameter
+func (r *Redis) CountMembersAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	return r.countAhead(ctx, leaderboard, order, "members", scores)
+}
+
+// CountScoresAhead return how many distinct scores are better than each score in parameter,
+// leaderboards written by older podium versions must have their distinct scores indexed by IndexScores first
+func (r *Redis) CountScoresAhead(ctx context.Context, leaderboard, order string, scores ...float64) ([]int, error) {
+	return r.countAhead(ctx, leaderboard, order, "scores", scores)
 }
 
 // GetLeaderboardExpiration return leaderboard expiration time
 func (r *Redis) GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error) {
-	duration, err := r.Client.TTL(ctx, leaderboard)
+	duration, err := r.Client.TTL(ctx, r.Keys.Leaderboard(leaderboard))
 	if err != nil {
 		if _, ok := err.(*redis.TTLNotFoundError); ok {
 			return int64(-1), NewTTLNotFoundError(leaderboard)
@@ -58,73 +169,72 @@ func (r *Redis) GetLeaderboardExpiration(ctx context.Context, leaderboard string
 
 // GetMembers return members from leaderboard
 func (r *Redis) GetMembers(ctx context.Context, leaderboard, order string, includeTTL bool, members ...string) ([]*Member, error) {
+	ttlKey := ""
+	if includeTTL {
+		ttlKey = r.Keys.LeaderboardTTL(leaderboard)
+	}
+
+	return r.getMembers(ctx, r.Keys.Leaderboard(leaderboard), ttlKey, order, members)
+}
+
+// getMembers return members score and rank stored in key, and their ttl stored in ttlKey unless it is empty
+func (r *Redis) getMembers(ctx context.Context, key, ttlKey, order string, members []string) ([]*Member, error) {
 	if order != "asc" && order != "desc" {
 		return nil, NewInvalidOrderError(order)
 	}
 
-	membersToReturn := make([]*Member, 0, len(members))
+	scores, err := r.Client.ZScores(ctx, key, members...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
 
-	for _, member := range members {
-		score, err := r.Client.ZScore(ctx, leaderboard, member)
-		if err != nil {
-			if _, ok := err.(*redis.MemberNotFoundError); ok {
-				membersToReturn = append(membersToReturn, nil)
-				continue
-			}
+	var ranks []*int64
+	switch order {
+	case "asc":
+		ranks, err = r.Client.ZRanks(ctx, key, members...)
+	case "desc":
+		ranks, err = r.Client.ZRevRanks(ctx, key, members...)
+	}
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
 
+	includeTTL := ttlKey != ""
+	var ttls []*float64
+	if includeTTL {
+		ttls, err = r.Client.ZScores(ctx, ttlKey, members...)
+		if err != nil {
 			return nil, NewGeneralError(err.Error())
 		}
+	}
 
-		var rank int64
-		switch order {
-		case "asc":
-			rank, err = r.Client.ZRank(ctx, leaderboard, member)
-		case "desc":
-			rank, err = r.Client.ZRevRank(ctx, leaderboard, member)
-		}
-		if err != nil {
-			return nil, NewGeneralError(err.Error())
+	membersToReturn := make([]*Member, 0, len(members))
+	for i, member := range members {
+		// member could be removed between score and rank reads, in both cases it is not in leaderboard
+		if scores[i] == nil || ranks[i] == nil {
+			membersToReturn = append(membersToReturn, nil)
+			continue
 		}
 
 		var ttl time.Time
-		if includeTTL {
-			ttl, err = r.getMemberTTL(ctx, leaderboard, member)
-			if err != nil {
-				if _, ok := err.(*MemberNotFoundError); !ok {
-					return nil, NewGeneralError(err.Error())
-				}
-
-				ttl = time.Time{}
-			}
+		if includeTTL && ttls[i] != nil {
+			ttl = time.Unix(int64(*ttls[i]), 0)
 		}
 
 		membersToReturn = append(membersToReturn, &Member{
 			Member: member,
-			Score:  score,
-			Rank:   rank,
+			Score:  *scores[i],
+			Rank:   *ranks[i],
 			TTL:    ttl,
 		})
-
-	}
-	return membersToReturn, nil
-}
-
-func (r *Redis) getMemberTTL(ctx context.Context, leaderboard, member string) (time.Time, error) {
-	leaderboardTTL := fmt.Sprintf("%s:ttl", leaderboard)
-	ttl, err := r.Client.ZScore(ctx, leaderboardTTL, member)
-	if err != nil {
-		if _, ok := err.(*redis.MemberNotFoundError); ok {
-			return time.Time{}, NewMemberNotFoundError(leaderboardTTL, member)
-		}
-		return time.Time{}, NewGeneralError(err.Error())
 	}
 
-	return time.Unix(int64(ttl), 0), nil
+	return membersToReturn, nil
 }
 
 // GetMemberIDsWithScoreInsideRange find members with score close to
 func (r *Redis) GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboard string, min, max string, offset, count int) ([]string, error) {
-	members, err := r.Client.ZRevRangeByScore(ctx, leaderboard, min, max, int64(offset), int64(count))
+	members, err := r.Client.ZRevRangeByScore(ctx, r.Keys.Leaderboard(leaderboard), min, max, int64(offset), int64(count))
 	if err != nil {
 		return nil, NewGeneralError(err.Error())
 	}
@@ -134,14 +244,18 @@ func (r *Redis) GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboar
 
 // GetOrderedMembers call redis ZRange if order is asc, if desc call redis ZRevRange
 func (r *Redis) GetOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error) {
+	return r.getOrderedMembers(ctx, r.Keys.Leaderboard(leaderboard), start, stop, order)
+}
+
+func (r *Redis) getOrderedMembers(ctx context.Context, key string, start, stop int, order string) ([]*Member, error) {
 	var redisMembers []*redis.Member
 	var err error
 
 	switch order {
 	case "asc":
-		redisMembers, err = r.Client.ZRange(ctx, leaderboard, int64(start), int64(stop))
+		redisMembers, err = r.Client.ZRange(ctx, key, int64(start), int64(stop))
 	case "desc":
-		redisMembers, err = r.Client.ZRevRange(ctx, leaderboard, int64(start), int64(stop))
+		redisMembers, err = r.Client.ZRevRange(ctx, key, int64(start), int64(stop))
 	default:
 		return nil, NewInvalidOrderError(order)
 	}
@@ -169,9 +283,9 @@ func (r *Redis) GetRank(ctx context.Context, leaderboard, member, order string)
 
 	switch order {
 	case "asc":
-		rank, err = r.Client.ZRank(ctx, leaderboard, member)
+		rank, err = r.Client.ZRank(ctx, r.Keys.Leaderboard(leaderboard), member)
 	case "desc":
-		rank, err = r.Client.ZRevRank(ctx, leaderboard, member)
+		rank, err = r.Client.ZRevRank(ctx, r.Keys.Leaderboard(leaderboard), member)
 	default:
 		return -1, NewInvalidOrderError(order)
 	}
@@ -189,7 +303,7 @@ func (r *Redis) GetRank(ctx context.Context, leaderboard, member, order string)
 
 // GetTotalMembers return total members in a leaderboard
 func (r *Redis) GetTotalMembers(ctx context.Context, leaderboard string) (int, error) {
-	totalMembers, err := r.Client.ZCard(ctx, leaderboard)
+	totalMembers, err := r.Client.ZCard(ctx, r.Keys.Leaderboard(leaderboard))
 	if err != nil {
 		if _, ok := err.(*redis.KeyNotFoundError); ok {
 			return 0, nil
@@ -211,16 +325,47 @@ func (r *Redis) Healthcheck(ctx context.Context) error {
 
 // IncrementMemberScore add to member score the value in parameter
 func (r *Redis) IncrementMemberScore(ctx context.Context, leaderboard, member string, increment float64) error {
-	err := r.ZIncrBy(ctx, leaderboard, member, increment)
+	_, err := r.Client.RunScript(ctx, writeMembersScript, r.scoreIndexKeys(leaderboard), "ZINCRBY", increment, member)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
 	return nil
 }
 
-// RemoveLeaderboard delete leaderboard key from redis
+// RemoveLeaderboard delete leaderboard keys from redis
 func (r *Redis) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
-	err := r.Client.Del(ctx, leaderboard)
+	keys := append(r.scoreIndexKeys(leaderboard),
+		r.Keys.LeaderboardRatings(leaderboard),
+		r.Keys.LeaderboardSubmissions(leaderboard),
+		r.Keys.LeaderboardQuarantine(leaderboard),
+	)
+	for _, key := range keys {
+		err := r.Client.Del(ctx, key)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	return r.removeIdempotentWrites(ctx, leaderboard)
+}
+
+// removeIdempotentWrites delete the results kept for leaderboard writes made with an idempotency key and then
+// their index, so a failed removal can be retried
+func (r *Redis) removeIdempotentWrites(ctx context.Context, leaderboard string) error {
+	index := r.Keys.LeaderboardIdempotencyKeys(leaderboard)
+	kept, err := r.Client.ZRange(ctx, index, 0, -1)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	for _, key := range kept {
+		err = r.Client.Del(ctx, key.Member)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.Client.Del(ctx, index)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
@@ -229,7 +374,18 @@ func (r *Redis) RemoveLeaderboard(ctx context.Context, leaderboard string) error
 
 // RemoveMembers delete from redis members
 func (r *Redis) RemoveMembers(ctx context.Context, leaderboard string, members ...string) error {
-	err := r.Client.ZRem(ctx, leaderboard, members...)
+	return r.removeMembers(ctx, leaderboard, members)
+}
+
+func (r *Redis) removeMembers(ctx context.Context, leaderboard string, members []string) error {
+	args := make([]interface{}, 0, 1+len(members))
+	args = append(args, "ZREM")
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	keys := append(r.scoreIndexKeys(leaderboard), r.Keys.LeaderboardRatings(leaderboard))
+	_, err := r.Client.RunScript(ctx, writeMembersScript, keys, args...)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
@@ -238,23 +394,23 @@ func (r *Redis) RemoveMembers(ctx context.Context, leaderboard string, members .
 
 // SetLeaderboardExpiration will set leaderboard expiration time
 func (r *Redis) SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error {
-	err := r.Client.ExpireAt(ctx, leaderboard, expireAt)
-	if err != nil {
-		return NewGeneralError(err.Error())
+	for _, key := range r.scoreIndexKeys(leaderboard) {
+		err := r.Client.ExpireAt(ctx, key, expireAt)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
 	}
 	return nil
 }
 
 // SetMembers will set member score and ttl
 func (r *Redis) SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
-	redisMembers := make([]*redis.Member, 0, len(databaseMembers))
+	args := make([]interface{}, 0, 1+2*len(databaseMembers))
+	args = append(args, "ZADD")
 	for _, member := range databaseMembers {
-		redisMembers = append(redisMembers, &redis.Member{
-			Member: member.Member,
-			Score:  member.Score,
-		})
+		args = append(args, member.Score, member.Member)
 	}
-	err := r.Client.ZAdd(ctx, leaderboard, redisMembers...)
+	_, err := r.Client.RunScript(ctx, writeMembersScript, r.scoreIndexKeys(leaderboard), args...)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
@@ -263,8 +419,8 @@ func (r *Redis) SetMembers(ctx context.Context, leaderboard string, databaseMemb
 
 // SetMembersTTL set member ttl in an OrderedSet and add this to expiration_worker set
 //		The TTL is a different ordered set than the original leaderboard, with key being
-//		leaderboard name and suffix ":ttl", for example to a leaderboard named test your
-//		orederedset with time to expire will be "test:ttl"
+//		leaderboard key and suffix ":ttl", for example to a leaderboard named test your
+//		orederedset with time to expire will be "test:ttl" or "{test}:ttl", see Keys
 //
 //		Note: the worker expiration set is expiration_set
 func (r *Redis) SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
@@ -276,16 +432,324 @@ func (r *Redis) SetMembersTTL(ctx context.Context, leaderboard string, databaseM
 		})
 	}
 
-	expirationKey := fmt.Sprintf("%s:ttl", leaderboard)
+	expirationKey := r.Keys.LeaderboardTTL(leaderboard)
 	err := r.Client.ZAdd(ctx, expirationKey, redisMembers...)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
 
-	err = r.Client.SAdd(ctx, ExpirationSet, expirationKey)
+	err = r.Client.SAdd(ctx, r.Keys.ExpirationSet(), expirationKey)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
 
 	return nil
 }
+
+// UpsertMembers write members score following update policy, apply members TTL and leaderboard expiration
+// and report members score and rank, all in a single atomic script call
+func (r *Redis) UpsertMembers(ctx context.Context, leaderboard string, databaseMembers []*Member, options *UpsertOptions) ([]*Member, error) {
+	if options.Order != "asc" && options.Order != "desc" {
+		return nil, NewInvalidOrderError(options.Order)
+	}
+
+	if !IsValidUpdatePolicy(options.UpdatePolicy) {
+		return nil, NewInvalidUpdatePolicyError(options.UpdatePolicy)
+	}
+
+	updatePolicy := options.UpdatePolicy
+	if updatePolicy == "" {
+		updatePolicy = UpdatePolicyLast
+	}
+
+	expirationKey := r.Keys.LeaderboardTTL(leaderboard)
+	keys := append(r.scoreIndexKeys(leaderboard),
+		expirationKey,
+		r.Keys.LeaderboardIdempotency(leaderboard, options.IdempotencyKey),
+		r.Keys.LeaderboardIdempotencyKeys(leaderboard),
+		r.Keys.LeaderboardSubmissions(leaderboard),
+		r.Keys.LeaderboardQuarantine(leaderboard),
+	)
+	if !r.Cluster {
+		keys = append(keys, r.Keys.ExpirationSet(), r.Keys.SeasonRollovers())
+	}
+
+	var idempotencyWindow int64
+	if options.IdempotencyKey != "" {
+		idempotencyWindow = options.IdempotencyWindow.Milliseconds()
+	}
+
+	scoreScale := options.ScoreScale
+	if scoreScale < 1 {
+		scoreScale = 1
+	}
+
+	minSum, maxSum := "", ""
+	if options.SumRange != nil {
+		minSum = strconv.FormatFloat(options.SumRange.Min, 'f', -1, 64)
+		maxSum = strconv.FormatFloat(options.SumRange.Max, 'f', -1, 64)
+	}
+
+	args := make([]interface{}, 0, 22+3*len(databaseMembers))
+	args = append(args,
+		options.Order,
+		updatePolicy,
+		formatBoolArg(options.PreviousRank),
+		formatTimeArg(options.ExpireAt),
+		formatTimeArg(options.TTL),
+		scoreScale,
+		idempotencyWindow,
+		minSum,
+		maxSum,
+		formatTimeArg(options.SeasonEnd),
+		leaderboard,
+	)
+	args = append(args, formatScoreRulesArgs(options.ScoreRules)...)
+	for _, member := range databaseMembers {
+		args = append(args, member.Member, member.Score, formatPreconditionArg(options.Preconditions[member.Member]))
+	}
+
+	result, err := r.Client.RunScript(ctx, upsertMembersScript, keys, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if failed, ok := result.([]interface{}); ok && len(failed) == 3 {
+		if status, ok := failed[0].(int64); ok && status == 0 {
+			return nil, parsePreconditionFailed(leaderboard, failed)
+		}
+		if status, ok := failed[0].(int64); ok && status == 2 {
+			return nil, parseSumOutOfRange(leaderboard, failed)
+		}
+	}
+	if violation, ok := result.([]interface{}); ok && len(violation) == 5 {
+		if status, ok := violation[0].(int64); ok && status == 3 {
+			return nil, parseScoreRuleViolation(leaderboard, violation)
+		}
+	}
+
+	members, err := parseUpsertedMembers(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Cluster {
+		err = r.registerUpsert(ctx, leaderboard, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return members, nil
+}
+
+// registerUpsert list leaderboard in the expiration set and season rollovers like upsertMembersScript does,
+// for clusters whose scripts can't write those keys
+func (r *Redis) registerUpsert(ctx context.Context, leaderboard string, options *UpsertOptions) error {
+	if !options.TTL.IsZero() {
+		err := r.Client.SAdd(ctx, r.Keys.ExpirationSet(), r.Keys.LeaderboardTTL(leaderboard))
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	if !options.SeasonEnd.IsZero() {
+		err := r.Client.ZAdd(ctx, r.Keys.SeasonRollovers(), &redis.Member{
+			Member: leaderboard,
+			Score:  float64(options.SeasonEnd.Unix()),
+		})
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetIdempotentMembers return the members reported by the UpsertMembers call made with idempotencyKey on
+// leaderboard, nil if it isn't kept
+func (r *Redis) GetIdempotentMembers(ctx context.Context, leaderboard, idempotencyKey string) ([]*Member, error) {
+	keys := []string{r.Keys.LeaderboardIdempotency(leaderboard, idempotencyKey)}
+	result, err := r.Client.RunScript(ctx, replayWriteScript, keys)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if kept, ok := result.(int64); ok && kept == 0 {
+		return nil, nil
+	}
+	return parseUpsertedMembers(result)
+}
+
+func parseUpsertedMembers(result interface{}) ([]*Member, error) {
+	entries, ok := result.([]interface{})
+	if !ok {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected upsert result %v", result))
+	}
+
+	members := make([]*Member, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.([]interface{})
+		if !ok || len(fields) < 5 || len(fields) > 7 {
+			return nil, NewGeneralError(fmt.Sprintf("unexpected upserted member %v", entry))
+		}
+
+		member, err := parseUpsertedMember(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) >= 6 {
+			member.TTL, err = parseTimeField(fields[5])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(fields) == 7 {
+			member.QuarantineRule, _ = fields[6].(string)
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// parseUpsertedMember read a {member, score, rank, previousRank, scoreChanged} entry returned by a write script
+func parseUpsertedMember(fields []interface{}) (*Member, error) {
+	member, _ := fields[0].(string)
+	rawScore, _ := fields[1].(string)
+	rank, _ := fields[2].(int64)
+	previousRank, _ := fields[3].(int64)
+	scoreChanged, _ := fields[4].(int64)
+
+	score, err := strconv.ParseFloat(rawScore, 64)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return &Member{
+		Member:       member,
+		Score:        score,
+		Rank:         rank,
+		PreviousRank: previousRank,
+		ScoreChanged: scoreChanged == 1,
+	}, nil
+}
+
+func parsePreconditionFailed(leaderboard string, failed []interface{}) error {
+	member, _ := failed[1].(string)
+	rawScore, ok := failed[2].(string)
+	if !ok {
+		return NewPreconditionFailedError(leaderboard, member, nil)
+	}
+
+	currentScore, err := strconv.ParseFloat(rawScore, 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return NewPreconditionFailedError(leaderboard, member, &currentScore)
+}
+
+func parseSumOutOfRange(leaderboard string, failed []interface{}) error {
+	member, _ := failed[1].(string)
+	rawSum, _ := failed[2].(string)
+
+	sum, err := strconv.ParseFloat(rawSum, 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return NewSumOutOfRangeError(leaderboard, member, sum)
+}
+
+func parseScoreRuleViolation(leaderboard string, violation []interface{}) error {
+	member, _ := violation[1].(string)
+	rule, _ := violation[2].(string)
+	rawValue, _ := violation[3].(string)
+	rawLimit, _ := violation[4].(string)
+
+	value, err := strconv.ParseFloat(rawValue, 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	limit, err := strconv.ParseFloat(rawLimit, 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return NewScoreRuleViolationError(leaderboard, member, rule, value, limit)
+}
+
+func formatPreconditionArg(precondition *Precondition) string {
+	switch {
+	case precondition == nil:
+		return ""
+	case precondition.Absent:
+		return "absent"
+	case precondition.ExpectedScore != nil:
+		return strconv.FormatFloat(*precondition.ExpectedScore, 'f', -1, 64)
+	case precondition.Present:
+		return "present"
+	}
+	return ""
+}
+
+// formatScoreRulesArgs return the score rules arguments of upsertMembersScript, an empty unit skips them
+func formatScoreRulesArgs(rules *ScoreRules) []interface{} {
+	if rules == nil {
+		return []interface{}{"", "", "", "", "", "", "", "", "", "", ""}
+	}
+
+	unit := rules.ScoreUnit
+	if unit <= 0 {
+		unit = 1
+	}
+
+	minScore, maxScore := "", ""
+	if rules.MinScore != nil {
+		minScore = strconv.FormatFloat(*rules.MinScore, 'f', -1, 64)
+	}
+	if rules.MaxScore != nil {
+		maxScore = strconv.FormatFloat(*rules.MaxScore, 'f', -1, 64)
+	}
+
+	return []interface{}{
+		strconv.FormatFloat(unit, 'f', -1, 64),
+		minScore,
+		maxScore,
+		strconv.FormatFloat(rules.MaxIncrement, 'f', -1, 64),
+		strconv.FormatFloat(rules.MaxDelta, 'f', -1, 64),
+		rules.DeltaWindow.Milliseconds(),
+		rules.MaxSubmissions,
+		rules.SubmissionWindow.Milliseconds(),
+		formatBoolArg(rules.Quarantine),
+		rules.Now.UnixMilli(),
+		rules.Now.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func formatBoolArg(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+func formatTimeArg(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(value.Unix(), 10)
+}
+
+// parseTimeField read a time written by formatTimeArg and returned by a script, zero time if it's empty
+func parseTimeField(field interface{}) (time.Time, error) {
+	value, _ := field.(string)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, NewGeneralError(err.Error())
+	}
+	return time.Unix(unix, 0), nil
+}
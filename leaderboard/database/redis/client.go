@@ -17,10 +17,20 @@ type Client interface {
 	Del(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) error
 	ExpireAt(ctx context.Context, key string, time time.Time) error
+	Get(ctx context.Context, key string) (string, error)
+	HDel(ctx context.Context, key, field string) (bool, error)
+	HGet(ctx context.Context, key, field string) (string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error)
+	HSet(ctx context.Context, key, field, value string) error
+	HSetNX(ctx context.Context, key, field, value string) (bool, error)
 	Ping(ctx context.Context) (string, error)
+	RunScript(ctx context.Context, script *Script, keys []string, args ...interface{}) (interface{}, error)
 	SAdd(ctx context.Context, key, member string) error
 	SMembers(ctx context.Context, key string) ([]string, error)
 	SRem(ctx context.Context, key string, members ...string) error
+	ScanType(ctx context.Context, match, keyType string) ([]string, error)
+	Set(ctx context.Context, key, value string, expiration time.Duration) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
 	ZAdd(ctx context.Context, key string, members ...*Member) error
 	ZCard(ctx context.Context, key string) (int64, error)
@@ -28,11 +38,14 @@ type Client interface {
 	ZRange(ctx context.Context, key string, start, stop int64) ([]*Member, error)
 	ZRangeByScore(ctx context.Context, key string, min, max string, offset, count int64) ([]string, error)
 	ZRank(ctx context.Context, key, member string) (int64, error)
+	ZRanks(ctx context.Context, key string, members ...string) ([]*int64, error)
 	ZRem(ctx context.Context, key string, members ...string) error
 	ZRevRange(ctx context.Context, key string, start, stop int64) ([]*Member, error)
 	ZRevRangeByScore(ctx context.Context, key string, min, max string, offset, count int64) ([]string, error)
 	ZRevRank(ctx context.Context, key, member string) (int64, error)
+	ZRevRanks(ctx context.Context, key string, members ...string) ([]*int64, error)
 	ZScore(ctx context.Context, key, member string) (float64, error)
+	ZScores(ctx context.Context, key string, members ...string) ([]*float64, error)
 }
 
 // Member is a struct to be used by sorted set range operations
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -66,6 +67,77 @@ func (cc *clusterClient) ExpireAt(ctx context.Context, key string, time time.Tim
 	return nil
 }
 
+// Get call redis GET function
+func (cc *clusterClient) Get(ctx context.Context, key string) (string, error) {
+	result, err := cc.ClusterClient.Get(ctx, key).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewKeyNotFoundError(key)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HDel call redis HDEL function and report if field was removed
+func (cc *clusterClient) HDel(ctx context.Context, key, field string) (bool, error) {
+	removed, err := cc.ClusterClient.HDel(ctx, key, field).Result()
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+	return removed == 1, nil
+}
+
+// HGet call redis HGET function
+func (cc *clusterClient) HGet(ctx context.Context, key, field string) (string, error) {
+	result, err := cc.ClusterClient.HGet(ctx, key, field).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewMemberNotFoundError(key, field)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HGetAll call redis HGETALL function
+func (cc *clusterClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	result, err := cc.ClusterClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HIncrBy call redis HINCRBY function and return the field value after the increment
+func (cc *clusterClient) HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error) {
+	value, err := cc.ClusterClient.HIncrBy(ctx, key, field, increment).Result()
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+	return value, nil
+}
+
+// HSet call redis HSET function
+func (cc *clusterClient) HSet(ctx context.Context, key, field, value string) error {
+	err := cc.ClusterClient.HSet(ctx, key, field, value).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
+// HSetNX call redis HSETNX function and report if field was set
+func (cc *clusterClient) HSetNX(ctx context.Context, key, field, value string) (bool, error) {
+	set, err := cc.ClusterClient.HSetNX(ctx, key, field, value).Result()
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+	return set, nil
+}
+
 // Ping call redis PING function
 func (cc *clusterClient) Ping(ctx context.Context) (string, error) {
 	result, err := cc.ClusterClient.Ping(ctx).Result()
@@ -75,6 +147,19 @@ func (cc *clusterClient) Ping(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// RunScript call redis EVALSHA function, falling back to EVAL when script isn't cached yet
+func (cc *clusterClient) RunScript(ctx context.Context, script *Script, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := script.Run(ctx, cc.ClusterClient, keys, args...).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return nil, nil
+		}
+
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
 // SAdd call redis SADD function
 func (cc *clusterClient) SAdd(ctx context.Context, key, member string) error {
 	err := cc.ClusterClient.SAdd(ctx, key, member).Err()
@@ -102,6 +187,42 @@ func (cc *clusterClient) SRem(ctx context.Context, key string, members ...string
 	return nil
 }
 
+// ScanType iterate redis SCAN function in every master node until all keys matching pattern and type are returned
+func (cc *clusterClient) ScanType(ctx context.Context, match, keyType string) ([]string, error) {
+	var mutex sync.Mutex
+	keys := []string{}
+
+	err := cc.ClusterClient.ForEachMaster(ctx, func(ctx context.Context, client *goredis.Client) error {
+		masterKeys, err := scanType(ctx, client, match, keyType)
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		keys = append(keys, masterKeys...)
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*GeneralError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return keys, nil
+}
+
+// Set call redis SET function, a zero expiration keeps value until it's removed
+func (cc *clusterClient) Set(ctx context.Context, key, value string, expiration time.Duration) error {
+	err := cc.ClusterClient.Set(ctx, key, value, expiration).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
 // TTL call redis TTL function
 func (cc *clusterClient) TTL(ctx context.Context, key string) (time.Duration, error) {
 	result, err := cc.ClusterClient.TTL(ctx, key).Result()
@@ -201,6 +322,11 @@ func (cc *clusterClient) ZRank(ctx context.Context, key, member string) (int64,
 	return result, nil
 }
 
+// ZRanks pipeline redis ZRANK function for every member, missing members are returned as nil
+func (cc *clusterClient) ZRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	return pipelinedRanks(ctx, cc.ClusterClient.Pipeline(), key, members, false)
+}
+
 // ZRem call redis ZREM function
 func (cc *clusterClient) ZRem(ctx context.Context, key string, members ...string) error {
 	err := cc.ClusterClient.ZRem(ctx, key, members).Err()
@@ -251,6 +377,11 @@ func (cc *clusterClient) ZRevRank(ctx context.Context, key, member string) (int6
 	return result, nil
 }
 
+// ZRevRanks pipeline redis ZREVRANK function for every member, missing members are returned as nil
+func (cc *clusterClient) ZRevRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	return pipelinedRanks(ctx, cc.ClusterClient.Pipeline(), key, members, true)
+}
+
 // ZScore call redis ZScore function
 func (cc *clusterClient) ZScore(ctx context.Context, key, member string) (float64, error) {
 	result, err := cc.ClusterClient.ZScore(ctx, key, member).Result()
@@ -264,3 +395,8 @@ func (cc *clusterClient) ZScore(ctx context.Context, key, member string) (float6
 
 	return result, nil
 }
+
+// ZScores pipeline redis ZSCORE function for every member, missing members are returned as nil
+func (cc *clusterClient) ZScores(ctx context.Context, key string, members ...string) ([]*float64, error) {
+	return pipelinedScores(ctx, cc.ClusterClient.Pipeline(), key, members)
+}
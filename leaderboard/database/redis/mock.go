@@ -77,6 +77,110 @@ func (mr *MockRedisMockRecorder) ExpireAt(ctx, key, time interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAt", reflect.TypeOf((*MockRedis)(nil).ExpireAt), ctx, key, time)
 }
 
+// Get mocks base method.
+func (m *MockRedis) Get(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRedisMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedis)(nil).Get), ctx, key)
+}
+
+// HDel mocks base method.
+func (m *MockRedis) HDel(ctx context.Context, key, field string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HDel", ctx, key, field)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HDel indicates an expected call of HDel.
+func (mr *MockRedisMockRecorder) HDel(ctx, key, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HDel", reflect.TypeOf((*MockRedis)(nil).HDel), ctx, key, field)
+}
+
+// HGet mocks base method.
+func (m *MockRedis) HGet(ctx context.Context, key, field string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGet", ctx, key, field)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HGet indicates an expected call of HGet.
+func (mr *MockRedisMockRecorder) HGet(ctx, key, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGet", reflect.TypeOf((*MockRedis)(nil).HGet), ctx, key, field)
+}
+
+// HGetAll mocks base method.
+func (m *MockRedis) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGetAll", ctx, key)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HGetAll indicates an expected call of HGetAll.
+func (mr *MockRedisMockRecorder) HGetAll(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGetAll", reflect.TypeOf((*MockRedis)(nil).HGetAll), ctx, key)
+}
+
+// HIncrBy mocks base method.
+func (m *MockRedis) HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HIncrBy", ctx, key, field, increment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HIncrBy indicates an expected call of HIncrBy.
+func (mr *MockRedisMockRecorder) HIncrBy(ctx, key, field, increment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HIncrBy", reflect.TypeOf((*MockRedis)(nil).HIncrBy), ctx, key, field, increment)
+}
+
+// HSet mocks base method.
+func (m *MockRedis) HSet(ctx context.Context, key, field, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HSet", ctx, key, field, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HSet indicates an expected call of HSet.
+func (mr *MockRedisMockRecorder) HSet(ctx, key, field, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockRedis)(nil).HSet), ctx, key, field, value)
+}
+
+// HSetNX mocks base method.
+func (m *MockRedis) HSetNX(ctx context.Context, key, field, value string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HSetNX", ctx, key, field, value)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HSetNX indicates an expected call of HSetNX.
+func (mr *MockRedisMockRecorder) HSetNX(ctx, key, field, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSetNX", reflect.TypeOf((*MockRedis)(nil).HSetNX), ctx, key, field, value)
+}
+
 // Ping mocks base method.
 func (m *MockRedis) Ping(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +196,26 @@ func (mr *MockRedisMockRecorder) Ping(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockRedis)(nil).Ping), ctx)
 }
 
+// RunScript mocks base method.
+func (m *MockRedis) RunScript(ctx context.Context, script *Script, keys []string, args ...interface{}) (interface{}, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, script, keys}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunScript", varargs...)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunScript indicates an expected call of RunScript.
+func (mr *MockRedisMockRecorder) RunScript(ctx, script, keys interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, script, keys}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScript", reflect.TypeOf((*MockRedis)(nil).RunScript), varargs...)
+}
+
 // SAdd mocks base method.
 func (m *MockRedis) SAdd(ctx context.Context, key, member string) error {
 	m.ctrl.T.Helper()
@@ -140,6 +264,35 @@ func (mr *MockRedisMockRecorder) SRem(ctx, key interface{}, members ...interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockRedis)(nil).SRem), varargs...)
 }
 
+// ScanType mocks base method.
+func (m *MockRedis) ScanType(ctx context.Context, match, keyType string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanType", ctx, match, keyType)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanType indicates an expected call of ScanType.
+func (mr *MockRedisMockRecorder) ScanType(ctx, match, keyType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanType", reflect.TypeOf((*MockRedis)(nil).ScanType), ctx, match, keyType)
+}
+
+// Set mocks base method.
+func (m *MockRedis) Set(ctx context.Context, key, value string, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockRedisMockRecorder) Set(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedis)(nil).Set), ctx, key, value, expiration)
+}
+
 // TTL mocks base method.
 func (m *MockRedis) TTL(ctx context.Context, key string) (time.Duration, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +401,26 @@ func (mr *MockRedisMockRecorder) ZRank(ctx, key, member interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRank", reflect.TypeOf((*MockRedis)(nil).ZRank), ctx, key, member)
 }
 
+// ZRanks mocks base method.
+func (m *MockRedis) ZRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZRanks", varargs...)
+	ret0, _ := ret[0].([]*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZRanks indicates an expected call of ZRanks.
+func (mr *MockRedisMockRecorder) ZRanks(ctx, key interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRanks", reflect.TypeOf((*MockRedis)(nil).ZRanks), varargs...)
+}
+
 // ZRem mocks base method.
 func (m *MockRedis) ZRem(ctx context.Context, key string, members ...string) error {
 	m.ctrl.T.Helper()
@@ -312,6 +485,26 @@ func (mr *MockRedisMockRecorder) ZRevRank(ctx, key, member interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockRedis)(nil).ZRevRank), ctx, key, member)
 }
 
+// ZRevRanks mocks base method.
+func (m *MockRedis) ZRevRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZRevRanks", varargs...)
+	ret0, _ := ret[0].([]*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZRevRanks indicates an expected call of ZRevRanks.
+func (mr *MockRedisMockRecorder) ZRevRanks(ctx, key interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRanks", reflect.TypeOf((*MockRedis)(nil).ZRevRanks), varargs...)
+}
+
 // ZScore mocks base method.
 func (m *MockRedis) ZScore(ctx context.Context, key, member string) (float64, error) {
 	m.ctrl.T.Helper()
@@ -326,3 +519,23 @@ func (mr *MockRedisMockRecorder) ZScore(ctx, key, member interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScore", reflect.TypeOf((*MockRedis)(nil).ZScore), ctx, key, member)
 }
+
+// ZScores mocks base method.
+func (m *MockRedis) ZScores(ctx context.Context, key string, members ...string) ([]*float64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZScores", varargs...)
+	ret0, _ := ret[0].([]*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZScores indicates an expected call of ZScores.
+func (mr *MockRedisMockRecorder) ZScores(ctx, key interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScores", reflect.TypeOf((*MockRedis)(nil).ZScores), varargs...)
+}
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// pipelinedScores send one ZSCORE per member in a single round trip, missing members are nil
+func pipelinedScores(ctx context.Context, pipeliner goredis.Pipeliner, key string, members []string) ([]*float64, error) {
+	cmds := make([]*goredis.FloatCmd, 0, len(members))
+	for _, member := range members {
+		cmds = append(cmds, pipeliner.ZScore(ctx, key, member))
+	}
+
+	if err := execPipeline(ctx, pipeliner, len(cmds)); err != nil {
+		return nil, err
+	}
+
+	scores := make([]*float64, 0, len(cmds))
+	for _, cmd := range cmds {
+		score, err := cmd.Result()
+		if err != nil {
+			if err.Error() == "redis: nil" {
+				scores = append(scores, nil)
+				continue
+			}
+
+			return nil, NewGeneralError(err.Error())
+		}
+
+		scores = append(scores, &score)
+	}
+
+	return scores, nil
+}
+
+// pipelinedRanks send one ZRANK or ZREVRANK per member in a single round trip, missing members are nil
+func pipelinedRanks(ctx context.Context, pipeliner goredis.Pipeliner, key string, members []string, reverse bool) ([]*int64, error) {
+	cmds := make([]*goredis.IntCmd, 0, len(members))
+	for _, member := range members {
+		if reverse {
+			cmds = append(cmds, pipeliner.ZRevRank(ctx, key, member))
+		} else {
+			cmds = append(cmds, pipeliner.ZRank(ctx, key, member))
+		}
+	}
+
+	if err := execPipeline(ctx, pipeliner, len(cmds)); err != nil {
+		return nil, err
+	}
+
+	ranks := make([]*int64, 0, len(cmds))
+	for _, cmd := range cmds {
+		rank, err := cmd.Result()
+		if err != nil {
+			if err.Error() == "redis: nil" {
+				ranks = append(ranks, nil)
+				continue
+			}
+
+			return nil, NewGeneralError(err.Error())
+		}
+
+		ranks = append(ranks, &rank)
+	}
+
+	return ranks, nil
+}
+
+// execPipeline run queued commands, nil replies are left to be handled per command
+func execPipeline(ctx context.Context, pipeliner goredis.Pipeliner, queued int) error {
+	if queued == 0 {
+		return nil
+	}
+
+	_, err := pipeliner.Exec(ctx)
+	if err != nil && err.Error() != "redis: nil" {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"context"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// scanCount is how many keys redis is hinted to check on each SCAN call
+const scanCount int64 = 1000
+
+// scanType iterate SCAN cursor of a single node
+func scanType(ctx context.Context, client *goredis.Client, match, keyType string) ([]string, error) {
+	keys := []string{}
+
+	var cursor uint64
+	for {
+		page, nextCursor, err := client.ScanType(ctx, cursor, match, scanCount, keyType).Result()
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		keys = append(keys, page...)
+		if nextCursor == 0 {
+			return keys, nil
+		}
+		cursor = nextCursor
+	}
+}
@@ -0,0 +1,13 @@
+package redis
+
+import goredis "github.com/go-redis/redis/v8"
+
+// Script is a lua script executed atomically by redis
+type Script struct {
+	*goredis.Script
+}
+
+// NewScript create a new Script from its lua source
+func NewScript(src string) *Script {
+	return &Script{goredis.NewScript(src)}
+}
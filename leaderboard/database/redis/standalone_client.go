@@ -69,6 +69,77 @@ func (c *standaloneClient) ExpireAt(ctx context.Context, key string, time time.T
 	return nil
 }
 
+// Get call redis GET function
+func (c *standaloneClient) Get(ctx context.Context, key string) (string, error) {
+	result, err := c.Client.Get(ctx, key).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewKeyNotFoundError(key)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HDel call redis HDEL function and report if field was removed
+func (c *standaloneClient) HDel(ctx context.Context, key, field string) (bool, error) {
+	removed, err := c.Client.HDel(ctx, key, field).Result()
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+	return removed == 1, nil
+}
+
+// HGet call redis HGET function
+func (c *standaloneClient) HGet(ctx context.Context, key, field string) (string, error) {
+	result, err := c.Client.HGet(ctx, key, field).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewMemberNotFoundError(key, field)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HGetAll call redis HGETALL function
+func (c *standaloneClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	result, err := c.Client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HIncrBy call redis HINCRBY function and return the field value after the increment
+func (c *standaloneClient) HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error) {
+	value, err := c.Client.HIncrBy(ctx, key, field, increment).Result()
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+	return value, nil
+}
+
+// HSet call redis HSET function
+func (c *standaloneClient) HSet(ctx context.Context, key, field, value string) error {
+	err := c.Client.HSet(ctx, key, field, value).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
+// HSetNX call redis HSETNX function and report if field was set
+func (c *standaloneClient) HSetNX(ctx context.Context, key, field, value string) (bool, error) {
+	set, err := c.Client.HSetNX(ctx, key, field, value).Result()
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+	return set, nil
+}
+
 // Ping call redis PING function
 func (c *standaloneClient) Ping(ctx context.Context) (string, error) {
 	result, err := c.Client.Ping(ctx).Result()
@@ -78,6 +149,19 @@ func (c *standaloneClient) Ping(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// RunScript call redis EVALSHA function, falling back to EVAL when script isn't cached yet
+func (c *standaloneClient) RunScript(ctx context.Context, script *Script, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := script.Run(ctx, c.Client, keys, args...).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return nil, nil
+		}
+
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
 // SAdd call redis SADD function
 func (c *standaloneClient) SAdd(ctx context.Context, key, member string) error {
 	err := c.Client.SAdd(ctx, key, member).Err()
@@ -105,6 +189,21 @@ func (c *standaloneClient) SRem(ctx context.Context, key string, members ...stri
 	return nil
 }
 
+// ScanType iterate redis SCAN function until all keys matching pattern and type are returned
+func (c *standaloneClient) ScanType(ctx context.Context, match, keyType string) ([]string, error) {
+	return scanType(ctx, c.Client, match, keyType)
+}
+
+// Set call redis SET function, a zero expiration keeps value until it's removed
+func (c *standaloneClient) Set(ctx context.Context, key, value string, expiration time.Duration) error {
+	err := c.Client.Set(ctx, key, value, expiration).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
 // TTL call redis TTL function
 func (c *standaloneClient) TTL(ctx context.Context, key string) (time.Duration, error) {
 	result, err := c.Client.TTL(ctx, key).Result()
@@ -203,6 +302,11 @@ func (c *standaloneClient) ZRank(ctx context.Context, key, member string) (int64
 	return result, nil
 }
 
+// ZRanks pipeline redis ZRANK function for every member, missing members are returned as nil
+func (c *standaloneClient) ZRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	return pipelinedRanks(ctx, c.Client.Pipeline(), key, members, false)
+}
+
 // ZRem call redis ZREM function
 func (c *standaloneClient) ZRem(ctx context.Context, key string, members ...string) error {
 	err := c.Client.ZRem(ctx, key, members).Err()
@@ -253,6 +357,11 @@ func (c *standaloneClient) ZRevRank(ctx context.Context, key, member string) (in
 	return result, nil
 }
 
+// ZRevRanks pipeline redis ZREVRANK function for every member, missing members are returned as nil
+func (c *standaloneClient) ZRevRanks(ctx context.Context, key string, members ...string) ([]*int64, error) {
+	return pipelinedRanks(ctx, c.Client.Pipeline(), key, members, true)
+}
+
 // ZScore call redis ZScore function
 func (c *standaloneClient) ZScore(ctx context.Context, key, member string) (float64, error) {
 	result, err := c.Client.ZScore(ctx, key, member).Result()
@@ -266,3 +375,8 @@ func (c *standaloneClient) ZScore(ctx context.Context, key, member string) (floa
 
 	return result, nil
 }
+
+// ZScores pipeline redis ZSCORE function for every member, missing members are returned as nil
+func (c *standaloneClient) ZScores(ctx context.Context, key string, members ...string) ([]*float64, error) {
+	return pipelinedScores(ctx, c.Client.Pipeline(), key, members)
+}
@@ -105,6 +105,118 @@ var _ = Describe("Standalone Client", func() {
 		})
 	})
 
+	Describe("HDel", func() {
+		It("Should return true if field is removed", func() {
+			err := goRedis.HSet(context.Background(), testKey, member, "value").Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			removed, err := standaloneClient.HDel(context.Background(), testKey, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeTrue())
+
+			exists, err := goRedis.HExists(context.Background(), testKey, member).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("Should return false if field doesn't exists", func() {
+			removed, err := standaloneClient.HDel(context.Background(), testKey, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+		})
+	})
+
+	Describe("Get", func() {
+		It("Should return key value", func() {
+			err := goRedis.Set(context.Background(), testKey, "value", 0).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			value, err := standaloneClient.Get(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value"))
+		})
+
+		It("Should return KeyNotFoundError if key doesn't exists", func() {
+			_, err := standaloneClient.Get(context.Background(), testKey)
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(testKey)))
+		})
+	})
+
+	Describe("HGet", func() {
+		It("Should return field value", func() {
+			err := goRedis.HSet(context.Background(), testKey, member, "value").Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			value, err := standaloneClient.HGet(context.Background(), testKey, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value"))
+		})
+
+		It("Should return MemberNotFoundError if field doesn't exists", func() {
+			_, err := standaloneClient.HGet(context.Background(), testKey, member)
+			Expect(err).To(MatchError(redis.NewMemberNotFoundError(testKey, member)))
+		})
+	})
+
+	Describe("HGetAll", func() {
+		It("Should return every field and value", func() {
+			err := goRedis.HSet(context.Background(), testKey, "field1", "value1", "field2", "value2").Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			values, err := standaloneClient.HGetAll(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]string{"field1": "value1", "field2": "value2"}))
+		})
+
+		It("Should return empty map if key doesn't exists", func() {
+			values, err := standaloneClient.HGetAll(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeEmpty())
+		})
+	})
+
+	Describe("HIncrBy", func() {
+		It("Should return field value after the increment", func() {
+			value, err := standaloneClient.HIncrBy(context.Background(), testKey, member, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(int64(1)))
+
+			value, err = standaloneClient.HIncrBy(context.Background(), testKey, member, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(int64(3)))
+		})
+	})
+
+	Describe("HSet", func() {
+		It("Should overwrite field value", func() {
+			err := standaloneClient.HSet(context.Background(), testKey, member, "value1")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = standaloneClient.HSet(context.Background(), testKey, member, "value2")
+			Expect(err).NotTo(HaveOccurred())
+
+			value, err := standaloneClient.HGet(context.Background(), testKey, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value2"))
+		})
+	})
+
+	Describe("HSetNX", func() {
+		It("Should set field only if it doesn't exists", func() {
+			set, err := standaloneClient.HSetNX(context.Background(), testKey, member, "value1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(set).To(BeTrue())
+
+			set, err = standaloneClient.HSetNX(context.Background(), testKey, member, "value2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(set).To(BeFalse())
+
+			value, err := goRedis.HGet(context.Background(), testKey, member).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value1"))
+		})
+	})
+
 	Describe("Ping", func() {
 		It("Should return PONG, nil if redis is OK", func() {
 			result, err := standaloneClient.Ping(context.Background())
@@ -113,6 +225,27 @@ var _ = Describe("Standalone Client", func() {
 		})
 	})
 
+	Describe("RunScript", func() {
+		It("Should return script result", func() {
+			script := redis.NewScript(`
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+return redis.call("ZSCORE", KEYS[1], ARGV[2])
+`)
+
+			result, err := standaloneClient.RunScript(context.Background(), script, []string{testKey}, 1.0, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal("1"))
+		})
+
+		It("Should return nil if script returns nil", func() {
+			script := redis.NewScript(`return redis.call("ZSCORE", KEYS[1], ARGV[1])`)
+
+			result, err := standaloneClient.RunScript(context.Background(), script, []string{testKey}, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+	})
+
 	Describe("SAdd", func() {
 		It("Should return nil if member is add to set", func() {
 			err := standaloneClient.SAdd(context.Background(), testKey, member)
@@ -164,6 +297,37 @@ var _ = Describe("Standalone Client", func() {
 		})
 	})
 
+	Describe("ScanType", func() {
+		It("Should return only keys matching pattern and type", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 1.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = goRedis.SAdd(context.Background(), testKey+"Set", member).Err()
+			Expect(err).NotTo(HaveOccurred())
+			defer goRedis.Del(context.Background(), testKey+"Set")
+
+			keys, err := standaloneClient.ScanType(context.Background(), testKey+"*", "zset")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keys).To(Equal([]string{testKey}))
+		})
+	})
+
+	Describe("Set", func() {
+		It("Should set key value with expiration", func() {
+			err := standaloneClient.Set(context.Background(), testKey, "value", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			value, err := goRedis.Get(context.Background(), testKey).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value"))
+
+			ttl, err := goRedis.TTL(context.Background(), testKey).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ttl).To(BeNumerically("~", time.Minute, time.Second))
+		})
+	})
+
 	Describe("TTL", func() {
 		It("Should return time.Duration if key has TTL set", func() {
 			err := goRedis.Set(context.Background(), testKey, "testValue", 10*time.Minute).Err()
@@ -316,6 +480,27 @@ var _ = Describe("Standalone Client", func() {
 		})
 	})
 
+	Describe("ZRanks", func() {
+		It("Should return members rank and nil for members not in set", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 2.0}, &goredis.Z{Member: "member2", Score: 1.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			ranks, err := standaloneClient.ZRanks(context.Background(), testKey, member, "member not found", "member2")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranks).To(HaveLen(3))
+			Expect(*ranks[0]).To(BeEquivalentTo(1))
+			Expect(ranks[1]).To(BeNil())
+			Expect(*ranks[2]).To(BeEquivalentTo(0))
+		})
+
+		It("Should return empty list if no member is given", func() {
+			ranks, err := standaloneClient.ZRanks(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ranks).To(BeEmpty())
+		})
+	})
+
 	Describe("ZRem", func() {
 		It("Should return nil if member is removed from set", func() {
 			score := 1.0
@@ -426,6 +611,21 @@ var _ = Describe("Standalone Client", func() {
 		})
 	})
 
+	Describe("ZRevRanks", func() {
+		It("Should return members reverse rank and nil for members not in set", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 2.0}, &goredis.Z{Member: "member2", Score: 1.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			ranks, err := standaloneClient.ZRevRanks(context.Background(), testKey, member, "member not found", "member2")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranks).To(HaveLen(3))
+			Expect(*ranks[0]).To(BeEquivalentTo(0))
+			Expect(ranks[1]).To(BeNil())
+			Expect(*ranks[2]).To(BeEquivalentTo(1))
+		})
+	})
+
 	Describe("ZScore", func() {
 		It("Should return score if member is in set", func() {
 			score := 1.0
@@ -449,4 +649,27 @@ var _ = Describe("Standalone Client", func() {
 			Expect(err).To(Equal(redis.NewMemberNotFoundError(testKey, "wrongKey")))
 		})
 	})
+
+	Describe("ZScores", func() {
+		It("Should return members score and nil for members not in set", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 2.0}, &goredis.Z{Member: "member2", Score: 1.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			scores, err := standaloneClient.ZScores(context.Background(), testKey, member, "member not found", "member2")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(scores).To(HaveLen(3))
+			Expect(*scores[0]).To(Equal(2.0))
+			Expect(scores[1]).To(BeNil())
+			Expect(*scores[2]).To(Equal(1.0))
+		})
+
+		It("Should return GeneralError if key is not a sorted set", func() {
+			err := goRedis.SAdd(context.Background(), testKey, member).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = standaloneClient.ZScores(context.Background(), testKey, member)
+			Expect(err).To(BeAssignableToTypeOf(&redis.GeneralError{}))
+		})
+	})
 })
@@ -0,0 +1,187 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+)
+
+var _ Archive = &Redis{}
+
+// GetLeaderboardsToArchive return up to amount leaderboards whose season ended until maxTime
+func (r *Redis) GetLeaderboardsToArchive(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	leaderboards, err := r.Client.ZRangeByScore(ctx, r.Keys.SeasonRollovers(), "-inf", strconv.FormatInt(maxTime.Unix(), 10), 0, int64(amount))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return leaderboards, nil
+}
+
+// ArchiveLeaderboard copy leaderboard members to its archive, evaluate its reward brackets, schedule its
+// deliveries and tier movements and remove it from the rollovers set, leaderboards already archived keep
+// their first archive
+func (r *Redis) ArchiveLeaderboard(ctx context.Context, leaderboard string, options *ArchiveOptions) (*LeaderboardArchive, error) {
+	seasonEnd, err := r.Client.ZScore(ctx, r.Keys.SeasonRollovers(), leaderboard)
+	if err != nil {
+		// another worker archived it since leaderboard was listed
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return r.GetLeaderboardArchive(ctx, leaderboard)
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	keys := []string{r.Keys.Leaderboard(leaderboard), r.Keys.LeaderboardArchive(leaderboard)}
+	result, err := r.Client.RunScript(ctx, archiveLeaderboardScript, keys)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	totalMembers, _ := result.(int64)
+	archive := &LeaderboardArchive{
+		ID:           leaderboard,
+		SeasonEnd:    time.Unix(int64(seasonEnd), 0).UTC(),
+		ArchivedAt:   time.Now().UTC(),
+		TotalMembers: int(totalMembers),
+	}
+	if options != nil && len(options.RewardTiers) > 0 {
+		archive.RewardOrder = options.RewardOrder
+		archive.RewardBrackets = rewards.NewBrackets(options.RewardTiers, archive.TotalMembers)
+	}
+
+	value, err := json.Marshal(archive)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	_, err = r.Client.HSetNX(ctx, r.Keys.LeaderboardArchives(), leaderboard, string(value))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	// deliveries are scheduled before leaving the rollovers set so they aren't lost if archiving is interrupted
+	if options != nil && options.DeliverRewards && len(archive.RewardBrackets) > 0 {
+		err = r.Client.ZAdd(ctx, r.Keys.RewardDeliveries(), &redis.Member{
+			Member: leaderboard,
+			Score:  float64(archive.ArchivedAt.Unix()),
+		})
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	if options != nil && options.MoveTiers {
+		err = r.Client.ZAdd(ctx, r.Keys.TierMovementJobs(), &redis.Member{
+			Member: leaderboard,
+			Score:  float64(archive.ArchivedAt.Unix()),
+		})
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.Client.ZRem(ctx, r.Keys.SeasonRollovers(), leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return r.GetLeaderboardArchive(ctx, leaderboard)
+}
+
+// GetLeaderboardArchive return leaderboard archive metadata
+func (r *Redis) GetLeaderboardArchive(ctx context.Context, leaderboard string) (*LeaderboardArchive, error) {
+	value, err := r.Client.HGet(ctx, r.Keys.LeaderboardArchives(), leaderboard)
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return nil, NewLeaderboardArchiveNotFoundError(leaderboard)
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	archive := &LeaderboardArchive{}
+	err = json.Unmarshal([]byte(value), archive)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return archive, nil
+}
+
+// GetArchivedOrderedMembers return archived members from start to stop position, like GetOrderedMembers
+func (r *Redis) GetArchivedOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error) {
+	return r.getOrderedMembers(ctx, r.Keys.LeaderboardArchive(leaderboard), start, stop, order)
+}
+
+// GetArchivedMembers return archived members score and rank, nil for members that weren't in the leaderboard
+func (r *Redis) GetArchivedMembers(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error) {
+	return r.getMembers(ctx, r.Keys.LeaderboardArchive(leaderboard), "", order, members)
+}
+
+// GetRewardDeliveries return up to amount leaderboards whose reward brackets must be delivered until maxTime
+func (r *Redis) GetRewardDeliveries(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	return r.getScheduledJobs(ctx, r.Keys.RewardDeliveries(), maxTime, amount)
+}
+
+// CompleteRewardDelivery remove leaderboard from the reward deliveries and forget its failed attempts
+func (r *Redis) CompleteRewardDelivery(ctx context.Context, leaderboard string) error {
+	return r.completeScheduledJob(ctx, r.Keys.RewardDeliveries(), r.Keys.RewardDeliveryAttempts(), leaderboard)
+}
+
+// RetryRewardDelivery count a failed reward delivery and return how many attempts failed, the next attempt
+// waits retryInterval doubled for each previous failure and deliveries failing maxAttempts times are dropped
+func (r *Redis) RetryRewardDelivery(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error) {
+	return r.retryScheduledJob(ctx, r.Keys.RewardDeliveries(), r.Keys.RewardDeliveryAttempts(), leaderboard, maxAttempts, retryInterval)
+}
+
+// getScheduledJobs return up to amount leaderboards of jobs sorted set whose next attempt is until maxTime
+func (r *Redis) getScheduledJobs(ctx context.Context, jobs string, maxTime time.Time, amount int) ([]string, error) {
+	leaderboards, err := r.Client.ZRangeByScore(ctx, jobs, "-inf", strconv.FormatInt(maxTime.Unix(), 10), 0, int64(amount))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return leaderboards, nil
+}
+
+// completeScheduledJob remove leaderboard from jobs sorted set and from attempts hash
+func (r *Redis) completeScheduledJob(ctx context.Context, jobs, attempts, leaderboard string) error {
+	err := r.Client.ZRem(ctx, jobs, leaderboard)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	_, err = r.Client.HDel(ctx, attempts, leaderboard)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// retryScheduledJob count a failed job in attempts hash and reschedule it in jobs sorted set with an
+// exponential backoff, jobs failing maxAttempts times are completed
+func (r *Redis) retryScheduledJob(ctx context.Context, jobs, attempts, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error) {
+	failed, err := r.Client.HIncrBy(ctx, attempts, leaderboard, 1)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	if int(failed) >= maxAttempts {
+		return int(failed), r.completeScheduledJob(ctx, jobs, attempts, leaderboard)
+	}
+
+	nextAttempt := time.Now().Add(retryInterval * time.Duration(1<<(failed-1)))
+	err = r.Client.ZAdd(ctx, jobs, &redis.Member{
+		Member: leaderboard,
+		Score:  float64(nextAttempt.Unix()),
+	})
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	return int(failed), nil
+}
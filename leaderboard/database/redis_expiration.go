@@ -2,9 +2,7 @@ package database
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
@@ -14,14 +12,18 @@ var _ Expiration = &Redis{}
 
 // GetExpirationLeaderboards return leaderboards registerd with members to expire
 func (r *Redis) GetExpirationLeaderboards(ctx context.Context) ([]string, error) {
-	expirationKeys, err := r.Client.SMembers(ctx, ExpirationSet)
+	expirationKeys, err := r.Client.SMembers(ctx, r.Keys.ExpirationSet())
 	if err != nil {
 		return nil, NewGeneralError(err.Error())
 	}
 
 	expirationLeaderboards := make([]string, 0, len(expirationKeys))
 	for _, expirationKey := range expirationKeys {
-		expirationLeaderboards = append(expirationLeaderboards, strings.TrimSuffix(expirationKey, ":ttl"))
+		leaderboard, ok := r.Keys.ParseLeaderboardTTL(expirationKey)
+		if !ok {
+			continue
+		}
+		expirationLeaderboards = append(expirationLeaderboards, leaderboard)
 	}
 
 	return expirationLeaderboards, nil
@@ -29,7 +31,7 @@ func (r *Redis) GetExpirationLeaderboards(ctx context.Context) ([]string, error)
 
 // GetMembersToExpire get members in the leaderboard to expire
 func (r *Redis) GetMembersToExpire(ctx context.Context, leaderboard string, amount int, maxTime time.Time) ([]string, error) {
-	expirationSet := fmt.Sprintf("%s:ttl", leaderboard)
+	expirationSet := r.Keys.LeaderboardTTL(leaderboard)
 
 	err := r.Client.Exists(ctx, expirationSet)
 	if err != nil {
@@ -51,9 +53,9 @@ func (r *Redis) GetMembersToExpire(ctx context.Context, leaderboard string, amou
 
 // RemoveLeaderboardFromExpireList remove from leaderboard expiration list the leaderboard
 func (r *Redis) RemoveLeaderboardFromExpireList(ctx context.Context, leaderboard string) error {
-	leaderboardExpirationKey := fmt.Sprintf("%s:ttl", leaderboard)
+	leaderboardExpirationKey := r.Keys.LeaderboardTTL(leaderboard)
 
-	err := r.Client.SRem(ctx, ExpirationSet, leaderboardExpirationKey)
+	err := r.Client.SRem(ctx, r.Keys.ExpirationSet(), leaderboardExpirationKey)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
@@ -63,11 +65,11 @@ func (r *Redis) RemoveLeaderboardFromExpireList(ctx context.Context, leaderboard
 
 // ExpireMembers remove members from leaderboard
 func (r *Redis) ExpireMembers(ctx context.Context, leaderboard string, members []string) error {
-	leaderboardExpirationKey := fmt.Sprintf("%s:ttl", leaderboard)
+	leaderboardExpirationKey := r.Keys.LeaderboardTTL(leaderboard)
 
-	err := r.Client.ZRem(ctx, leaderboard, members...)
+	err := r.removeMembers(ctx, leaderboard, members)
 	if err != nil {
-		return NewGeneralError(err.Error())
+		return err
 	}
 
 	err = r.Client.ZRem(ctx, leaderboardExpirationKey, members...)
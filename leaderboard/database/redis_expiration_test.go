@@ -26,7 +26,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = redis.NewMockRedis(ctrl)
 
-		redisExpiration = &database.Redis{mock}
+		redisExpiration = &database.Redis{Client: mock}
 	})
 
 	AfterEach(func() {
@@ -43,6 +43,16 @@ var _ = Describe("Redis Expiration Database", func() {
 			Expect(leaderboards).To(Equal([]string{leaderboard}))
 		})
 
+		It("Should parse leaderboards and skip unknown keys if key schema is V2", func() {
+			redisExpiration = &database.Redis{Client: mock, Keys: database.Keys{Version: database.KeySchemaV2, Prefix: "podium:"}}
+			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq("podium:expiration-sets")).Return([]string{"podium:{leaderboardTest}:ttl", "leaderboardTest:ttl"}, nil)
+
+			leaderboards, err := redisExpiration.GetExpirationLeaderboards(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leaderboards).To(Equal([]string{leaderboard}))
+		})
+
 		It("Should return GeneralError if redis return any other error", func() {
 			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq(database.ExpirationSet)).Return(nil, fmt.Errorf("redis error"))
 
@@ -101,7 +111,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return nil if all is ok", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboard + ":scores", leaderboard + ":ratings"}), "ZREM", member, member2).Return(nil, nil)
 			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
@@ -111,7 +121,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return GeneralError if redis return in error on remove member from leaderboard", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(fmt.Errorf("New redis error"))
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboard + ":scores", leaderboard + ":ratings"}), "ZREM", member, member2).Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
 			Expect(err).To(MatchError(database.NewGeneralError("New redis error")))
@@ -120,7 +130,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return GeneralError if redis return in error on remove member from expiration set", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboard + ":scores", leaderboard + ":ratings"}), "ZREM", member, member2).Return(nil, nil)
 			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(member), gomock.Eq(member2)).Return(fmt.Errorf("New redis error"))
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ Ladders = &Redis{}
+
+// ReportChallenge reorder ladder positions by a challenge result atomically and return the challenger and the
+// defender score and rank in ascending position order, it returns ChallengeOutOfRangeError without writing
+// anything if the defender isn't ranked above the challenger within the challenge max distance
+func (r *Redis) ReportChallenge(ctx context.Context, leaderboard string, challenge *Challenge, options *LadderOptions) ([]*Member, error) {
+	keys := r.scoreIndexKeys(leaderboard)
+	if options.IdempotencyKey != "" {
+		keys = append(keys, r.Keys.LeaderboardIdempotency(leaderboard, options.IdempotencyKey), r.Keys.LeaderboardIdempotencyKeys(leaderboard))
+	}
+
+	result, err := r.Client.RunScript(ctx, reportChallengeScript, keys,
+		challenge.Challenger,
+		challenge.Defender,
+		formatBoolArg(challenge.ChallengerWon),
+		challenge.MaxDistance,
+		formatTimeArg(options.ExpireAt),
+		options.IdempotencyWindow.Milliseconds(),
+	)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if outOfRange, ok := result.(int64); ok && outOfRange == 0 {
+		return nil, NewChallengeOutOfRangeError(leaderboard, challenge.Challenger, challenge.Defender)
+	}
+
+	members, err := parseUpsertedMembers(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.SeasonEnd.IsZero() {
+		err = r.Client.ZAdd(ctx, r.Keys.SeasonRollovers(), &redis.Member{
+			Member: leaderboard,
+			Score:  float64(options.SeasonEnd.Unix()),
+		})
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	return members, nil
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+var _ Leagues = &Redis{}
+
+// AssignLeagueBuckets return the bucket of each member, members that aren't in a bucket are assigned to the open
+// one, which is closed once it has bucketSize members, and league buckets expire at expireAt if it isn't zero
+func (r *Redis) AssignLeagueBuckets(ctx context.Context, league string, members []string, bucketSize int, expireAt time.Time) (map[string]int, error) {
+	args := make([]interface{}, 0, 2+len(members))
+	args = append(args, bucketSize, "")
+	if !expireAt.IsZero() {
+		args[1] = strconv.FormatInt(expireAt.Unix(), 10)
+	}
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	result, err := r.Client.RunScript(ctx, assignLeagueBucketsScript, []string{r.Keys.LeagueBuckets(league)}, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != len(members)+1 {
+		return nil, NewGeneralError("unexpected assign league buckets result")
+	}
+
+	if assigned, _ := values[0].(int64); assigned == 1 {
+		err = r.Client.SAdd(ctx, r.Keys.Leagues(), league)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	buckets := make(map[string]int, len(members))
+	for i, member := range members {
+		bucket, _ := values[i+1].(int64)
+		buckets[member] = int(bucket)
+	}
+
+	return buckets, nil
+}
+
+// GetLeagueBuckets return the bucket of each member, members that aren't in a bucket are left out
+func (r *Redis) GetLeagueBuckets(ctx context.Context, league string, members ...string) (map[string]int, error) {
+	scores, err := r.Client.ZScores(ctx, r.Keys.LeagueBuckets(league), members...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	buckets := make(map[string]int, len(members))
+	for i, score := range scores {
+		if score != nil {
+			buckets[members[i]] = int(*score)
+		}
+	}
+
+	return buckets, nil
+}
+
+// GetLeagueBucketsCount return how many buckets league has
+func (r *Redis) GetLeagueBucketsCount(ctx context.Context, league string) (int, error) {
+	last, err := r.Client.ZRevRange(ctx, r.Keys.LeagueBuckets(league), 0, 0)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	if len(last) == 0 {
+		return 0, nil
+	}
+
+	return int(last[0].Score) + 1, nil
+}
+
+// RemoveLeague forget the bucket of every league member
+func (r *Redis) RemoveLeague(ctx context.Context, league string) error {
+	err := r.Client.Del(ctx, r.Keys.LeagueBuckets(league))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.SRem(ctx, r.Keys.Leagues(), league)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,675 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// MigrationOptions define which leaderboards MigrateKeys moves
+type MigrationOptions struct {
+	// Leaderboards are glob patterns, leaderboards whose id matches none of them are left where they are.
+	// They are required when from keys have no prefix and schema V1, since then any sorted set is a leaderboard
+	Leaderboards []string
+	// DryRun only counts the leaderboards that would be moved, nothing is written
+	DryRun bool
+	// BatchSize is how many members are read from redis at a time, defaults to 1000
+	BatchSize int
+}
+
+const defaultMigrationBatchSize = 1000
+
+// batchSize return how many members are read from redis at a time
+func (options *MigrationOptions) batchSize() int {
+	if options.BatchSize <= 0 {
+		return defaultMigrationBatchSize
+	}
+	return options.BatchSize
+}
+
+// allows report if leaderboard must be moved
+func (options *MigrationOptions) allows(leaderboard string) bool {
+	if len(options.Leaderboards) == 0 {
+		return true
+	}
+
+	for _, pattern := range options.Leaderboards {
+		if ok, _ := path.Match(pattern, leaderboard); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateKeys move every leaderboard stored with from keys to r keys and return how many leaderboards were moved,
+// or would be moved in a dry run.
+//
+//	Members, members TTL and leaderboard expiration are copied before old keys are removed, so migration
+//	must run while podium is not writing, otherwise scores written during the copy are lost.
+//	Sorted sets registered in from expiration set are handled as TTL sets and every other sorted set
+//	matching from prefix is handled as a leaderboard, keys already valid in r keys are skipped.
+//	Distinct scores indexes aren't copied, IndexScores rebuilds them once leaderboards are moved, and
+//	member ratings, quarantined scores, score rules submission counts and the results kept for writes made
+//	with an idempotency key are moved with their leaderboard. Sorted sets are read in batches.
+//	Leaderboard definitions and archives metadata are moved to r hashes, entries already there are kept,
+//	archived standings are moved with them and so are leaderboards waiting to be archived and reward
+//	deliveries with their failed attempts. League buckets are moved with their expiration, tier histories
+//	and tier movement jobs with their failed attempts
+func (r *Redis) MigrateKeys(ctx context.Context, from Keys, options *MigrationOptions) (int, error) {
+	if from == r.Keys {
+		return 0, nil
+	}
+
+	if from.Version == KeySchemaV1 && from.Prefix == "" && len(options.Leaderboards) == 0 {
+		return 0, NewGeneralError("leaderboards to migrate are required when keys have no prefix, every sorted set would be moved")
+	}
+
+	expirationKeys, err := r.Client.SMembers(ctx, from.ExpirationSet())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isExpirationKey := make(map[string]bool, len(expirationKeys))
+	pendingExpirationKeys := make(map[string]bool, len(expirationKeys))
+	for _, expirationKey := range expirationKeys {
+		isExpirationKey[expirationKey] = true
+		pendingExpirationKeys[expirationKey] = true
+	}
+
+	keys, err := r.Client.ScanType(ctx, escapeMatchPattern(from.Prefix)+"*", "zset")
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[key] = true
+	}
+
+	archives, err := r.Client.HGetAll(ctx, from.LeaderboardArchives())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	leagues, err := r.Client.SMembers(ctx, from.Leagues())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isLeague := make(map[string]bool, len(leagues))
+	for _, league := range leagues {
+		isLeague[league] = true
+	}
+
+	batchSize := options.batchSize()
+	migrated := 0
+	for _, key := range keys {
+		if isExpirationKey[key] || key == from.SeasonRollovers() || key == from.RewardDeliveries() || key == from.TierMovementJobs() {
+			continue
+		}
+
+		if leaderboard, ok := from.ParseLeaderboardArchive(key); ok && archives[leaderboard] != "" {
+			if !r.isCurrentKey(from, key) && options.allows(leaderboard) && !options.DryRun {
+				err = r.moveSortedSet(ctx, key, r.Keys.LeaderboardArchive(leaderboard), batchSize)
+				if err != nil {
+					return migrated, err
+				}
+			}
+			continue
+		}
+
+		if league, ok := from.ParseLeagueBuckets(key); ok && isLeague[league] {
+			if !r.isCurrentKey(from, key) && options.allows(league) && !options.DryRun {
+				err = r.migrateLeagueBuckets(ctx, from, league, batchSize)
+				if err != nil {
+					return migrated, err
+				}
+			}
+			continue
+		}
+
+		// distinct scores indexes are dropped with their leaderboard and rebuilt by IndexScores
+		if leaderboard, ok := from.ParseLeaderboardScores(key); ok && isKey[from.Leaderboard(leaderboard)] {
+			continue
+		}
+
+		// idempotency keys indexes list the kept results of leaderboard writes, which are moved with their leaderboard
+		if _, ok := from.ParseLeaderboardIdempotencyKeys(key); ok {
+			continue
+		}
+
+		if r.isCurrentKey(from, key) {
+			continue
+		}
+
+		leaderboard, ok := from.ParseLeaderboard(key)
+		if !ok || !options.allows(leaderboard) {
+			continue
+		}
+
+		if options.DryRun {
+			migrated++
+			continue
+		}
+
+		err = r.migrateLeaderboard(ctx, from, leaderboard, batchSize)
+		if err != nil {
+			return migrated, err
+		}
+
+		expirationKey := from.LeaderboardTTL(leaderboard)
+		if pendingExpirationKeys[expirationKey] {
+			err = r.migrateLeaderboardTTL(ctx, from, leaderboard, batchSize)
+			if err != nil {
+				return migrated, err
+			}
+			delete(pendingExpirationKeys, expirationKey)
+		}
+
+		migrated++
+	}
+
+	if options.DryRun {
+		return migrated, nil
+	}
+
+	// TTL sets whose leaderboard was removed still need to be moved so worker can clean them
+	for expirationKey := range pendingExpirationKeys {
+		leaderboard, ok := from.ParseLeaderboardTTL(expirationKey)
+		if !ok || r.isCurrentKey(from, expirationKey) || !options.allows(leaderboard) {
+			continue
+		}
+
+		err = r.migrateLeaderboardTTL(ctx, from, leaderboard, batchSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	if from.SeasonRollovers() != r.Keys.SeasonRollovers() {
+		err = r.moveSortedSet(ctx, from.SeasonRollovers(), r.Keys.SeasonRollovers(), batchSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	if from.RewardDeliveries() != r.Keys.RewardDeliveries() {
+		err = r.moveSortedSet(ctx, from.RewardDeliveries(), r.Keys.RewardDeliveries(), batchSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	if from.TierMovementJobs() != r.Keys.TierMovementJobs() {
+		err = r.moveSortedSet(ctx, from.TierMovementJobs(), r.Keys.TierMovementJobs(), batchSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	if from.Leagues() != r.Keys.Leagues() {
+		for _, league := range leagues {
+			err = r.Client.SAdd(ctx, r.Keys.Leagues(), league)
+			if err != nil {
+				return migrated, NewGeneralError(err.Error())
+			}
+		}
+
+		err = r.Client.Del(ctx, from.Leagues())
+		if err != nil {
+			return migrated, NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.migrateHash(ctx, from.LeaderboardDefinitions(), r.Keys.LeaderboardDefinitions())
+	if err != nil {
+		return migrated, err
+	}
+
+	err = r.migrateHash(ctx, from.LeaderboardArchives(), r.Keys.LeaderboardArchives())
+	if err != nil {
+		return migrated, err
+	}
+
+	err = r.migrateHash(ctx, from.RewardDeliveryAttempts(), r.Keys.RewardDeliveryAttempts())
+	if err != nil {
+		return migrated, err
+	}
+
+	err = r.migrateHash(ctx, from.TierMovementAttempts(), r.Keys.TierMovementAttempts())
+	if err != nil {
+		return migrated, err
+	}
+
+	err = r.migrateTieredLeagues(ctx, from)
+	if err != nil {
+		return migrated, err
+	}
+
+	return migrated, nil
+}
+
+// IndexScores build the distinct scores index of every leaderboard stored with r keys that has none and return
+// how many leaderboards were indexed, or would be indexed in a dry run. Leaderboards written before podium kept
+// the index and leaderboards moved by MigrateKeys have none, and their dense ranks can't be read until it's
+// built. Members are read in batches so large leaderboards don't block redis, but like MigrateKeys it must run
+// while podium is not writing
+func (r *Redis) IndexScores(ctx context.Context, options *MigrationOptions) (int, error) {
+	if r.Keys.Version == KeySchemaV1 && r.Keys.Prefix == "" && len(options.Leaderboards) == 0 {
+		return 0, NewGeneralError("leaderboards to index are required when keys have no prefix, every sorted set would be indexed")
+	}
+
+	expirationKeys, err := r.Client.SMembers(ctx, r.Keys.ExpirationSet())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isExpirationKey := make(map[string]bool, len(expirationKeys))
+	for _, expirationKey := range expirationKeys {
+		isExpirationKey[expirationKey] = true
+	}
+
+	keys, err := r.Client.ScanType(ctx, escapeMatchPattern(r.Keys.Prefix)+"*", "zset")
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[key] = true
+	}
+
+	archives, err := r.Client.HGetAll(ctx, r.Keys.LeaderboardArchives())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	leagues, err := r.Client.SMembers(ctx, r.Keys.Leagues())
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	isLeague := make(map[string]bool, len(leagues))
+	for _, league := range leagues {
+		isLeague[league] = true
+	}
+
+	indexed := 0
+	for _, key := range keys {
+		if isExpirationKey[key] || key == r.Keys.SeasonRollovers() || key == r.Keys.RewardDeliveries() || key == r.Keys.TierMovementJobs() {
+			continue
+		}
+
+		if leaderboard, ok := r.Keys.ParseLeaderboardArchive(key); ok && archives[leaderboard] != "" {
+			continue
+		}
+
+		if league, ok := r.Keys.ParseLeagueBuckets(key); ok && isLeague[league] {
+			continue
+		}
+
+		if leaderboard, ok := r.Keys.ParseLeaderboardScores(key); ok && isKey[r.Keys.Leaderboard(leaderboard)] {
+			continue
+		}
+
+		if _, ok := r.Keys.ParseLeaderboardIdempotencyKeys(key); ok {
+			continue
+		}
+
+		leaderboard, ok := r.Keys.ParseLeaderboard(key)
+		if !ok || !options.allows(leaderboard) || isKey[r.Keys.LeaderboardScores(leaderboard)] {
+			continue
+		}
+
+		if options.DryRun {
+			indexed++
+			continue
+		}
+
+		err = r.indexScores(ctx, leaderboard, options.batchSize())
+		if err != nil {
+			return indexed, err
+		}
+		indexed++
+	}
+
+	return indexed, nil
+}
+
+// indexScores add every distinct score of leaderboard to its index, reading batchSize members at a time
+func (r *Redis) indexScores(ctx context.Context, leaderboard string, batchSize int) error {
+	min := "-inf"
+	for {
+		result, err := r.Client.RunScript(ctx, indexScoresScript, r.scoreIndexKeys(leaderboard), min, batchSize)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		highest, ok := result.(string)
+		if !ok {
+			return NewGeneralError(fmt.Sprintf("unexpected index scores result %v", result))
+		}
+		if highest == "" {
+			return nil
+		}
+		min = "(" + highest
+	}
+}
+
+// isCurrentKey report if key is already stored with r keys, when a key can be parsed by both
+// schemas it belongs to the most specific one, hash tagged first and then the longest prefix
+func (r *Redis) isCurrentKey(from Keys, key string) bool {
+	_, isLeaderboard := r.Keys.ParseLeaderboard(key)
+	_, isLeaderboardTTL := r.Keys.ParseLeaderboardTTL(key)
+	_, isLeaderboardScores := r.Keys.ParseLeaderboardScores(key)
+	_, isLeaderboardArchive := r.Keys.ParseLeaderboardArchive(key)
+	_, isLeagueBuckets := r.Keys.ParseLeagueBuckets(key)
+	if !isLeaderboard && !isLeaderboardTTL && !isLeaderboardScores && !isLeaderboardArchive && !isLeagueBuckets {
+		return false
+	}
+
+	if r.Keys.Version != from.Version {
+		return r.Keys.Version == KeySchemaV2
+	}
+
+	return len(r.Keys.Prefix) > len(from.Prefix)
+}
+
+func (r *Redis) migrateLeaderboard(ctx context.Context, from Keys, leaderboard string, batchSize int) error {
+	source := from.Leaderboard(leaderboard)
+	target := r.Keys.Leaderboard(leaderboard)
+
+	err := r.copySortedSet(ctx, source, target, batchSize)
+	if err != nil {
+		return err
+	}
+
+	err = r.copyExpiration(ctx, source, target)
+	if err != nil {
+		return err
+	}
+
+	err = r.migrateHash(ctx, from.LeaderboardRatings(leaderboard), r.Keys.LeaderboardRatings(leaderboard))
+	if err != nil {
+		return err
+	}
+
+	err = r.copyExpiration(ctx, source, r.Keys.LeaderboardRatings(leaderboard))
+	if err != nil {
+		return err
+	}
+
+	err = r.migrateHash(ctx, from.LeaderboardQuarantine(leaderboard), r.Keys.LeaderboardQuarantine(leaderboard))
+	if err != nil {
+		return err
+	}
+
+	err = r.migrateHash(ctx, from.LeaderboardSubmissions(leaderboard), r.Keys.LeaderboardSubmissions(leaderboard))
+	if err != nil {
+		return err
+	}
+
+	err = r.migrateIdempotentWrites(ctx, from, leaderboard, batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range []string{source, from.LeaderboardScores(leaderboard)} {
+		err = r.Client.Del(ctx, key)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// migrateIdempotentWrites move the results kept for leaderboard writes made with an idempotency key along with
+// their index, keeping when each one is forgotten, results that were already forgotten are dropped
+func (r *Redis) migrateIdempotentWrites(ctx context.Context, from Keys, leaderboard string, batchSize int) error {
+	source := from.LeaderboardIdempotencyKeys(leaderboard)
+	target := r.Keys.LeaderboardIdempotencyKeys(leaderboard)
+
+	for start := int64(0); ; start += int64(batchSize) {
+		kept, err := r.Client.ZRange(ctx, source, start, start+int64(batchSize)-1)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		for _, entry := range kept {
+			err = r.migrateIdempotentWrite(ctx, from, leaderboard, entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(kept) < batchSize {
+			break
+		}
+	}
+
+	err := r.copyExpiration(ctx, source, target)
+	if err != nil {
+		return err
+	}
+
+	err = r.Client.Del(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// migrateIdempotentWrite move the result listed by entry of from idempotency keys index of leaderboard
+func (r *Redis) migrateIdempotentWrite(ctx context.Context, from Keys, leaderboard string, entry *redis.Member) error {
+	key := strings.TrimPrefix(entry.Member, from.LeaderboardIdempotency(leaderboard, ""))
+	target := r.Keys.LeaderboardIdempotency(leaderboard, key)
+
+	ttl := time.Until(time.UnixMilli(int64(entry.Score)))
+	if ttl > 0 {
+		result, err := r.Client.Get(ctx, entry.Member)
+		if err != nil {
+			if _, ok := err.(*redis.KeyNotFoundError); ok {
+				return nil
+			}
+			return NewGeneralError(err.Error())
+		}
+
+		err = r.Client.Set(ctx, target, result, ttl)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		err = r.Client.ZAdd(ctx, r.Keys.LeaderboardIdempotencyKeys(leaderboard), &redis.Member{Member: target, Score: entry.Score})
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	err := r.Client.Del(ctx, entry.Member)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *Redis) migrateLeagueBuckets(ctx context.Context, from Keys, league string, batchSize int) error {
+	source := from.LeagueBuckets(league)
+	target := r.Keys.LeagueBuckets(league)
+
+	err := r.copySortedSet(ctx, source, target, batchSize)
+	if err != nil {
+		return err
+	}
+
+	err = r.copyExpiration(ctx, source, target)
+	if err != nil {
+		return err
+	}
+
+	err = r.Client.Del(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// migrateTieredLeagues move the tier history of every tiered league and the set listing them
+func (r *Redis) migrateTieredLeagues(ctx context.Context, from Keys) error {
+	if from.TieredLeagues() == r.Keys.TieredLeagues() {
+		return nil
+	}
+
+	competitions, err := r.Client.SMembers(ctx, from.TieredLeagues())
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	for _, competition := range competitions {
+		err = r.migrateHash(ctx, from.TierHistory(competition), r.Keys.TierHistory(competition))
+		if err != nil {
+			return err
+		}
+
+		err = r.Client.SAdd(ctx, r.Keys.TieredLeagues(), competition)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.Client.Del(ctx, from.TieredLeagues())
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// copyExpiration expire target when source expires, if source has an expiration and target exists
+func (r *Redis) copyExpiration(ctx context.Context, source, target string) error {
+	ttl, err := r.Client.TTL(ctx, source)
+	if err != nil {
+		switch err.(type) {
+		case *redis.TTLNotFoundError, *redis.KeyNotFoundError:
+			return nil
+		default:
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.Client.ExpireAt(ctx, target, time.Now().Add(ttl))
+	if err != nil {
+		// targets that weren't copied, like ratings of leaderboards without them, have nothing to expire
+		if _, ok := err.(*redis.KeyNotFoundError); ok {
+			return nil
+		}
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *Redis) migrateLeaderboardTTL(ctx context.Context, from Keys, leaderboard string, batchSize int) error {
+	source := from.LeaderboardTTL(leaderboard)
+	target := r.Keys.LeaderboardTTL(leaderboard)
+
+	err := r.copySortedSet(ctx, source, target, batchSize)
+	if err != nil {
+		return err
+	}
+
+	err = r.Client.SAdd(ctx, r.Keys.ExpirationSet(), target)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.SRem(ctx, from.ExpirationSet(), source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.Del(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// migrateHash move source fields missing in target and source expiration, if it has one
+func (r *Redis) migrateHash(ctx context.Context, source, target string) error {
+	if source == target {
+		return nil
+	}
+
+	values, err := r.Client.HGetAll(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	for field, value := range values {
+		_, err = r.Client.HSetNX(ctx, target, field, value)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	err = r.copyExpiration(ctx, source, target)
+	if err != nil {
+		return err
+	}
+
+	err = r.Client.Del(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *Redis) moveSortedSet(ctx context.Context, source, target string, batchSize int) error {
+	err := r.copySortedSet(ctx, source, target, batchSize)
+	if err != nil {
+		return err
+	}
+
+	err = r.Client.Del(ctx, source)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// copySortedSet add source members to target, reading batchSize members at a time
+func (r *Redis) copySortedSet(ctx context.Context, source, target string, batchSize int) error {
+	for start := int64(0); ; start += int64(batchSize) {
+		members, err := r.Client.ZRange(ctx, source, start, start+int64(batchSize)-1)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		if len(members) > 0 {
+			err = r.Client.ZAdd(ctx, target, members...)
+			if err != nil {
+				return NewGeneralError(err.Error())
+			}
+		}
+
+		if len(members) < batchSize {
+			return nil
+		}
+	}
+}
+
+func escapeMatchPattern(pattern string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+	return replacer.Replace(pattern)
+}
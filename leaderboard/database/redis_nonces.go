@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+var _ Nonces = &Redis{}
+
+// ClaimNonce remember nonce of tenant for ttl, returning false if it was already remembered
+func (r *Redis) ClaimNonce(ctx context.Context, tenant, nonce string, ttl time.Duration) (bool, error) {
+	result, err := r.Client.RunScript(ctx, claimNonceScript, []string{r.Keys.SubmissionNonce(tenant, nonce)}, ttl.Milliseconds())
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	claimed, ok := result.(int64)
+	if !ok {
+		return false, NewGeneralError(fmt.Sprintf("unexpected claim nonce result %v", result))
+	}
+
+	return claimed == 1, nil
+}
+
+// ReleaseNonce forget nonce of tenant, so it can be claimed again
+func (r *Redis) ReleaseNonce(ctx context.Context, tenant, nonce string) error {
+	err := r.Client.Del(ctx, r.Keys.SubmissionNonce(tenant, nonce))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
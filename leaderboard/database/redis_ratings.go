@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ Ratings = &Redis{}
+
+// GetRatings return the rating of each member, members that were never rated are left out
+func (r *Redis) GetRatings(ctx context.Context, leaderboard string, members ...string) (map[string]*Rating, error) {
+	ratings := make(map[string]*Rating, len(members))
+	for _, member := range members {
+		value, err := r.Client.HGet(ctx, r.Keys.LeaderboardRatings(leaderboard), member)
+		if err != nil {
+			if _, ok := err.(*redis.MemberNotFoundError); ok {
+				continue
+			}
+			return nil, NewGeneralError(err.Error())
+		}
+
+		rating := &Rating{}
+		err = json.Unmarshal([]byte(value), rating)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		ratings[member] = rating
+	}
+
+	return ratings, nil
+}
+
+// WriteRatings write members rating and score atomically and return members score, rank and rating, it returns
+// RatingConflictError without writing anything if any member rating was written since the one its update
+// was computed from
+func (r *Redis) WriteRatings(ctx context.Context, leaderboard string, updates []*RatingUpdate, options *RatingOptions) ([]*RatedMember, error) {
+	if options.Order != "asc" && options.Order != "desc" {
+		return nil, NewInvalidOrderError(options.Order)
+	}
+
+	keys := append(r.scoreIndexKeys(leaderboard), r.Keys.LeaderboardRatings(leaderboard))
+	if options.IdempotencyKey != "" {
+		keys = append(keys, r.Keys.LeaderboardIdempotency(leaderboard, options.IdempotencyKey), r.Keys.LeaderboardIdempotencyKeys(leaderboard))
+	}
+
+	args := make([]interface{}, 0, 3+4*len(updates))
+	args = append(args, options.Order, formatTimeArg(options.ExpireAt), options.IdempotencyWindow.Milliseconds())
+	for _, update := range updates {
+		value, err := json.Marshal(update.Rating)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		args = append(args, update.Member, update.Rating.Matches-1, string(value), update.Score)
+	}
+
+	result, err := r.Client.RunScript(ctx, writeRatingsScript, keys, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if conflict, ok := result.(int64); ok && conflict == 0 {
+		return nil, NewRatingConflictError(leaderboard)
+	}
+
+	members, err := parseRatedMembers(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.SeasonEnd.IsZero() {
+		err = r.Client.ZAdd(ctx, r.Keys.SeasonRollovers(), &redis.Member{
+			Member: leaderboard,
+			Score:  float64(options.SeasonEnd.Unix()),
+		})
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	return members, nil
+}
+
+func parseRatedMembers(result interface{}) ([]*RatedMember, error) {
+	entries, ok := result.([]interface{})
+	if !ok {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected write ratings result %v", result))
+	}
+
+	members := make([]*RatedMember, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.([]interface{})
+		if !ok || len(fields) != 7 {
+			return nil, NewGeneralError(fmt.Sprintf("unexpected rated member %v", entry))
+		}
+
+		member, err := parseUpsertedMember(fields[:5])
+		if err != nil {
+			return nil, err
+		}
+		ratedMember := &RatedMember{Member: member, Rating: &Rating{}}
+
+		if previousRating, _ := fields[5].(string); previousRating != "" {
+			ratedMember.PreviousRating = &Rating{}
+			if err := json.Unmarshal([]byte(previousRating), ratedMember.PreviousRating); err != nil {
+				return nil, NewGeneralError(err.Error())
+			}
+		}
+
+		rating, _ := fields[6].(string)
+		if err := json.Unmarshal([]byte(rating), ratedMember.Rating); err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		members = append(members, ratedMember)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ Registry = &Redis{}
+
+// CreateLeaderboardDefinition register a leaderboard definition, fail if leaderboard is already registered
+func (r *Redis) CreateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	value, err := json.Marshal(definition)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	created, err := r.Client.HSetNX(ctx, r.Keys.LeaderboardDefinitions(), definition.ID, string(value))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if !created {
+		return NewLeaderboardDefinitionAlreadyExistsError(definition.ID)
+	}
+
+	return nil
+}
+
+// GetLeaderboardDefinition return leaderboard definition
+func (r *Redis) GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*LeaderboardDefinition, error) {
+	value, err := r.Client.HGet(ctx, r.Keys.LeaderboardDefinitions(), leaderboard)
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return nil, NewLeaderboardDefinitionNotFoundError(leaderboard)
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return parseLeaderboardDefinition(value)
+}
+
+// ListLeaderboardDefinitions return every leaderboard definition ordered by leaderboard id
+func (r *Redis) ListLeaderboardDefinitions(ctx context.Context) ([]*LeaderboardDefinition, error) {
+	values, err := r.Client.HGetAll(ctx, r.Keys.LeaderboardDefinitions())
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	definitions := make([]*LeaderboardDefinition, 0, len(values))
+	for _, value := range values {
+		definition, err := parseLeaderboardDefinition(value)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].ID < definitions[j].ID
+	})
+
+	return definitions, nil
+}
+
+// RemoveLeaderboardDefinition unregister a leaderboard, its members are kept
+func (r *Redis) RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error {
+	removed, err := r.Client.HDel(ctx, r.Keys.LeaderboardDefinitions(), leaderboard)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if !removed {
+		return NewLeaderboardDefinitionNotFoundError(leaderboard)
+	}
+
+	return nil
+}
+
+// UpdateLeaderboardDefinition replace a leaderboard definition, fail if leaderboard isn't registered
+func (r *Redis) UpdateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	value, err := json.Marshal(definition)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	result, err := r.Client.RunScript(ctx, updateLeaderboardDefinitionScript,
+		[]string{r.Keys.LeaderboardDefinitions()}, definition.ID, string(value))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if updated, _ := result.(int64); updated == 0 {
+		return NewLeaderboardDefinitionNotFoundError(definition.ID)
+	}
+
+	return nil
+}
+
+func parseLeaderboardDefinition(value string) (*LeaderboardDefinition, error) {
+	definition := &LeaderboardDefinition{}
+	err := json.Unmarshal([]byte(value), definition)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return definition, nil
+}
@@ -0,0 +1,777 @@
+package database
+
+import "github.com/topfreegames/podium/leaderboard/v2/database/redis"
+
+// scoreIndexFunctions keep the distinct scores sorted set of a leaderboard, see Keys.LeaderboardScores.
+// The index is either missing or lists every score of the leaderboard: writes keep an existing index,
+// create it with an empty leaderboard and skip leaderboards written before it existed, which are
+// indexed offline by indexScoresScript
+const scoreIndexFunctions = `
+local function open_score_index(leaderboard, scores)
+	if redis.call("EXISTS", leaderboard) == 0 then
+		redis.call("DEL", scores)
+		return true
+	end
+	return redis.call("EXISTS", scores) == 1
+end
+
+local function index_score(leaderboard, scores, previous, current)
+	if previous == current then
+		return
+	end
+	if previous and redis.call("ZCOUNT", leaderboard, previous, previous) == 0 then
+		redis.call("ZREM", scores, previous)
+	end
+	if current then
+		redis.call("ZADD", scores, current, current)
+	end
+end
+
+local function sync_score_index_expiration(leaderboard, scores)
+	local ttl = redis.call("PTTL", leaderboard)
+	if ttl > 0 then
+		redis.call("PEXPIRE", scores, ttl)
+	end
+end
+`
+
+// idempotencyFunctions replay writes made with an idempotency key, see Keys.LeaderboardIdempotency: the
+// entries returned by the first write are kept for the idempotency window and returned again, without
+// writing anything, by later writes with the same key. Kept keys are listed in Keys.LeaderboardIdempotencyKeys
+// so they're removed with their leaderboard
+const idempotencyFunctions = `
+local function replay_write(key)
+	if not key then
+		return nil
+	end
+	local stored = redis.call("GET", key)
+	if stored then
+		return cjson.decode(stored)
+	end
+	return nil
+end
+
+local function remember_write(key, keys, window, entries)
+	if key and tonumber(window) > 0 then
+		redis.call("SET", key, cjson.encode(entries), "PX", window)
+
+		local time = redis.call("TIME")
+		local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+		redis.call("ZREMRANGEBYSCORE", keys, "-inf", now)
+		redis.call("ZADD", keys, now + tonumber(window), key)
+		redis.call("PEXPIRE", keys, window)
+	end
+	return entries
+end
+`
+
+// replayWriteScript reads the entries kept for a write made with an idempotency key
+//
+//	KEYS[1] idempotency key of the write
+//
+// Returns the entries returned by the write, 0 if they aren't kept
+var replayWriteScript = redis.NewScript(idempotencyFunctions + `
+local replayed = replay_write(KEYS[1])
+if replayed then
+	return replayed
+end
+return 0
+`)
+
+// upsertMembersScript checks score rules, writes members score and reads them back in a single atomic call
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard distinct scores sorted set
+//	KEYS[3] leaderboard ttl sorted set
+//	KEYS[4] idempotency key of the write, only read when ARGV[7] is greater than 0
+//	KEYS[5] leaderboard idempotency keys sorted set
+//	KEYS[6] leaderboard submissions hash, members map to "window start,submissions,delta start,base score"
+//	KEYS[7] leaderboard quarantine hash, members map to their quarantine entry json
+//	KEYS[8] expiration set, optional, KEYS[3] is added to it when ARGV[5] is set
+//	KEYS[9] season rollovers sorted set, optional, ARGV[11] is added to it when ARGV[10] is set
+//	ARGV[1] order used to report ranks and pick the best score, asc or desc
+//	ARGV[2] update policy, last, best, worst or sum
+//	ARGV[3] "1" to report members rank before the write
+//	ARGV[4] unix timestamp to expire leaderboard if it has no expiration, empty to skip
+//	ARGV[5] unix timestamp to expire members, empty to skip
+//	ARGV[6] score scale, scores are score*scale plus a tie-breaker when greater than 1
+//	ARGV[7] milliseconds the idempotency key is kept, 0 if the write has none
+//	ARGV[8] lowest score, divided by scale when greater than 1, the sum policy can write, empty to skip
+//	ARGV[9] highest score, divided by scale when greater than 1, the sum policy can write, empty to skip
+//	ARGV[10] unix timestamp the leaderboard season ends to archive it, empty to skip
+//	ARGV[11] leaderboard id
+//	ARGV[12] score rules unit, scores divided by scale when greater than 1 are divided by it before score
+//	rules check them, empty to not check score rules
+//	ARGV[13] lowest score members can end up with, empty to skip
+//	ARGV[14] highest score members can end up with, empty to skip
+//	ARGV[15] highest absolute score the sum policy can add, 0 to skip
+//	ARGV[16] highest change of a member score in its delta window, 0 to skip
+//	ARGV[17] delta window in milliseconds, 0 to not keep base scores
+//	ARGV[18] highest count of submissions of a member in its submission window, 0 to skip
+//	ARGV[19] submission window in milliseconds, 0 to not count submissions
+//	ARGV[20] "1" to hold members that break a score rule in KEYS[7] instead of refusing the write
+//	ARGV[21] current unix time in milliseconds
+//	ARGV[22] submission time of quarantined members, formatted as RFC 3339
+//	ARGV[23...] member, score and precondition triples, preconditions are "absent", "present", the expected
+//	score or empty for none
+//
+// Returns {3, member, rule, value, limit} without writing anything but submissions if a member breaks a
+// score rule and ARGV[20] isn't set, score rules are named like the ScoreRule constants. Then returns
+// {0, member, currentScore} without writing anything if the precondition of a member doesn't hold,
+// currentScore is nil if member is absent, and {2, member, sum} if a sum is out of ARGV[8] and ARGV[9].
+// Otherwise returns one {member, score, rank, previousRank, scoreChanged, ttl} entry per member, ranks are
+// -1 when absent, scoreChanged is 1 when the stored score was written and ttl is ARGV[5]. Quarantined
+// members aren't written, their entry has the submitted score, -1 ranks and the rule they broke as a
+// seventh field
+var upsertMembersScript = redis.NewScript(scoreIndexFunctions + idempotencyFunctions + `
+local idempotency_window = tonumber(ARGV[7])
+if idempotency_window > 0 then
+	local replayed = replay_write(KEYS[4])
+	if replayed then
+		return replayed
+	end
+end
+
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+local policy = ARGV[2]
+local report_previous_rank = ARGV[3] == "1"
+local expire_at = ARGV[4]
+local ttl = ARGV[5]
+local scale = tonumber(ARGV[6])
+
+local rank_command = "ZREVRANK"
+local higher_is_better = true
+if ARGV[1] == "asc" then
+	rank_command = "ZRANK"
+	higher_is_better = false
+end
+
+local writes = {}
+local quarantined = {}
+local unit = tonumber(ARGV[12])
+if not unit then
+	for i = 23, #ARGV, 3 do
+		table.insert(writes, i)
+	end
+else
+	local min_score = tonumber(ARGV[13])
+	local max_score = tonumber(ARGV[14])
+	local max_increment = tonumber(ARGV[15])
+	local max_delta = tonumber(ARGV[16])
+	local delta_window = tonumber(ARGV[17])
+	local max_submissions = tonumber(ARGV[18])
+	local submission_window = tonumber(ARGV[19])
+	local quarantine = ARGV[20] == "1"
+	local now = tonumber(ARGV[21])
+
+	local function rule_score(stored)
+		local score = tonumber(stored)
+		if scale > 1 then
+			score = math.floor(score / scale)
+		end
+		return score / unit
+	end
+
+	local function resulting_score(current, score)
+		if current == nil then
+			return score
+		end
+		if policy == "sum" then
+			return current + score
+		elseif policy ~= "best" and policy ~= "worst" then
+			return score
+		end
+		if (policy == "best") == higher_is_better then
+			return math.max(current, score)
+		end
+		return math.min(current, score)
+	end
+
+	local violation = nil
+	for i = 23, #ARGV, 3 do
+		local member = ARGV[i]
+		local submitted = rule_score(ARGV[i + 1])
+		local stored = redis.call("ZSCORE", leaderboard, member)
+		local current = nil
+		if stored then
+			current = rule_score(stored)
+		else
+			stored = "0"
+		end
+
+		local window_start, count, delta_start, base_score = 0, 0, 0, stored
+		local tracked = redis.call("HGET", KEYS[6], member)
+		if tracked then
+			local fields = {}
+			for field in string.gmatch(tracked, "[^,]+") do
+				table.insert(fields, field)
+			end
+			window_start, count, delta_start, base_score = tonumber(fields[1]), tonumber(fields[2]), tonumber(fields[3]), fields[4]
+		end
+		if submission_window > 0 then
+			if now - window_start >= submission_window then
+				window_start = now
+				count = 0
+			end
+			count = count + 1
+		end
+		if delta_window == 0 or now - delta_start >= delta_window then
+			delta_start = now
+			base_score = stored
+		end
+		if submission_window > 0 or delta_window > 0 then
+			redis.call("HSET", KEYS[6], member, window_start .. "," .. count .. "," .. delta_start .. "," .. base_score)
+		end
+
+		local score = resulting_score(current, submitted)
+		local delta = math.abs(score - rule_score(base_score))
+		local rule, value, limit = nil, 0, 0
+		if min_score and score < min_score then
+			rule, value, limit = "min_score", score, min_score
+		elseif max_score and score > max_score then
+			rule, value, limit = "max_score", score, max_score
+		elseif max_increment > 0 and policy == "sum" and math.abs(submitted) > max_increment then
+			rule, value, limit = "max_increment", math.abs(submitted), max_increment
+		elseif max_delta > 0 and delta > max_delta then
+			rule, value, limit = "max_delta", delta, max_delta
+		elseif max_submissions > 0 and count > max_submissions then
+			rule, value, limit = "max_submissions", count, max_submissions
+		end
+
+		if not rule then
+			table.insert(writes, i)
+		elseif quarantine then
+			redis.call("HSET", KEYS[7], member, '{"score":' .. string.format("%.17g", score) .. ',"rule":"' .. rule ..
+				'","submittedAt":"' .. ARGV[22] .. '"}')
+			quarantined[i] = rule
+		elseif not violation then
+			violation = {3, member, rule, string.format("%.17g", value), string.format("%.17g", limit)}
+		end
+	end
+
+	local window = math.max(submission_window, delta_window)
+	if window > 0 then
+		redis.call("PEXPIRE", KEYS[6], window)
+	end
+	if violation then
+		return violation
+	end
+end
+
+for _, i in ipairs(writes) do
+	local precondition = ARGV[i + 2]
+	if precondition ~= "" then
+		local current = redis.call("ZSCORE", leaderboard, ARGV[i])
+		local holds = current ~= false
+		if precondition == "absent" then
+			holds = current == false
+		elseif precondition ~= "present" and holds then
+			local stored = tonumber(current)
+			if scale > 1 then
+				stored = math.floor(stored / scale)
+			end
+			holds = stored == tonumber(precondition)
+		end
+		if not holds then
+			return {0, ARGV[i], current}
+		end
+	end
+end
+
+local min_sum = tonumber(ARGV[8])
+local max_sum = tonumber(ARGV[9])
+if policy == "sum" and min_sum and max_sum then
+	local sums = {}
+	for _, i in ipairs(writes) do
+		local member = ARGV[i]
+		local increment = tonumber(ARGV[i + 1])
+		if scale > 1 then
+			increment = math.floor(increment / scale)
+		end
+		local sum = sums[member]
+		if sum == nil then
+			sum = 0
+			local current = redis.call("ZSCORE", leaderboard, member)
+			if current ~= false then
+				sum = tonumber(current)
+				if scale > 1 then
+					sum = math.floor(sum / scale)
+				end
+			end
+		end
+		sum = sum + increment
+		if sum < min_sum or sum > max_sum then
+			return {2, member, string.format("%.17g", sum)}
+		end
+		sums[member] = sum
+	end
+end
+
+local indexed = open_score_index(leaderboard, scores)
+
+local previous_ranks = {}
+for _, i in ipairs(writes) do
+	local rank = -1
+	if report_previous_rank then
+		rank = redis.call(rank_command, leaderboard, ARGV[i]) or -1
+	end
+	previous_ranks[i] = rank
+end
+
+local function should_replace(current, score)
+	if policy == "best" then
+		return (higher_is_better and score > current) or (not higher_is_better and score < current)
+	elseif policy == "worst" then
+		return (higher_is_better and score < current) or (not higher_is_better and score > current)
+	end
+	return score ~= current
+end
+
+local changed = {}
+for _, i in ipairs(writes) do
+	local member = ARGV[i]
+	local score = tonumber(ARGV[i + 1])
+	local current = redis.call("ZSCORE", leaderboard, member)
+
+	local member_changed = 0
+	if policy == "sum" and scale > 1 then
+		local increment = math.floor(score / scale)
+		if current == false then
+			redis.call("ZADD", leaderboard, ARGV[i + 1], member)
+			member_changed = 1
+		elseif increment ~= 0 then
+			local stored = (math.floor(tonumber(current) / scale) + increment) * scale + (score - increment * scale)
+			redis.call("ZADD", leaderboard, string.format("%.17g", stored), member)
+			member_changed = 1
+		end
+	elseif policy == "sum" then
+		redis.call("ZINCRBY", leaderboard, ARGV[i + 1], member)
+		if current == false or score ~= 0 then
+			member_changed = 1
+		end
+	elseif current == false or should_replace(tonumber(current), score) then
+		redis.call("ZADD", leaderboard, ARGV[i + 1], member)
+		member_changed = 1
+	end
+	if indexed and member_changed == 1 then
+		index_score(leaderboard, scores, current, redis.call("ZSCORE", leaderboard, member))
+	end
+	changed[i] = member_changed
+end
+
+if expire_at ~= "" and redis.call("TTL", leaderboard) == -1 then
+	redis.call("EXPIREAT", leaderboard, expire_at)
+end
+
+if indexed then
+	sync_score_index_expiration(leaderboard, scores)
+end
+
+if ttl ~= "" then
+	for _, i in ipairs(writes) do
+		redis.call("ZADD", KEYS[3], ttl, ARGV[i])
+	end
+	if KEYS[8] then
+		redis.call("SADD", KEYS[8], KEYS[3])
+	end
+end
+
+if ARGV[10] ~= "" and KEYS[9] then
+	redis.call("ZADD", KEYS[9], ARGV[10], ARGV[11])
+end
+
+local members = {}
+for i = 23, #ARGV, 3 do
+	local member = ARGV[i]
+	if quarantined[i] then
+		table.insert(members, {member, ARGV[i + 1], -1, -1, 0, "", quarantined[i]})
+	else
+		table.insert(members, {
+			member,
+			redis.call("ZSCORE", leaderboard, member),
+			redis.call(rank_command, leaderboard, member),
+			previous_ranks[i],
+			changed[i],
+			ttl,
+		})
+	end
+end
+
+return remember_write(KEYS[4], KEYS[5], ARGV[7], members)
+`)
+
+// writeMembersScript write members score keeping leaderboard distinct scores index up to date
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard distinct scores sorted set
+//	KEYS[3] leaderboard ratings hash, only needed by ZREM to forget removed members rating
+//	ARGV[1] command used to write each member, ZADD, ZINCRBY or ZREM
+//	ARGV[2...] score and member pairs for ZADD and ZINCRBY, members for ZREM
+var writeMembersScript = redis.NewScript(scoreIndexFunctions + `
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+local command = ARGV[1]
+
+local indexed = open_score_index(leaderboard, scores)
+
+local step = 2
+if command == "ZREM" then
+	step = 1
+end
+
+for i = 2, #ARGV, step do
+	local member = ARGV[i + step - 1]
+	local previous = redis.call("ZSCORE", leaderboard, member)
+	if command == "ZREM" then
+		redis.call(command, leaderboard, member)
+		if KEYS[3] then
+			redis.call("HDEL", KEYS[3], member)
+		end
+	else
+		redis.call(command, leaderboard, ARGV[i], member)
+	end
+	if indexed then
+		index_score(leaderboard, scores, previous, redis.call("ZSCORE", leaderboard, member))
+	end
+end
+
+if indexed then
+	sync_score_index_expiration(leaderboard, scores)
+end
+`)
+
+// countAheadScript count how many members or distinct scores are better than each score
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard distinct scores sorted set
+//	ARGV[1] order that defines which scores are better, asc or desc
+//	ARGV[2] "scores" to count distinct scores, anything else counts members
+//	ARGV[3...] scores
+//
+// Returns one count per score, or -1 if distinct scores are counted and the leaderboard has no index
+var countAheadScript = redis.NewScript(scoreIndexFunctions + `
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+
+local key = leaderboard
+if ARGV[2] == "scores" then
+	key = scores
+	if not open_score_index(leaderboard, scores) then
+		return -1
+	end
+end
+
+local counts = {}
+for i = 3, #ARGV do
+	if ARGV[1] == "asc" then
+		table.insert(counts, redis.call("ZCOUNT", key, "-inf", "(" .. ARGV[i]))
+	else
+		table.insert(counts, redis.call("ZCOUNT", key, "(" .. ARGV[i], "+inf"))
+	end
+end
+
+return counts
+`)
+
+// indexScoresScript add the scores of a batch of leaderboard members to its distinct scores index, members
+// are read in score order starting after the highest score of the previous batch
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard distinct scores sorted set
+//	ARGV[1] lowest score of the batch, "-inf" for the first batch or "(" followed by the highest score of the previous one
+//	ARGV[2] batch size
+//
+// Returns the highest score of the batch, or an empty string once every score is indexed
+var indexScoresScript = redis.NewScript(scoreIndexFunctions + `
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+
+local batch = redis.call("ZRANGEBYSCORE", leaderboard, ARGV[1], "+inf", "WITHSCORES", "LIMIT", 0, ARGV[2])
+if #batch == 0 then
+	return ""
+end
+
+for i = 2, #batch, 2 do
+	redis.call("ZADD", scores, batch[i], batch[i])
+end
+sync_score_index_expiration(leaderboard, scores)
+
+return batch[#batch]
+`)
+
+// updateLeaderboardDefinitionScript replaces a leaderboard definition only if it is registered
+//
+//	KEYS[1] leaderboard definitions hash
+//	ARGV[1] leaderboard id
+//	ARGV[2] leaderboard definition
+//
+// Returns 1 if definition was replaced and 0 if leaderboard isn't registered
+var updateLeaderboardDefinitionScript = redis.NewScript(`
+if redis.call("HEXISTS", KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+return 1
+`)
+
+// archiveLeaderboardScript copies a leaderboard to its archive unless it was already archived
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard archive sorted set
+//
+// Returns how many members the archive has
+var archiveLeaderboardScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 0 and redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("ZUNIONSTORE", KEYS[2], 1, KEYS[1])
+end
+return redis.call("ZCARD", KEYS[2])
+`)
+
+// assignLeagueBucketsScript assign members that aren't in a league bucket to the open one, buckets are filled
+// in order so bucket n has members assigned from n*size to (n+1)*size-1
+//
+//	KEYS[1] league buckets sorted set, scored by bucket
+//	ARGV[1] bucket size
+//	ARGV[2] unix timestamp to expire league buckets if they have no expiration, empty to skip
+//	ARGV[3...] members
+//
+// Returns {assigned, bucket...}, one bucket per member and assigned is 1 when any member was assigned
+var assignLeagueBucketsScript = redis.NewScript(`
+local bucketSize = tonumber(ARGV[1])
+local assigned = 0
+local buckets = {}
+for i = 3, #ARGV do
+	local bucket = redis.call("ZSCORE", KEYS[1], ARGV[i])
+	if not bucket then
+		bucket = math.floor(redis.call("ZCARD", KEYS[1]) / bucketSize)
+		redis.call("ZADD", KEYS[1], bucket, ARGV[i])
+		assigned = 1
+	end
+	table.insert(buckets, tonumber(bucket))
+end
+if assigned == 1 and ARGV[2] ~= "" and redis.call("TTL", KEYS[1]) == -1 then
+	redis.call("EXPIREAT", KEYS[1], ARGV[2])
+end
+table.insert(buckets, 1, assigned)
+return buckets
+`)
+
+// writeRatingsScript writes members rating and score in a single atomic call, nothing is written if any
+// stored rating changed since it was read
+//
+//	KEYS[1] leaderboard sorted set
+//	KEYS[2] leaderboard distinct scores sorted set
+//	KEYS[3] leaderboard ratings hash
+//	KEYS[4] idempotency key of the write, optional
+//	KEYS[5] leaderboard idempotency keys sorted set, needed when KEYS[4] is given
+//	ARGV[1] order used to report ranks, asc or desc
+//	ARGV[2] unix timestamp to expire leaderboard if it has no expiration, empty to skip
+//	ARGV[3] milliseconds the idempotency key is kept
+//	ARGV[4...] member, matches of the stored rating, new rating and score quadruples
+//
+// Returns 0 if any stored rating changed, otherwise one {member, score, rank, previousRank, scoreChanged,
+// previousRating, rating} entry per member like upsertMembersScript, previousRating is empty if member
+// was never rated
+var writeRatingsScript = redis.NewScript(scoreIndexFunctions + idempotencyFunctions + `
+local replayed = replay_write(KEYS[4])
+if replayed then
+	return replayed
+end
+
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+local ratings = KEYS[3]
+local expire_at = ARGV[2]
+
+local rank_command = "ZREVRANK"
+if ARGV[1] == "asc" then
+	rank_command = "ZRANK"
+end
+
+local previous_ratings = {}
+for i = 4, #ARGV, 4 do
+	local stored = redis.call("HGET", ratings, ARGV[i])
+	local matches = 0
+	if stored then
+		matches = cjson.decode(stored).matches or 0
+	end
+	if matches ~= tonumber(ARGV[i + 1]) then
+		return 0
+	end
+	table.insert(previous_ratings, stored or "")
+end
+
+local indexed = open_score_index(leaderboard, scores)
+
+local previous_ranks = {}
+for i = 4, #ARGV, 4 do
+	table.insert(previous_ranks, redis.call(rank_command, leaderboard, ARGV[i]) or -1)
+end
+
+local changed = {}
+for i = 4, #ARGV, 4 do
+	local member = ARGV[i]
+	local current = redis.call("ZSCORE", leaderboard, member)
+	local member_changed = 0
+	if current == false or tonumber(current) ~= tonumber(ARGV[i + 3]) then
+		redis.call("ZADD", leaderboard, ARGV[i + 3], member)
+		member_changed = 1
+		if indexed then
+			index_score(leaderboard, scores, current, redis.call("ZSCORE", leaderboard, member))
+		end
+	end
+	redis.call("HSET", ratings, member, ARGV[i + 2])
+	table.insert(changed, member_changed)
+end
+
+if expire_at ~= "" and redis.call("TTL", leaderboard) == -1 then
+	redis.call("EXPIREAT", leaderboard, expire_at)
+end
+
+local ttl = redis.call("PTTL", leaderboard)
+if ttl > 0 then
+	redis.call("PEXPIRE", ratings, ttl)
+end
+
+if indexed then
+	sync_score_index_expiration(leaderboard, scores)
+end
+
+local members = {}
+for i = 4, #ARGV, 4 do
+	local member = ARGV[i]
+	table.insert(members, {
+		member,
+		redis.call("ZSCORE", leaderboard, member),
+		redis.call(rank_command, leaderboard, member),
+		previous_ranks[#members + 1],
+		changed[#members + 1],
+		previous_ratings[#members + 1],
+		ARGV[i + 2],
+	})
+end
+return remember_write(KEYS[4], KEYS[5], ARGV[3], members)
+`)
+
+// reportChallengeScript moves a challenger that beat a defender ranked above it to the defender position and
+// shifts everyone in between one position down, in a single atomic call
+//
+//	KEYS[1] leaderboard sorted set, scores are positions
+//	KEYS[2] leaderboard distinct scores sorted set
+//	KEYS[3] idempotency key of the report, optional
+//	KEYS[4] leaderboard idempotency keys sorted set, needed when KEYS[3] is given
+//	ARGV[1] challenger
+//	ARGV[2] defender
+//	ARGV[3] "1" if the challenger won
+//	ARGV[4] how many ranks above the challenger the defender can be, 0 for any
+//	ARGV[5] unix timestamp to expire leaderboard if it has no expiration, empty to skip
+//	ARGV[6] milliseconds the idempotency key is kept
+//
+// Returns 0 if the defender isn't ranked above the challenger within the max distance, otherwise one
+// {member, score, rank, previousRank, scoreChanged} entry for the challenger and the defender like
+// upsertMembersScript, ranked in ascending position order
+var reportChallengeScript = redis.NewScript(scoreIndexFunctions + idempotencyFunctions + `
+local replayed = replay_write(KEYS[3])
+if replayed then
+	return replayed
+end
+
+local leaderboard = KEYS[1]
+local scores = KEYS[2]
+local challenger = ARGV[1]
+local defender = ARGV[2]
+local challenger_won = ARGV[3] == "1"
+local max_distance = tonumber(ARGV[4])
+local expire_at = ARGV[5]
+
+local previous_defender_rank = redis.call("ZRANK", leaderboard, defender) or -1
+local previous_challenger_rank = redis.call("ZRANK", leaderboard, challenger) or -1
+local previous_positions = {
+	[defender] = redis.call("ZSCORE", leaderboard, defender),
+	[challenger] = redis.call("ZSCORE", leaderboard, challenger),
+}
+
+local next_rank = redis.call("ZCARD", leaderboard)
+local defender_rank = previous_defender_rank
+if defender_rank < 0 then
+	defender_rank = next_rank
+	next_rank = next_rank + 1
+end
+local challenger_rank = previous_challenger_rank
+if challenger_rank < 0 then
+	challenger_rank = next_rank
+end
+
+if challenger_rank <= defender_rank or (max_distance > 0 and challenger_rank - defender_rank > max_distance) then
+	return 0
+end
+
+local indexed = open_score_index(leaderboard, scores)
+
+local function move(member, position)
+	local previous = redis.call("ZSCORE", leaderboard, member)
+	redis.call("ZADD", leaderboard, position, member)
+	if indexed then
+		index_score(leaderboard, scores, previous, redis.call("ZSCORE", leaderboard, member))
+	end
+end
+
+local function join(member)
+	local last = redis.call("ZRANGE", leaderboard, -1, -1, "WITHSCORES")
+	local position = 1
+	if #last > 0 then
+		position = tonumber(last[2]) + 1
+	end
+	move(member, position)
+end
+
+if previous_defender_rank < 0 then
+	join(defender)
+end
+if previous_challenger_rank < 0 then
+	join(challenger)
+end
+
+if challenger_won then
+	local defender_position = redis.call("ZSCORE", leaderboard, defender)
+	local challenger_position = redis.call("ZSCORE", leaderboard, challenger)
+	local shifted = redis.call("ZRANGEBYSCORE", leaderboard, defender_position, "(" .. challenger_position)
+	move(challenger, defender_position)
+	for i = #shifted, 1, -1 do
+		move(shifted[i], tonumber(redis.call("ZSCORE", leaderboard, shifted[i])) + 1)
+	end
+end
+
+if expire_at ~= "" and redis.call("TTL", leaderboard) == -1 then
+	redis.call("EXPIREAT", leaderboard, expire_at)
+end
+
+if indexed then
+	sync_score_index_expiration(leaderboard, scores)
+end
+
+local function entry(member, previous_rank)
+	local position = redis.call("ZSCORE", leaderboard, member)
+	local changed = 0
+	if position ~= previous_positions[member] then
+		changed = 1
+	end
+	return {member, position, redis.call("ZRANK", leaderboard, member), previous_rank, changed}
+end
+
+return remember_write(KEYS[3], KEYS[4], ARGV[6], {entry(challenger, previous_challenger_rank), entry(defender, previous_defender_rank)})
+`)
+
+// claimNonceScript remembers a nonce until it expires
+//
+//	KEYS[1] nonce key
+//	ARGV[1] ttl in milliseconds
+//
+// Returns 1 if the nonce was claimed and 0 if it was already remembered
+var claimNonceScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], "1", "NX", "PX", ARGV[1]) then
+	return 1
+end
+return 0
+`)
@@ -0,0 +1,40 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+var _ Submissions = &Redis{}
+
+// GetQuarantinedMembers return the score writes held for review, oldest first
+func (r *Redis) GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*QuarantineEntry, error) {
+	values, err := r.Client.HGetAll(ctx, r.Keys.LeaderboardQuarantine(leaderboard))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	entries := make([]*QuarantineEntry, 0, len(values))
+	for member, value := range values {
+		entry := &QuarantineEntry{}
+		err = json.Unmarshal([]byte(value), entry)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		entry.Member = member
+		entries = append(entries, entry)
+	}
+	sortQuarantineEntries(entries)
+
+	return entries, nil
+}
+
+func sortQuarantineEntries(entries []*QuarantineEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].SubmittedAt.Equal(entries[j].SubmittedAt) {
+			return entries[i].SubmittedAt.Before(entries[j].SubmittedAt)
+		}
+		return entries[i].Member < entries[j].Member
+	})
+}
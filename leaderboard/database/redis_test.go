@@ -18,6 +18,7 @@ var _ = Describe("Redis Database", func() {
 	var redisDatabase database.Database
 	var leaderboard string = "leaderboardTest"
 	var leaderboardTTL string = "leaderboardTest:ttl"
+	var leaderboardScores string = "leaderboardTest:scores"
 	var member string = "memberTest"
 	var score float64 = 1.0
 
@@ -25,7 +26,7 @@ var _ = Describe("Redis Database", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = redis.NewMockRedis(ctrl)
 
-		redisDatabase = &database.Redis{mock}
+		redisDatabase = &database.Redis{Client: mock}
 	})
 
 	AfterEach(func() {
@@ -64,6 +65,10 @@ var _ = Describe("Redis Database", func() {
 
 	Describe("GetMembers", func() {
 		var members = []string{"member1", "member2"}
+
+		scorePtr := func(score float64) *float64 { return &score }
+		rankPtr := func(rank int64) *int64 { return &rank }
+
 		Describe("When order is asc", func() {
 			var order = "asc"
 
@@ -86,45 +91,9 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(1), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member1")).Return(float64(10000), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member2")).Return(float64(0), redis.NewMemberNotFoundError(leaderboard, "member2"))
-
-					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
-					Expect(err).NotTo(HaveOccurred())
-
-					Expect(members).To(Equal(expectedMembers))
-				})
-
-				It("Should return member list with TTL equals zero if redis return ok", func() {
-					expectedMembers := []*database.Member{
-						{
-							Member: "member1",
-							Score:  float64(1),
-							Rank:   int64(0),
-							TTL:    time.Unix(10000, 0),
-						},
-						{
-							Member: "member2",
-							Score:  float64(2),
-							Rank:   int64(1),
-							TTL:    time.Time{},
-						},
-					}
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(1), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member1")).Return(float64(10000), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member2")).Return(float64(0), redis.NewMemberNotFoundError(leaderboard, "member2"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(1), scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{rankPtr(0), rankPtr(1)}, nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboardTTL), "member1", "member2").Return([]*float64{scorePtr(10000), nil}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
@@ -143,22 +112,27 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), redis.NewMemberNotFoundError(leaderboard, "member1"))
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member2")).Return(float64(10000), nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{nil, scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{nil, rankPtr(1)}, nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboardTTL), "member1", "member2").Return([]*float64{nil, scorePtr(10000)}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(members).To(Equal(expectedMembers))
-
 				})
 
 				It("Should return General Error if redis return in error", func() {
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), fmt.Errorf("General error"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(nil, fmt.Errorf("General error"))
+
+					_, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
+					Expect(err).To(Equal(database.NewGeneralError("General error")))
+				})
+
+				It("Should return General Error if redis return in error while reading TTL", func() {
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(1), scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{rankPtr(0), rankPtr(1)}, nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboardTTL), "member1", "member2").Return(nil, fmt.Errorf("General error"))
 
 					_, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).To(Equal(database.NewGeneralError("General error")))
@@ -184,39 +158,8 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(1), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
-					Expect(err).NotTo(HaveOccurred())
-
-					Expect(members).To(Equal(expectedMembers))
-				})
-
-				It("Should return member list with empty TTL if redis return ok", func() {
-					expectedMembers := []*database.Member{
-						{
-							Member: "member1",
-							Score:  float64(1),
-							Rank:   int64(0),
-							TTL:    time.Time{},
-						},
-						{
-							Member: "member2",
-							Score:  float64(2),
-							Rank:   int64(1),
-							TTL:    time.Time{},
-						},
-					}
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(1), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(1), scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{rankPtr(0), rankPtr(1)}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
@@ -224,31 +167,29 @@ var _ = Describe("Redis Database", func() {
 					Expect(members).To(Equal(expectedMembers))
 				})
 
-				It("Should return nil member if it doesnt exists", func() {
+				It("Should return nil member if it is removed between score and rank reads", func() {
 					expectedMembers := []*database.Member{
 						nil,
 						{
 							Member: "member2",
 							Score:  float64(2),
-							Rank:   int64(1),
+							Rank:   int64(0),
 							TTL:    time.Time{},
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), redis.NewMemberNotFoundError(leaderboard, "member1"))
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(1), scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{nil, rankPtr(0)}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(members).To(Equal(expectedMembers))
-
 				})
 
 				It("Should return General Error if redis return in error", func() {
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), fmt.Errorf("General error"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(1), scorePtr(2)}, nil)
+					mock.EXPECT().ZRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(nil, fmt.Errorf("General error"))
 
 					_, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).To(Equal(database.NewGeneralError("General error")))
@@ -278,14 +219,9 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(2), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(1), nil)
-
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member1")).Return(float64(10000), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member2")).Return(float64(0), redis.NewMemberNotFoundError(leaderboard, "member2"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(2), scorePtr(1)}, nil)
+					mock.EXPECT().ZRevRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{rankPtr(0), rankPtr(1)}, nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboardTTL), "member1", "member2").Return([]*float64{scorePtr(10000), nil}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
@@ -304,22 +240,18 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), redis.NewMemberNotFoundError(leaderboard, "member1"))
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq("member2")).Return(float64(10000), nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{nil, scorePtr(2)}, nil)
+					mock.EXPECT().ZRevRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{nil, rankPtr(1)}, nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboardTTL), "member1", "member2").Return([]*float64{nil, scorePtr(10000)}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(members).To(Equal(expectedMembers))
-
 				})
 
 				It("Should return General Error if redis return in error", func() {
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), fmt.Errorf("General error"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(nil, fmt.Errorf("General error"))
 
 					_, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).To(Equal(database.NewGeneralError("General error")))
@@ -345,11 +277,8 @@ var _ = Describe("Redis Database", func() {
 						},
 					}
 
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(2), nil)
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(1), nil)
-
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(int64(0), nil)
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(2), scorePtr(1)}, nil)
+					mock.EXPECT().ZRevRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*int64{rankPtr(0), rankPtr(1)}, nil)
 
 					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).NotTo(HaveOccurred())
@@ -357,31 +286,9 @@ var _ = Describe("Redis Database", func() {
 					Expect(members).To(Equal(expectedMembers))
 				})
 
-				It("Should return nil member if it doesnt exists", func() {
-					expectedMembers := []*database.Member{
-						nil,
-						{
-							Member: "member2",
-							Score:  float64(2),
-							Rank:   int64(1),
-							TTL:    time.Time{},
-						},
-					}
-
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), redis.NewMemberNotFoundError(leaderboard, "member1"))
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(float64(2), nil)
-
-					mock.EXPECT().ZRevRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(int64(1), nil)
-
-					members, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
-					Expect(err).NotTo(HaveOccurred())
-
-					Expect(members).To(Equal(expectedMembers))
-
-				})
-
 				It("Should return General Error if redis return in error", func() {
-					mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(float64(-1), fmt.Errorf("General error"))
+					mock.EXPECT().ZScores(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return([]*float64{scorePtr(2), scorePtr(1)}, nil)
+					mock.EXPECT().ZRevRanks(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(nil, fmt.Errorf("General error"))
 
 					_, err := redisDatabase.GetMembers(context.Background(), leaderboard, order, includeTTL, members...)
 					Expect(err).To(Equal(database.NewGeneralError("General error")))
@@ -633,14 +540,14 @@ var _ = Describe("Redis Database", func() {
 
 	Describe("RemoveMembers", func() {
 		It("Should return nil if no error occur", func() {
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq("member2")).Return(nil)
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboardScores, leaderboard + ":ratings"}), "ZREM", member, "member2").Return(nil, nil)
 
 			err := redisDatabase.RemoveMembers(context.Background(), leaderboard, member, "member2")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("Should return error if an error happened", func() {
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq("member2")).Return(redis.NewGeneralError("New redis error"))
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboardScores, leaderboard + ":ratings"}), "ZREM", member, "member2").Return(nil, redis.NewGeneralError("New redis error"))
 
 			err := redisDatabase.RemoveMembers(context.Background(), leaderboard, member, "member2")
 			Expect(err).To(Equal(database.NewGeneralError(redis.NewGeneralError("New redis error").Error())))
@@ -650,6 +557,14 @@ var _ = Describe("Redis Database", func() {
 	Describe("RemoveLeaderboard", func() {
 		It("Should return nil if no error happended", func() {
 			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboardScores)).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard+":ratings")).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard+":submissions")).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard+":quarantine")).Return(nil)
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Eq(leaderboard+":idempotency"), gomock.Eq(int64(0)), gomock.Eq(int64(-1))).
+				Return([]*redis.Member{{Member: leaderboard + ":idempotency:retry-1", Score: 1700000000000}}, nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard+":idempotency:retry-1")).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard+":idempotency")).Return(nil)
 
 			err := redisDatabase.RemoveLeaderboard(context.Background(), leaderboard)
 			Expect(err).NotTo(HaveOccurred())
@@ -667,6 +582,7 @@ var _ = Describe("Redis Database", func() {
 		It("Should return nil if all is ok", func() {
 			expireTime := time.Unix(123456, 0)
 			mock.EXPECT().ExpireAt(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(expireTime)).Return(nil)
+			mock.EXPECT().ExpireAt(gomock.Any(), gomock.Eq(leaderboardScores), gomock.Eq(expireTime)).Return(nil)
 
 			err := redisDatabase.SetLeaderboardExpiration(context.Background(), leaderboard, expireTime)
 			Expect(err).NotTo(HaveOccurred())
@@ -682,17 +598,6 @@ var _ = Describe("Redis Database", func() {
 	})
 
 	Describe("SetMembersScore", func() {
-		redisMembers := []*redis.Member{
-			{
-				Member: member,
-				Score:  score,
-			},
-			{
-				Member: "member2",
-				Score:  2.0,
-			},
-		}
-
 		databaseMembers := []*database.Member{
 			{
 				Member: member,
@@ -704,14 +609,14 @@ var _ = Describe("Redis Database", func() {
 			},
 		}
 		It("Should return nil if all is ok", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(nil)
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboardScores}), "ZADD", score, member, 2.0, "member2").Return(nil, nil)
 
 			err := redisDatabase.SetMembers(context.Background(), leaderboard, databaseMembers)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("Should return GeneralError if redis return in error", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(fmt.Errorf("New redis error"))
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, leaderboardScores}), "ZADD", score, member, 2.0, "member2").Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisDatabase.SetMembers(context.Background(), leaderboard, databaseMembers)
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
@@ -758,7 +663,8 @@ var _ = Describe("Redis Database", func() {
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
 		})
 
-		It("Should return GeneralError if redis SAdd return in error", func() {
+		It("Should return GeneralError if redis SAdd return in error on clusters", func() {
+			redisDatabase = &database.Redis{Client: mock, Cluster: true}
 			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(nil)
 			mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq(leaderboardTTL)).Return(fmt.Errorf("New redis error"))
 
@@ -766,4 +672,215 @@ var _ = Describe("Redis Database", func() {
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
 		})
 	})
+
+	Describe("UpsertMembers", func() {
+		memberTTL := time.Unix(2000000000, 0)
+		expireAt := time.Unix(1900000000, 0)
+
+		databaseMembers := []*database.Member{
+			{
+				Member: member,
+				Score:  score,
+			},
+			{
+				Member: "member2",
+				Score:  2.0,
+			},
+		}
+
+		upsertKeys := []string{
+			leaderboard, leaderboardScores, leaderboardTTL, leaderboard + ":idempotency:", leaderboard + ":idempotency",
+			leaderboard + ":submissions", leaderboard + ":quarantine", database.ExpirationSet, database.SeasonRollovers,
+		}
+
+		scriptResult := []interface{}{
+			[]interface{}{member, "1", int64(1), int64(-1), int64(1)},
+			[]interface{}{"member2", "2", int64(0), int64(0), int64(0)},
+		}
+
+		It("Should return upserted members if all is ok", func() {
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys),
+				"desc", "last", "1", "", "", 1.0, int64(0), "", "", "", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "", "member2", 2.0, "",
+			).Return(scriptResult, nil)
+
+			members, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order:        "desc",
+				PreviousRank: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(members).To(Equal([]*database.Member{
+				{Member: member, Score: 1, Rank: 1, PreviousRank: -1, ScoreChanged: true},
+				{Member: "member2", Score: 2, Rank: 0, PreviousRank: 0, ScoreChanged: false},
+			}))
+		})
+
+		It("Should send members TTL and return it", func() {
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys),
+				"asc", "sum", "0", "1900000000", "2000000000", 1.0, int64(0), "", "", "", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "", "member2", 2.0, "",
+			).Return([]interface{}{
+				[]interface{}{member, "1", int64(1), int64(-1), int64(1), "2000000000"},
+				[]interface{}{"member2", "2", int64(0), int64(0), int64(0), "2000000000"},
+			}, nil)
+
+			members, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order:        "asc",
+				UpdatePolicy: database.UpdatePolicySum,
+				TTL:          memberTTL,
+				ExpireAt:     expireAt,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(members[0].TTL).To(Equal(memberTTL))
+			Expect(members[1].TTL).To(Equal(memberTTL))
+		})
+
+		It("Should use hash tagged keys if key schema is V2", func() {
+			redisDatabase = &database.Redis{Client: mock, Keys: database.Keys{Version: database.KeySchemaV2}}
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{
+					"{leaderboardTest}", "{leaderboardTest}:scores", "{leaderboardTest}:ttl", "{leaderboardTest}:idempotency:",
+					"{leaderboardTest}:idempotency", "{leaderboardTest}:submissions", "{leaderboardTest}:quarantine",
+					database.ExpirationSet, database.SeasonRollovers,
+				}),
+				"desc", "last", "0", "", "2000000000", 1.0, int64(0), "", "", "", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "", "member2", 2.0, "",
+			).Return(scriptResult, nil)
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order: "desc",
+				TTL:   memberTTL,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should register TTL and season end outside the script on clusters", func() {
+			redisDatabase = &database.Redis{Client: mock, Cluster: true}
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys[:7]),
+				"desc", "last", "0", "", "2000000000", 1.0, int64(0), "", "", "2100000000", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "", "member2", 2.0, "",
+			).Return(scriptResult, nil)
+			mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq(leaderboardTTL)).Return(nil)
+			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(database.SeasonRollovers), gomock.Eq(&redis.Member{Member: leaderboard, Score: 2100000000})).Return(nil)
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order:     "desc",
+				TTL:       memberTTL,
+				SeasonEnd: time.Unix(2100000000, 0),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should send preconditions and return PreconditionFailedError if one doesn't hold", func() {
+			expectedScore := 420.0
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys),
+				"desc", "last", "0", "", "", 1.0, int64(0), "", "", "", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "420", "member2", 2.0, "absent",
+			).Return([]interface{}{int64(0), "member2", "2"}, nil)
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order: "desc",
+				Preconditions: map[string]*database.Precondition{
+					member:    {ExpectedScore: &expectedScore},
+					"member2": {Absent: true},
+				},
+			})
+			currentScore := 2.0
+			Expect(err).To(Equal(database.NewPreconditionFailedError(leaderboard, "member2", &currentScore)))
+		})
+
+		It("Should send the sum range and return SumOutOfRangeError if a sum is out of it", func() {
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys),
+				"desc", "sum", "0", "", "", 1.0, int64(0), "-100", "100", "", leaderboard,
+				"", "", "", "", "", "", "", "", "", "", "",
+				member, score, "", "member2", 2.0, "",
+			).Return([]interface{}{int64(2), "member2", "101"}, nil)
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order:        "desc",
+				UpdatePolicy: database.UpdatePolicySum,
+				SumRange:     &database.ScoreRange{Min: -100, Max: 100},
+			})
+			Expect(err).To(Equal(database.NewSumOutOfRangeError(leaderboard, "member2", 101)))
+		})
+
+		It("Should send score rules and return ScoreRuleViolationError if a member breaks one", func() {
+			maxScore := 100.0
+			mock.EXPECT().RunScript(
+				gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys),
+				"desc", "last", "0", "", "", 1.0, int64(0), "", "", "", leaderboard,
+				"100", "", "100", "0", "10", int64(3600000), 5, int64(60000), "0", int64(1600000000000), "2020-09-13T12:26:40Z",
+				member, score, "", "member2", 2.0, "",
+			).Return([]interface{}{int64(3), "member2", "max_delta", "12.5", "10"}, nil)
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order: "desc",
+				ScoreRules: &database.ScoreRules{
+					MaxScore:         &maxScore,
+					MaxDelta:         10,
+					DeltaWindow:      time.Hour,
+					MaxSubmissions:   5,
+					SubmissionWindow: time.Minute,
+					ScoreUnit:        100,
+					Now:              time.Unix(1600000000, 0),
+				},
+			})
+			Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboard, "member2", "max_delta", 12.5, 10)))
+		})
+
+		It("Should return members held for review with the rule they broke", func() {
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Eq(upsertKeys), gomock.Any()).Return([]interface{}{
+				[]interface{}{member, "1", int64(0), int64(-1), int64(1), ""},
+				[]interface{}{"member2", "2", int64(-1), int64(-1), int64(0), "", "max_score"},
+			}, nil)
+
+			members, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{
+				Order:      "desc",
+				ScoreRules: &database.ScoreRules{Quarantine: true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*database.Member{
+				{Member: member, Score: 1, Rank: 0, PreviousRank: -1, ScoreChanged: true},
+				{Member: "member2", Score: 2, Rank: -1, PreviousRank: -1, QuarantineRule: "max_score"},
+			}))
+		})
+
+		It("Should return InvalidOrderError if order is invalid", func() {
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{Order: "invalid"})
+			Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+		})
+
+		It("Should return InvalidUpdatePolicyError if update policy is invalid", func() {
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{Order: "desc", UpdatePolicy: "invalid"})
+			Expect(err).To(Equal(database.NewInvalidUpdatePolicyError("invalid")))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("New redis error"))
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{Order: "desc"})
+			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
+		})
+
+		It("Should return GeneralError if redis SAdd return in error on clusters", func() {
+			redisDatabase = &database.Redis{Client: mock, Cluster: true}
+			mock.EXPECT().RunScript(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(scriptResult, nil)
+			mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq(leaderboardTTL)).Return(fmt.Errorf("New redis error"))
+
+			_, err := redisDatabase.UpsertMembers(context.Background(), leaderboard, databaseMembers, &database.UpsertOptions{Order: "desc", TTL: memberTTL})
+			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
+		})
+	})
 })
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ Tiers = &Redis{}
+
+// RecordTierMovements add each member movement to its tier history, replacing any movement already recorded
+// for the same leaderboard so jobs can be retried, and keep the last TierHistoryLength seasons
+func (r *Redis) RecordTierMovements(ctx context.Context, competition string, movements map[string]*TierMovement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	err := r.Client.SAdd(ctx, r.Keys.TieredLeagues(), competition)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	for member, movement := range movements {
+		history, err := r.GetTierHistory(ctx, competition, member)
+		if err != nil {
+			return err
+		}
+
+		updated := make([]*TierMovement, 0, len(history)+1)
+		for _, recorded := range history {
+			if recorded.Leaderboard != movement.Leaderboard {
+				updated = append(updated, recorded)
+			}
+		}
+		updated = append(updated, movement)
+		sort.SliceStable(updated, func(i, j int) bool { return updated[i].SeasonEnd.Before(updated[j].SeasonEnd) })
+		if len(updated) > TierHistoryLength {
+			updated = updated[len(updated)-TierHistoryLength:]
+		}
+
+		value, err := json.Marshal(updated)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		err = r.Client.HSet(ctx, r.Keys.TierHistory(competition), member, string(value))
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetTierHistory return member tier movements from the oldest season to the latest, empty if member never
+// finished a season
+func (r *Redis) GetTierHistory(ctx context.Context, competition, member string) ([]*TierMovement, error) {
+	value, err := r.Client.HGet(ctx, r.Keys.TierHistory(competition), member)
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return []*TierMovement{}, nil
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	history := []*TierMovement{}
+	err = json.Unmarshal([]byte(value), &history)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return history, nil
+}
+
+// GetTierMovementJobs return up to amount archived leaderboards whose tier movements must be recorded until maxTime
+func (r *Redis) GetTierMovementJobs(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	return r.getScheduledJobs(ctx, r.Keys.TierMovementJobs(), maxTime, amount)
+}
+
+// CompleteTierMovementJob remove leaderboard from the tier movement jobs and forget its failed attempts
+func (r *Redis) CompleteTierMovementJob(ctx context.Context, leaderboard string) error {
+	return r.completeScheduledJob(ctx, r.Keys.TierMovementJobs(), r.Keys.TierMovementAttempts(), leaderboard)
+}
+
+// RetryTierMovementJob count a failed tier movement job and return how many attempts failed, like RetryRewardDelivery
+func (r *Redis) RetryTierMovementJob(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error) {
+	return r.retryScheduledJob(ctx, r.Keys.TierMovementJobs(), r.Keys.TierMovementAttempts(), leaderboard, maxAttempts, retryInterval)
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Registry interface standardize leaderboard definitions database calls
+type Registry interface {
+	CreateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error
+	GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*LeaderboardDefinition, error)
+	ListLeaderboardDefinitions(ctx context.Context) ([]*LeaderboardDefinition, error)
+	RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error
+	UpdateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error
+}
+
+// LeaderboardDefinition is how a registered leaderboard behaves when requests don't say it,
+// zero values mean the implicit behaviour of unregistered leaderboards
+type LeaderboardDefinition struct {
+	ID              string        `json:"id"`
+	DisplayName     string        `json:"displayName,omitempty"`
+	Order           string        `json:"order,omitempty"`
+	UpdatePolicy    string        `json:"updatePolicy,omitempty"`
+	DefaultPageSize int           `json:"defaultPageSize,omitempty"`
+	MaxPageSize     int           `json:"maxPageSize,omitempty"`
+	StartTime       time.Time     `json:"startTime"`
+	EndTime         time.Time     `json:"endTime"`
+	Retention       time.Duration `json:"retention,omitempty"`
+	CreatedAt       time.Time     `json:"createdAt"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
+}
@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/registry.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRegistry is a mock of Registry interface.
+type MockRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryMockRecorder
+}
+
+// MockRegistryMockRecorder is the mock recorder for MockRegistry.
+type MockRegistryMockRecorder struct {
+	mock *MockRegistry
+}
+
+// NewMockRegistry creates a new mock instance.
+func NewMockRegistry(ctrl *gomock.Controller) *MockRegistry {
+	mock := &MockRegistry{ctrl: ctrl}
+	mock.recorder = &MockRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
+	return m.recorder
+}
+
+// CreateLeaderboardDefinition mocks base method.
+func (m *MockRegistry) CreateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaderboardDefinition", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLeaderboardDefinition indicates an expected call of CreateLeaderboardDefinition.
+func (mr *MockRegistryMockRecorder) CreateLeaderboardDefinition(ctx, definition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaderboardDefinition", reflect.TypeOf((*MockRegistry)(nil).CreateLeaderboardDefinition), ctx, definition)
+}
+
+// GetLeaderboardDefinition mocks base method.
+func (m *MockRegistry) GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*LeaderboardDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardDefinition", ctx, leaderboard)
+	ret0, _ := ret[0].(*LeaderboardDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardDefinition indicates an expected call of GetLeaderboardDefinition.
+func (mr *MockRegistryMockRecorder) GetLeaderboardDefinition(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardDefinition", reflect.TypeOf((*MockRegistry)(nil).GetLeaderboardDefinition), ctx, leaderboard)
+}
+
+// ListLeaderboardDefinitions mocks base method.
+func (m *MockRegistry) ListLeaderboardDefinitions(ctx context.Context) ([]*LeaderboardDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaderboardDefinitions", ctx)
+	ret0, _ := ret[0].([]*LeaderboardDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaderboardDefinitions indicates an expected call of ListLeaderboardDefinitions.
+func (mr *MockRegistryMockRecorder) ListLeaderboardDefinitions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaderboardDefinitions", reflect.TypeOf((*MockRegistry)(nil).ListLeaderboardDefinitions), ctx)
+}
+
+// RemoveLeaderboardDefinition mocks base method.
+func (m *MockRegistry) RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLeaderboardDefinition", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLeaderboardDefinition indicates an expected call of RemoveLeaderboardDefinition.
+func (mr *MockRegistryMockRecorder) RemoveLeaderboardDefinition(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLeaderboardDefinition", reflect.TypeOf((*MockRegistry)(nil).RemoveLeaderboardDefinition), ctx, leaderboard)
+}
+
+// UpdateLeaderboardDefinition mocks base method.
+func (m *MockRegistry) UpdateLeaderboardDefinition(ctx context.Context, definition *LeaderboardDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLeaderboardDefinition", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLeaderboardDefinition indicates an expected call of UpdateLeaderboardDefinition.
+func (mr *MockRegistryMockRecorder) UpdateLeaderboardDefinition(ctx, definition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLeaderboardDefinition", reflect.TypeOf((*MockRegistry)(nil).UpdateLeaderboardDefinition), ctx, definition)
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Score rules UpsertMembers check, see ScoreRules
+const (
+	// ScoreRuleMinScore is broken by scores that would leave a member below the minimum score
+	ScoreRuleMinScore = "min_score"
+	// ScoreRuleMaxScore is broken by scores that would leave a member above the maximum score
+	ScoreRuleMaxScore = "max_score"
+	// ScoreRuleMaxIncrement is broken by scores summed with UpdatePolicySum larger than the maximum increment
+	ScoreRuleMaxIncrement = "max_increment"
+	// ScoreRuleMaxDelta is broken by scores that would change a member score more than the maximum delta
+	// since its delta window started
+	ScoreRuleMaxDelta = "max_delta"
+	// ScoreRuleMaxSubmissions is broken by submissions past the maximum of a member submission window
+	ScoreRuleMaxSubmissions = "max_submissions"
+)
+
+// Submissions interface standardize calls that read the writes held for review instead of being ranked, see
+// ScoreRules
+type Submissions interface {
+	GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*QuarantineEntry, error)
+}
+
+// ScoreRules bound the scores UpsertMembers write, they are checked in the same atomic call as the write.
+// Scores are compared divided by ScoreScale and rounded down when ScoreScale is greater than 1, then divided
+// by ScoreUnit, and zero limits are not enforced. Every member written counts a submission, refused ones
+// included, in windows kept in Keys.LeaderboardSubmissions that restart once they are older than their duration
+type ScoreRules struct {
+	// MinScore and MaxScore bound the score a member ends up with, nil means unbounded
+	MinScore *float64
+	MaxScore *float64
+	// MaxIncrement bounds the absolute value of scores written with UpdatePolicySum
+	MaxIncrement float64
+	// MaxDelta bounds how much a member score can change since its delta window started, members without a
+	// score count from zero
+	MaxDelta    float64
+	DeltaWindow time.Duration
+	// MaxSubmissions bounds how many submissions a member makes per submission window
+	MaxSubmissions   int
+	SubmissionWindow time.Duration
+	// Quarantine holds members that break a rule in Keys.LeaderboardQuarantine and writes the others, held
+	// members are returned with the rule they broke. Otherwise nothing is written and a ScoreRuleViolationError
+	// is returned
+	Quarantine bool
+	// ScoreUnit is what stored scores are divided by to get the scores limits are set in, zero means 1
+	ScoreUnit float64
+	// Now is when the scores are submitted, it moves the windows and is kept with quarantined writes
+	Now time.Time
+}
+
+// QuarantineEntry is a score write held for review, Rule is the score rule it broke
+type QuarantineEntry struct {
+	Member      string    `json:"-"`
+	Score       float64   `json:"score"`
+	Rule        string    `json:"rule"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/submissions.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSubmissions is a mock of Submissions interface.
+type MockSubmissions struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubmissionsMockRecorder
+}
+
+// MockSubmissionsMockRecorder is the mock recorder for MockSubmissions.
+type MockSubmissionsMockRecorder struct {
+	mock *MockSubmissions
+}
+
+// NewMockSubmissions creates a new mock instance.
+func NewMockSubmissions(ctrl *gomock.Controller) *MockSubmissions {
+	mock := &MockSubmissions{ctrl: ctrl}
+	mock.recorder = &MockSubmissionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubmissions) EXPECT() *MockSubmissionsMockRecorder {
+	return m.recorder
+}
+
+// GetQuarantinedMembers mocks base method.
+func (m *MockSubmissions) GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*QuarantineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuarantinedMembers", ctx, leaderboard)
+	ret0, _ := ret[0].([]*QuarantineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuarantinedMembers indicates an expected call of GetQuarantinedMembers.
+func (mr *MockSubmissionsMockRecorder) GetQuarantinedMembers(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarantinedMembers", reflect.TypeOf((*MockSubmissions)(nil).GetQuarantinedMembers), ctx, leaderboard)
+}
@@ -0,0 +1,28 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// TierHistoryLength is how many seasons of a member are kept in its tier history
+const TierHistoryLength = 50
+
+// Tiers interface standardize calls that keep the tier members of a tiered league play each season in
+type Tiers interface {
+	RecordTierMovements(ctx context.Context, competition string, movements map[string]*TierMovement) error
+	GetTierHistory(ctx context.Context, competition, member string) ([]*TierMovement, error)
+	GetTierMovementJobs(ctx context.Context, maxTime time.Time, amount int) ([]string, error)
+	CompleteTierMovementJob(ctx context.Context, leaderboard string) error
+	RetryTierMovementJob(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error)
+}
+
+// TierMovement describe how a member finished a tiered league season and the tier it plays the next one in
+type TierMovement struct {
+	Leaderboard string    `json:"leaderboard"`
+	SeasonEnd   time.Time `json:"seasonEnd"`
+	Tier        string    `json:"tier"`
+	Rank        int       `json:"rank"`
+	Movement    string    `json:"movement"`
+	NextTier    string    `json:"nextTier"`
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/tiers.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTiers is a mock of Tiers interface.
+type MockTiers struct {
+	ctrl     *gomock.Controller
+	recorder *MockTiersMockRecorder
+}
+
+// MockTiersMockRecorder is the mock recorder for MockTiers.
+type MockTiersMockRecorder struct {
+	mock *MockTiers
+}
+
+// NewMockTiers creates a new mock instance.
+func NewMockTiers(ctrl *gomock.Controller) *MockTiers {
+	mock := &MockTiers{ctrl: ctrl}
+	mock.recorder = &MockTiersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTiers) EXPECT() *MockTiersMockRecorder {
+	return m.recorder
+}
+
+// CompleteTierMovementJob mocks base method.
+func (m *MockTiers) CompleteTierMovementJob(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTierMovementJob", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTierMovementJob indicates an expected call of CompleteTierMovementJob.
+func (mr *MockTiersMockRecorder) CompleteTierMovementJob(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTierMovementJob", reflect.TypeOf((*MockTiers)(nil).CompleteTierMovementJob), ctx, leaderboard)
+}
+
+// GetTierHistory mocks base method.
+func (m *MockTiers) GetTierHistory(ctx context.Context, competition, member string) ([]*TierMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierHistory", ctx, competition, member)
+	ret0, _ := ret[0].([]*TierMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierHistory indicates an expected call of GetTierHistory.
+func (mr *MockTiersMockRecorder) GetTierHistory(ctx, competition, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierHistory", reflect.TypeOf((*MockTiers)(nil).GetTierHistory), ctx, competition, member)
+}
+
+// GetTierMovementJobs mocks base method.
+func (m *MockTiers) GetTierMovementJobs(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierMovementJobs", ctx, maxTime, amount)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierMovementJobs indicates an expected call of GetTierMovementJobs.
+func (mr *MockTiersMockRecorder) GetTierMovementJobs(ctx, maxTime, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierMovementJobs", reflect.TypeOf((*MockTiers)(nil).GetTierMovementJobs), ctx, maxTime, amount)
+}
+
+// RecordTierMovements mocks base method.
+func (m *MockTiers) RecordTierMovements(ctx context.Context, competition string, movements map[string]*TierMovement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTierMovements", ctx, competition, movements)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordTierMovements indicates an expected call of RecordTierMovements.
+func (mr *MockTiersMockRecorder) RecordTierMovements(ctx, competition, movements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTierMovements", reflect.TypeOf((*MockTiers)(nil).RecordTierMovements), ctx, competition, movements)
+}
+
+// RetryTierMovementJob mocks base method.
+func (m *MockTiers) RetryTierMovementJob(ctx context.Context, leaderboard string, maxAttempts int, retryInterval time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTierMovementJob", ctx, leaderboard, maxAttempts, retryInterval)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryTierMovementJob indicates an expected call of RetryTierMovementJob.
+func (mr *MockTiersMockRecorder) RetryTierMovementJob(ctx, leaderboard, maxAttempts, retryInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTierMovementJob", reflect.TypeOf((*MockTiers)(nil).RetryTierMovementJob), ctx, leaderboard, maxAttempts, retryInterval)
+}
@@ -257,10 +257,22 @@ func membersModelToProto(leaderboardID string, members []*model.Member) []*podiu
 		protoMembers[i] = &podium_leaderboard_webhooks_v1.Member{
 			LeaderboardId: leaderboardID,
 			Id:            m.PublicID,
-			Scores:        []*podium_leaderboard_webhooks_v1.Score{{Value: m.Score}},
+			Scores:        scoresModelToProto(m),
 			Rank:          int32(m.Rank),
 		}
 	}
 
 	return protoMembers
 }
+
+func scoresModelToProto(member *model.Member) []*podium_leaderboard_webhooks_v1.Score {
+	if len(member.Scores) == 0 {
+		return []*podium_leaderboard_webhooks_v1.Score{{Value: int64(member.Score), ExactValue: member.Score}}
+	}
+
+	scores := make([]*podium_leaderboard_webhooks_v1.Score, len(member.Scores))
+	for i, score := range member.Scores {
+		scores[i] = &podium_leaderboard_webhooks_v1.Score{Value: score, ExactValue: float64(score)}
+	}
+	return scores
+}
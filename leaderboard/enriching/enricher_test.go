@@ -214,3 +214,22 @@ var _ = Describe("test url builder", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 })
+
+var _ = Describe("test members conversion", func() {
+	It("should send member score as its only score", func() {
+		res := membersModelToProto("leaderboardID", []*model.Member{{PublicID: "publicID", Score: 10, Rank: 1}})
+
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Scores).To(HaveLen(1))
+		Expect(res[0].Scores[0].Value).To(Equal(int64(10)))
+	})
+
+	It("should send every score of composite scores", func() {
+		res := membersModelToProto("leaderboardID", []*model.Member{{PublicID: "publicID", Score: 1027, Scores: []int64{4, 3}, Rank: 1}})
+
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Scores).To(HaveLen(2))
+		Expect(res[0].Scores[0].Value).To(Equal(int64(4)))
+		Expect(res[0].Scores[1].Value).To(Equal(int64(3)))
+	})
+})
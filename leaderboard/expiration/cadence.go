@@ -0,0 +1,69 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package expiration
+
+import (
+	"fmt"
+	"time"
+)
+
+// Cadence is how often the seasons of a leaderboard start
+type Cadence string
+
+const (
+	// CadenceYearly seasons have year suffixes, like year2026
+	CadenceYearly Cadence = "yearly"
+	// CadenceQuarterly seasons have quarter suffixes, like year2026quarter04
+	CadenceQuarterly Cadence = "quarterly"
+	// CadenceMonthly seasons have month suffixes, like year2026month10
+	CadenceMonthly Cadence = "monthly"
+	// CadenceWeekly seasons have ISO week suffixes, like year2026week42
+	CadenceWeekly Cadence = "weekly"
+	// CadenceDaily seasons have day suffixes, like year2026month10day17
+	CadenceDaily Cadence = "daily"
+	// CadenceHourly seasons have hour suffixes, like year2026month10day17hour06
+	CadenceHourly Cadence = "hourly"
+)
+
+// IsValidCadence returns if cadence is one of the supported cadences
+func IsValidCadence(cadence string) bool {
+	switch Cadence(cadence) {
+	case CadenceYearly, CadenceQuarterly, CadenceMonthly, CadenceWeekly, CadenceDaily, CadenceHourly:
+		return true
+	}
+	return false
+}
+
+// SeasonSuffix returns the leaderboard name suffix of the season offset seasons away from the one containing
+// t, in t location, a negative offset means past seasons
+func (c Cadence) SeasonSuffix(t time.Time, offset int) string {
+	year, month, day := t.Date()
+
+	switch c {
+	case CadenceYearly:
+		return fmt.Sprintf("year%04d", year+offset)
+	case CadenceQuarterly:
+		quarters := year*4 + (int(month)-1)/3 + offset
+		return fmt.Sprintf("year%04dquarter%02d", quarters/4, quarters%4+1)
+	case CadenceMonthly:
+		start := time.Date(year, month+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+		return start.Format("year2006month01")
+	case CadenceWeekly:
+		isoYear, isoWeek := time.Date(year, month, day+7*offset, 0, 0, 0, 0, time.UTC).ISOWeek()
+		return fmt.Sprintf("year%04dweek%02d", isoYear, isoWeek)
+	case CadenceDaily:
+		start := time.Date(year, month, day+offset, 0, 0, 0, 0, time.UTC)
+		return start.Format("year2006month01day02")
+	case CadenceHourly:
+		start := time.Date(year, month, day, t.Hour()+offset, 0, 0, 0, time.UTC)
+		return start.Format("year2006month01day02hour15")
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package expiration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+)
+
+var _ = Describe("Cadence", func() {
+	now := time.Date(2026, time.January, 2, 6, 30, 0, 0, time.UTC)
+
+	It("should validate cadences", func() {
+		Expect(expiration.IsValidCadence("weekly")).To(BeTrue())
+		Expect(expiration.IsValidCadence("hourly")).To(BeTrue())
+		Expect(expiration.IsValidCadence("fortnightly")).To(BeFalse())
+		Expect(expiration.IsValidCadence("")).To(BeFalse())
+	})
+
+	It("should get the suffix of the current season", func() {
+		suffixes := map[expiration.Cadence]string{
+			expiration.CadenceYearly:    "year2026",
+			expiration.CadenceQuarterly: "year2026quarter01",
+			expiration.CadenceMonthly:   "year2026month01",
+			expiration.CadenceWeekly:    "year2026week01",
+			expiration.CadenceDaily:     "year2026month01day02",
+			expiration.CadenceHourly:    "year2026month01day02hour06",
+		}
+
+		for cadence, suffix := range suffixes {
+			Expect(cadence.SeasonSuffix(now, 0)).To(Equal(suffix), string(cadence))
+		}
+	})
+
+	It("should get the suffix of the previous season", func() {
+		suffixes := map[expiration.Cadence]string{
+			expiration.CadenceYearly:    "year2025",
+			expiration.CadenceQuarterly: "year2025quarter04",
+			expiration.CadenceMonthly:   "year2025month12",
+			expiration.CadenceWeekly:    "year2025week52",
+			expiration.CadenceDaily:     "year2026month01day01",
+			expiration.CadenceHourly:    "year2026month01day02hour05",
+		}
+
+		for cadence, suffix := range suffixes {
+			Expect(cadence.SeasonSuffix(now, -1)).To(Equal(suffix), string(cadence))
+		}
+	})
+
+	It("should get suffixes of seasons containing the time in its location", func() {
+		saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+		Expect(err).NotTo(HaveOccurred())
+
+		localTime := time.Date(2026, time.January, 1, 1, 0, 0, 0, time.UTC).In(saoPaulo)
+		Expect(expiration.CadenceDaily.SeasonSuffix(localTime, 0)).To(Equal("year2025month12day31"))
+		Expect(expiration.CadenceYearly.SeasonSuffix(localTime, 0)).To(Equal("year2025"))
+	})
+
+	It("should get suffixes parsed back into the season containing the time", func() {
+		for _, cadence := range []expiration.Cadence{
+			expiration.CadenceYearly, expiration.CadenceQuarterly, expiration.CadenceMonthly,
+			expiration.CadenceWeekly, expiration.CadenceDaily, expiration.CadenceHourly,
+		} {
+			season, err := expiration.GetSeason("leaderboard_" + cadence.SeasonSuffix(now, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season).NotTo(BeNil(), string(cadence))
+			Expect(now).To(BeTemporally(">=", season.Start), string(cadence))
+			Expect(now).To(BeTemporally("<", season.End), string(cadence))
+		}
+	})
+})
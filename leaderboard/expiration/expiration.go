@@ -19,22 +19,54 @@ import (
 
 var unixRE = regexp.MustCompile("from([0-9]{10})to([0-9]{10})$")                                            // unix timestamp
 var timestampRE = regexp.MustCompile("from([0-9]{4}[0|1][0-9][0-3][0-9])to([0-9]{4}[0|1][0-9][0-3][0-9])$") //YYYYMMDD
+var minuteRE = regexp.MustCompile("from([0-9]{12})to([0-9]{12})$")                                          //YYYYMMDDhhmm
 var yearlyRE = regexp.MustCompile("year([0-9]{4})$")                                                        // yearly
 var quarterRE = regexp.MustCompile("year([0-9]{4})(week|quarter|month)([0-9]+)$")                           //week, quarter, mo
+var dailyRE = regexp.MustCompile("year([0-9]{4})month([0-9]{2})day([0-9]{2})$")                             // daily
+var hourlyRE = regexp.MustCompile("year([0-9]{4})month([0-9]{2})day([0-9]{2})hour([0-9]{2})$")              // hourly
 
-func checkExpireAtErrors(
-	leaderboardPublicID string, startTimestamp, endTimestamp int64,
-) (int64, error) {
-	now := time.Now().UTC().Unix()
-	durationInSeconds := endTimestamp - startTimestamp
+// Season is the period a seasonal leaderboard accepts scores, from Start to End (not included), the
+// leaderboard is kept until ExpireAt
+type Season struct {
+	Start    time.Time
+	End      time.Time
+	ExpireAt time.Time
+}
+
+// Retention is how long seasonal leaderboards are kept, only one of its fields should be set. The zero value
+// keeps them for twice their season length
+type Retention struct {
+	// Multiplier expires leaderboards after Multiplier times their season length, counted from the season start
+	Multiplier float64
+	// Grace expires leaderboards Grace after their season end
+	Grace time.Duration
+	// Duration expires leaderboards Duration after their season start
+	Duration time.Duration
+}
+
+// Policy is how seasons are read from leaderboard names and how long their leaderboards are kept,
+// the zero value reads seasons in UTC and keeps leaderboards for twice their season length
+type Policy struct {
+	// Location is the time zone of calendar suffixes, unix timestamps don't depend on it. Defaults to UTC
+	Location  *time.Location
+	Retention Retention
+}
+
+func newRangeSeason(leaderboardPublicID string, startTime, endTime time.Time) (*Season, error) {
+	durationInSeconds := endTime.Unix() - startTime.Unix()
 	if durationInSeconds <= 0 {
-		return -1, &InvalidDurationError{leaderboardPublicID, durationInSeconds}
-	}
-	expireAt := endTimestamp + durationInSeconds
-	if expireAt <= now {
-		return -1, &LeaderboardExpiredError{leaderboardPublicID}
+		return nil, &InvalidDurationError{leaderboardPublicID, durationInSeconds}
 	}
-	return expireAt, nil
+	return &Season{
+		Start:    startTime,
+		End:      endTime,
+		ExpireAt: endTime.Add(endTime.Sub(startTime)),
+	}, nil
+}
+
+// inLocation returns the time with the same wall clock in location
+func inLocation(t time.Time, location *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
 }
 
 // WeeklyExpiration calculates the expireAt date for leaderboards with weekly format
@@ -57,70 +89,183 @@ func QuarterlyExpiration(year, quarter int64) time.Time {
 	return startTime.AddDate(0, 6, 0)
 }
 
-// GetExpireAt returns a timestamp when the key should expire or -1 if the key doesn't match any valid auto expire regexes
-func GetExpireAt(leaderboardPublicID string) (int64, error) {
+// DailyExpiration calculates the expireAt date for leaderboards with daily format
+func DailyExpiration(startTime time.Time) time.Time {
+	return startTime.AddDate(0, 0, 2)
+}
+
+// HourlyExpiration calculates the expireAt date for leaderboards with hourly format
+func HourlyExpiration(startTime time.Time) time.Time {
+	return startTime.Add(2 * time.Hour)
+}
+
+// isoWeekStart returns the monday that starts the given ISO week
+func isoWeekStart(year, week int64) time.Time {
+	fourthOfJanuary := time.Date(int(year), time.January, 4, 0, 0, 0, 0, time.UTC)
+	firstMonday := fourthOfJanuary.AddDate(0, 0, -((int(fourthOfJanuary.Weekday()) + 6) % 7))
+	return firstMonday.AddDate(0, 0, (int(week)-1)*7)
+}
+
+// GetSeason returns the season of a leaderboard from its name suffix or nil if the name doesn't match any season
+func GetSeason(leaderboardPublicID string) (*Season, error) {
+	return Policy{}.GetSeason(leaderboardPublicID)
+}
+
+// GetSeason returns the season of a leaderboard from its name suffix in the policy location, expiring as
+// the policy retention, or nil if the name doesn't match any season
+func (p Policy) GetSeason(leaderboardPublicID string) (*Season, error) {
+	season, calendar, err := parseSeason(leaderboardPublicID)
+	if err != nil || season == nil {
+		return nil, err
+	}
+
+	if calendar && p.Location != nil {
+		season.Start = inLocation(season.Start, p.Location)
+		season.End = inLocation(season.End, p.Location)
+		season.ExpireAt = inLocation(season.ExpireAt, p.Location)
+	}
+
+	switch {
+	case p.Retention.Grace > 0:
+		season.ExpireAt = season.End.Add(p.Retention.Grace)
+	case p.Retention.Duration > 0:
+		season.ExpireAt = season.Start.Add(p.Retention.Duration)
+	case p.Retention.Multiplier > 0:
+		seasonLength := float64(season.End.Sub(season.Start))
+		season.ExpireAt = season.Start.Add(time.Duration(p.Retention.Multiplier * seasonLength))
+	}
+
+	return season, nil
+}
+
+// parseSeason returns the season of the name suffix in UTC and if it's a calendar suffix
+func parseSeason(leaderboardPublicID string) (*Season, bool, error) {
 	substrings := unixRE.FindStringSubmatch(leaderboardPublicID)
 	if len(substrings) == 3 {
 		startTimestamp, _ := strconv.ParseInt(substrings[1], 10, 32)
 		endTimestamp, _ := strconv.ParseInt(substrings[2], 10, 32)
-		return checkExpireAtErrors(leaderboardPublicID, startTimestamp, endTimestamp)
+		season, err := newRangeSeason(leaderboardPublicID, time.Unix(startTimestamp, 0).UTC(), time.Unix(endTimestamp, 0).UTC())
+		return season, false, err
 	}
 
 	substrings = timestampRE.FindStringSubmatch(leaderboardPublicID)
 	if len(substrings) == 3 {
 		startTime, err := time.Parse("20060102", substrings[1])
 		if err != nil {
-			return -1, err
+			return nil, false, err
 		}
 		endTime, err := time.Parse("20060102", substrings[2])
 		if err != nil {
-			return -1, err
+			return nil, false, err
 		}
-		return checkExpireAtErrors(leaderboardPublicID, startTime.Unix(), endTime.Unix())
+		season, err := newRangeSeason(leaderboardPublicID, startTime, endTime)
+		return season, true, err
 	}
 
-	now := time.Now().UTC().Unix()
+	substrings = minuteRE.FindStringSubmatch(leaderboardPublicID)
+	if len(substrings) == 3 {
+		startTime, err := time.Parse("200601021504", substrings[1])
+		if err != nil {
+			return nil, false, err
+		}
+		endTime, err := time.Parse("200601021504", substrings[2])
+		if err != nil {
+			return nil, false, err
+		}
+		season, err := newRangeSeason(leaderboardPublicID, startTime, endTime)
+		return season, true, err
+	}
 
 	substrings = yearlyRE.FindStringSubmatch(leaderboardPublicID)
 	if len(substrings) == 2 {
 		startTime, _ := time.Parse("2006", substrings[1])
-		endTime := startTime.AddDate(2, 0, 0)
-		if endTime.Unix() <= now {
-			return -1, &LeaderboardExpiredError{leaderboardPublicID}
-		}
-		return endTime.Unix(), nil
+		return &Season{
+			Start:    startTime,
+			End:      startTime.AddDate(1, 0, 0),
+			ExpireAt: startTime.AddDate(2, 0, 0),
+		}, true, nil
 	}
 
 	substrings = quarterRE.FindStringSubmatch(leaderboardPublicID)
 	if len(substrings) == 4 {
-		var startTime time.Time
-		var endTime time.Time
-		var err error
+		year, _ := strconv.ParseInt(substrings[1], 10, 32)
+		value, _ := strconv.ParseInt(substrings[3], 10, 32)
 
-		if substrings[2] == "month" {
-			startTime, err = time.Parse("200601", strings.Join([]string{substrings[1], substrings[3]}, ""))
+		switch substrings[2] {
+		case "month":
+			startTime, err := time.Parse("200601", strings.Join([]string{substrings[1], substrings[3]}, ""))
 			if err != nil {
-				return -1, err
+				return nil, false, err
 			}
-			endTime = MonthlyExpiration(startTime)
+			return &Season{
+				Start:    startTime,
+				End:      startTime.AddDate(0, 1, 0),
+				ExpireAt: MonthlyExpiration(startTime),
+			}, true, nil
+		case "week":
+			startTime := isoWeekStart(year, value)
+			return &Season{
+				Start:    startTime,
+				End:      startTime.AddDate(0, 0, 7),
+				ExpireAt: WeeklyExpiration(year, value),
+			}, true, nil
+		default:
+			startTime := time.Date(int(year), time.Month((value-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+			return &Season{
+				Start:    startTime,
+				End:      startTime.AddDate(0, 3, 0),
+				ExpireAt: QuarterlyExpiration(year, value),
+			}, true, nil
 		}
+	}
 
-		year, _ := strconv.ParseInt(substrings[1], 10, 32)
-		if substrings[2] == "week" {
-			week, _ := strconv.ParseInt(substrings[3], 10, 32)
-			endTime = WeeklyExpiration(year, week)
+	substrings = dailyRE.FindStringSubmatch(leaderboardPublicID)
+	if len(substrings) == 4 {
+		startTime, err := time.Parse("20060102", strings.Join(substrings[1:], ""))
+		if err != nil {
+			return nil, false, err
 		}
+		return &Season{
+			Start:    startTime,
+			End:      startTime.AddDate(0, 0, 1),
+			ExpireAt: DailyExpiration(startTime),
+		}, true, nil
+	}
 
-		if substrings[2] == "quarter" {
-			quarter, _ := strconv.ParseInt(substrings[3], 10, 32)
-			endTime = QuarterlyExpiration(year, quarter)
+	substrings = hourlyRE.FindStringSubmatch(leaderboardPublicID)
+	if len(substrings) == 5 {
+		startTime, err := time.Parse("2006010215", strings.Join(substrings[1:], ""))
+		if err != nil {
+			return nil, false, err
 		}
+		return &Season{
+			Start:    startTime,
+			End:      startTime.Add(time.Hour),
+			ExpireAt: HourlyExpiration(startTime),
+		}, true, nil
+	}
 
-		if endTime.Unix() <= now {
-			return -1, &LeaderboardExpiredError{leaderboardPublicID}
-		}
-		return endTime.Unix(), nil
+	return nil, false, nil
+}
+
+// GetExpireAt returns a timestamp when the key should expire or -1 if the key doesn't match any valid auto expire regexes
+func GetExpireAt(leaderboardPublicID string) (int64, error) {
+	return Policy{}.GetExpireAt(leaderboardPublicID)
+}
+
+// GetExpireAt returns a timestamp when the key should expire as the policy or -1 if the key doesn't match
+// any valid auto expire regexes
+func (p Policy) GetExpireAt(leaderboardPublicID string) (int64, error) {
+	season, err := p.GetSeason(leaderboardPublicID)
+	if err != nil {
+		return -1, err
+	}
+	if season == nil {
+		return -1, nil
 	}
 
-	return -1, nil
+	if season.ExpireAt.Unix() <= time.Now().UTC().Unix() {
+		return -1, &LeaderboardExpiredError{leaderboardPublicID}
+	}
+	return season.ExpireAt.Unix(), nil
 }
@@ -166,4 +166,181 @@ var _ = Describe("Expires Helper", func() {
 			Expect(exp).To(BeEquivalentTo(ts))
 		})
 	})
+	Describe("Daily expiration", func() {
+		It("should get daily expiration", func() {
+			now := time.Now().UTC()
+			exp, err := expiration.GetExpireAt(fmt.Sprintf("leaderboard_%s", now.Format("year2006month01day02")))
+			Expect(err).NotTo(HaveOccurred())
+
+			startTime := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+			Expect(exp).To(BeEquivalentTo(expiration.DailyExpiration(startTime).Unix()))
+		})
+
+		It("should return error for invalid timestamp", func() {
+			exp, err := expiration.GetExpireAt("leaderboard_year2026month10day39")
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("parsing time \"20261039\": day out of range"))
+		})
+
+		It("should return error if expired", func() {
+			exp, err := expiration.GetExpireAt("leaderboard_year2016month10day17")
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has already expired"))
+		})
+	})
+
+	Describe("Hourly expiration", func() {
+		It("should get hourly expiration", func() {
+			now := time.Now().UTC()
+			exp, err := expiration.GetExpireAt(fmt.Sprintf("leaderboard_%s", now.Format("year2006month01day02hour15")))
+			Expect(err).NotTo(HaveOccurred())
+
+			startTime := now.Truncate(time.Hour)
+			Expect(exp).To(BeEquivalentTo(expiration.HourlyExpiration(startTime).Unix()))
+		})
+
+		It("should return error for invalid timestamp", func() {
+			exp, err := expiration.GetExpireAt("leaderboard_year2026month10day17hour24")
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("parsing time \"2026101724\": hour out of range"))
+		})
+	})
+
+	Describe("Custom Minute expiration", func() {
+		It("should get expiration", func() {
+			start := time.Now().UTC().Truncate(time.Minute)
+			end := start.Add(6 * time.Hour)
+			exp, err := expiration.GetExpireAt(fmt.Sprintf("leaderboard_from%sto%s", start.Format("200601021504"), end.Format("200601021504")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exp).To(BeEquivalentTo(end.Add(end.Sub(start)).Unix()))
+		})
+
+		It("should return error for invalid timestamp", func() {
+			exp, err := expiration.GetExpireAt("leaderboard_from202610170660to202610171200")
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("parsing time \"202610170660\": minute out of range"))
+		})
+
+		It("should return error for negative duration", func() {
+			exp, err := expiration.GetExpireAt("leaderboard_from202610171200to202610170600")
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has invalid duration -21600"))
+		})
+	})
+
+	Describe("Season", func() {
+		date := func(year int, month time.Month, day, hour, min int) time.Time {
+			return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+		}
+
+		It("should get nil season if name has no season", func() {
+			season, err := expiration.GetSeason("my_leaderboard")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season).To(BeNil())
+		})
+
+		It("should get season of every suffix", func() {
+			seasons := map[string][2]time.Time{
+				"leaderboard_from1792195200to1792216800":     {date(2026, time.October, 17, 0, 0), date(2026, time.October, 17, 6, 0)},
+				"leaderboard_from20261017to20261019":         {date(2026, time.October, 17, 0, 0), date(2026, time.October, 19, 0, 0)},
+				"leaderboard_from202610170600to202610171200": {date(2026, time.October, 17, 6, 0), date(2026, time.October, 17, 12, 0)},
+				"leaderboard_year2026":                       {date(2026, time.January, 1, 0, 0), date(2027, time.January, 1, 0, 0)},
+				"leaderboard_year2026quarter04":              {date(2026, time.October, 1, 0, 0), date(2027, time.January, 1, 0, 0)},
+				"leaderboard_year2026month10":                {date(2026, time.October, 1, 0, 0), date(2026, time.November, 1, 0, 0)},
+				"leaderboard_year2016week21":                 {date(2016, time.May, 23, 0, 0), date(2016, time.May, 30, 0, 0)},
+				"leaderboard_year2026week01":                 {date(2025, time.December, 29, 0, 0), date(2026, time.January, 5, 0, 0)},
+				"leaderboard_year2026month10day17":           {date(2026, time.October, 17, 0, 0), date(2026, time.October, 18, 0, 0)},
+				"leaderboard_year2026month10day17hour06":     {date(2026, time.October, 17, 6, 0), date(2026, time.October, 17, 7, 0)},
+			}
+
+			for leaderboard, expected := range seasons {
+				season, err := expiration.GetSeason(leaderboard)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(season).NotTo(BeNil(), leaderboard)
+				Expect(season.Start).To(Equal(expected[0]), leaderboard)
+				Expect(season.End).To(Equal(expected[1]), leaderboard)
+			}
+		})
+
+		It("should get season of expired leaderboards", func() {
+			season, err := expiration.GetSeason("leaderboard_year2016month10day17")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.Start).To(Equal(date(2016, time.October, 17, 0, 0)))
+			Expect(season.End).To(Equal(date(2016, time.October, 18, 0, 0)))
+		})
+
+		It("should return error for invalid duration", func() {
+			season, err := expiration.GetSeason("leaderboard_from20201010to20201010")
+			Expect(season).To(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has invalid duration 0"))
+		})
+	})
+	Describe("Policy", func() {
+		It("should read calendar suffixes in the policy location", func() {
+			saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+			Expect(err).NotTo(HaveOccurred())
+
+			season, err := expiration.Policy{Location: saoPaulo}.GetSeason("leaderboard_year2026month10day17")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.Start).To(BeTemporally("==", time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC)))
+			Expect(season.End).To(BeTemporally("==", time.Date(2026, time.October, 18, 3, 0, 0, 0, time.UTC)))
+			Expect(season.ExpireAt).To(BeTemporally("==", time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC)))
+		})
+
+		It("should not change unix timestamps in the policy location", func() {
+			saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+			Expect(err).NotTo(HaveOccurred())
+
+			season, err := expiration.Policy{Location: saoPaulo}.GetSeason("leaderboard_from1792195200to1792216800")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.Start.Unix()).To(BeEquivalentTo(1792195200))
+			Expect(season.End.Unix()).To(BeEquivalentTo(1792216800))
+		})
+
+		It("should expire after the grace period", func() {
+			policy := expiration.Policy{Retention: expiration.Retention{Grace: 30 * 24 * time.Hour}}
+			season, err := policy.GetSeason("leaderboard_year2026")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.ExpireAt).To(Equal(time.Date(2027, time.January, 31, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should expire after the retention duration", func() {
+			policy := expiration.Policy{Retention: expiration.Retention{Duration: 36 * time.Hour}}
+			season, err := policy.GetSeason("leaderboard_year2026month10day17")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.ExpireAt).To(Equal(time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)))
+		})
+
+		It("should expire after the retention multiplier", func() {
+			policy := expiration.Policy{Retention: expiration.Retention{Multiplier: 1.5}}
+			season, err := policy.GetSeason("leaderboard_from202610170600to202610171200")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.ExpireAt).To(Equal(time.Date(2026, time.October, 17, 15, 0, 0, 0, time.UTC)))
+		})
+
+		It("should get expiration as the policy", func() {
+			now := time.Now().UTC()
+			policy := expiration.Policy{Retention: expiration.Retention{Grace: time.Hour}}
+			exp, err := policy.GetExpireAt(fmt.Sprintf("leaderboard_%s", now.Format("year2006month01day02")))
+			Expect(err).NotTo(HaveOccurred())
+
+			startTime := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+			Expect(exp).To(BeEquivalentTo(startTime.AddDate(0, 0, 1).Add(time.Hour).Unix()))
+		})
+
+		It("should return error if expired as the policy", func() {
+			yesterday := time.Now().UTC().AddDate(0, 0, -1)
+			policy := expiration.Policy{Retention: expiration.Retention{Multiplier: 1}}
+			exp, err := policy.GetExpireAt(fmt.Sprintf("leaderboard_%s", yesterday.Format("year2006month01day02")))
+			Expect(exp).To(BeEquivalentTo(-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has already expired"))
+		})
+	})
 })
@@ -17,11 +17,14 @@ import (
 
 	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
 
 	uuid "github.com/satori/go.uuid"
@@ -66,10 +69,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("setting member scores", func() {
 		It("should set scores and return ranks", func() {
 			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"dayvson", 481516, false, "")
+				"dayvson", 481516, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			arthur, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"arthur", 1000, false, "")
+				"arthur", 1000, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
@@ -79,7 +82,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should set score expiration if expiry field is passed", func() {
 			ttl := "100"
 			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"denix", 481516, false, ttl)
+				"denix", 481516, false, ttl, "")
 			Expect(err).NotTo(HaveOccurred())
 			redisLBExpirationKey := fmt.Sprintf("%s:ttl", testLeaderboardID)
 			err = redisDatabase.Exists(context.Background(), redisLBExpirationKey)
@@ -99,17 +102,17 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should set scores and return previous ranks", func() {
 			member1, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member1",
-				481516, true, "")
+				481516, true, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			member2, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member2",
-				1000, false, "")
+				1000, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member1.Rank).To(Equal(1))
 			Expect(member1.PreviousRank).To(Equal(-1))
 			Expect(member2.Rank).To(Equal(2))
 			Expect(member2.PreviousRank).To(Equal(0))
 			nmember1, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member1",
-				1, true, "")
+				1, true, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(nmember1.Rank).To(Equal(2))
 			Expect(nmember1.PreviousRank).To(Equal(1))
@@ -117,7 +120,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should fail if invalid connection to Redis", func() {
 			_, err := faultyLeaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "dayvson",
-				481516, false, "")
+				481516, false, "", "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -129,7 +132,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "dayvson"},
 				{Score: 1000, PublicID: "arthur"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, "")
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].PublicID).To(Equal("dayvson"))
 			Expect(members[0].Rank).To(Equal(1))
@@ -145,7 +148,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "denix1"},
 				{Score: 481516, PublicID: "denix2"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, ttl)
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, ttl, "")
 			Expect(err).NotTo(HaveOccurred())
 			redisLBExpirationKey := fmt.Sprintf("%s:ttl", testLeaderboardID)
 			err = redisDatabase.Exists(context.Background(), redisLBExpirationKey)
@@ -170,7 +173,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "member1"},
 				{Score: 1000, PublicID: "member2"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "")
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].Rank).To(Equal(1))
 			Expect(members[0].PreviousRank).To(Equal(-1))
@@ -180,7 +183,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 1, PublicID: "member1"},
 				{Score: 500, PublicID: "member2"},
 			}
-			err = leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "")
+			err = leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].Rank).To(Equal(2))
 			Expect(members[0].PreviousRank).To(Equal(1))
@@ -189,7 +192,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 
 		It("should fail if invalid connection to Redis", func() {
-			err := faultyLeaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, []*model.Member{}, false, "")
+			err := faultyLeaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, []*model.Member{}, false, "", "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -199,12 +202,12 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should increment member score and return ranks", func() {
 			lbID := uuid.NewV4().String()
 
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 1000, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 1000, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(member.Score).To(Equal(int64(1010)))
+			Expect(member.Score).To(Equal(float64(1010)))
 			Expect(member.PublicID).To(Equal("dayvson"))
 
 			score, err := redisDatabase.ZScore(context.Background(), lbID, "dayvson")
@@ -217,7 +220,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(member.Score).To(Equal(int64(10)))
+			Expect(member.Score).To(Equal(float64(10)))
 			Expect(member.PublicID).To(Equal("dayvson"))
 
 			score, err := redisDatabase.ZScore(context.Background(), lbID, "dayvson")
@@ -235,7 +238,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting number of members", func() {
 		It("should retrieve the number of members in a leaderboard", func() {
 			for i := 0; i < 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			count, err := leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)
@@ -254,7 +257,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should remove member", func() {
 			for i := 0; i < 10; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)).To(Equal(10))
@@ -267,7 +270,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should remove many members", func() {
 			for i := 0; i < 10; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)).To(Equal(10))
@@ -292,7 +295,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should return total number of pages", func() {
 			for i := 0; i < 101; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalPages(NewEmptyCtx(), testLeaderboardID, 25)).To(Equal(5))
@@ -308,16 +311,16 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting member details for a given leaderboard", func() {
 		It("should return member details", func() {
 			lbID := uuid.NewV4().String()
-			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "")
+			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "")
+			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
 			Expect(felipe.Rank).To(Equal(2))
-			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "")
-			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", false)
+			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "", "")
+			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
-			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", false)
+			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(felipe.Rank).To(Equal(1))
 			Expect(dayvson.Rank).To(Equal(2))
@@ -325,16 +328,16 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should return member details including score expiration", func() {
 			lbID := uuid.NewV4().String()
-			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "10")
+			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "10", "")
 			Expect(err).NotTo(HaveOccurred())
-			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "")
+			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
 			Expect(felipe.Rank).To(Equal(2))
-			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "")
-			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", true)
+			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "", "")
+			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", true, "")
 			Expect(err).NotTo(HaveOccurred())
-			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", true)
+			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", true, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(felipe.Rank).To(Equal(1))
 			Expect(dayvson.Rank).To(Equal(2))
@@ -345,7 +348,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should fail if member does not exist", func() {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, memberID, "desc", false)
+			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, memberID, "desc", false, "")
 			Expect(member).To(BeNil())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
@@ -356,7 +359,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should fail if member does not exist and should include expiration timestamp", func() {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, memberID, "desc", true)
+			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, memberID, "desc", true, "")
 			Expect(member).To(BeNil())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
@@ -365,7 +368,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 
 		It("should fail if faulty redis client", func() {
-			_, err := faultyLeaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "qwe", "desc", false)
+			_, err := faultyLeaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "qwe", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -375,10 +378,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			firstAroundMe := members[0]
 			lastAroundMe := members[pageSize-1]
@@ -390,12 +393,12 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should always return page size members when page size is less than total members", func() {
 			pageSize := 3
 			for i := 0; i < 5; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
 			for i := 0; i < 5; i++ {
-				members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, fmt.Sprintf("member_%d", i), "desc", false)
+				members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, fmt.Sprintf("member_%d", i), "desc", false, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(len(members)).To(Equal(pageSize))
@@ -406,10 +409,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member in reverse order", func() {
 			pageSize := 20
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "asc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "asc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			firstAroundMe := members[0]
 			lastAroundMe := members[pageSize-1]
@@ -421,25 +424,25 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member if repeated scores", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(members)).To(Equal(pageSize))
 			firstAroundMe := members[0]
 			lastAroundMe := members[pageSize-1]
-			Expect(firstAroundMe.Score).To(Equal(int64(100)))
-			Expect(lastAroundMe.Score).To(Equal(int64(100)))
+			Expect(firstAroundMe.Score).To(Equal(float64(100)))
+			Expect(lastAroundMe.Score).To(Equal(float64(100)))
 		})
 
 		It("should get PageSize members around specific member even if member in ranking top", func() {
 			pageSize := 25
 			for i := 1; i <= 100; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_2", "desc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_2", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(members)).To(Equal(pageSize))
 			firstAroundMe := members[0]
@@ -451,10 +454,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get PageSize members around specific member even if member in ranking bottom", func() {
 			pageSize := 25
 			for i := 1; i <= 100; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_99", "desc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_99", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(members)).To(Equal(pageSize))
 			firstAroundMe := members[0]
@@ -465,10 +468,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should get PageSize members when interval larger than total members", func() {
 			for i := 1; i <= 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 25, "member_2", "desc", false)
+			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 25, "member_2", "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).To(HaveLen(10))
 			firstAroundMe := members[0]
@@ -478,7 +481,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 
 		It("should fail if faulty redis client", func() {
-			_, err := faultyLeaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 10, "qwe", "desc", false)
+			_, err := faultyLeaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 10, "qwe", "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -488,7 +491,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific score", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*20, "desc")
@@ -503,12 +506,12 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should always return page size members when page size is less than total members", func() {
 			pageSize := 3
 			for i := 0; i < 5; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
 			for i := 0; i < 5; i++ {
-				members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, int64(i), "desc")
+				members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, float64(i), "desc")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(len(members)).To(Equal(pageSize))
@@ -519,7 +522,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific score reverse order", func() {
 			pageSize := 20
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*20, "asc")
@@ -534,7 +537,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get last members if score <= 0", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, -50, "desc")
@@ -549,7 +552,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get top members if score > max score in leaderboard", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*200, "desc")
@@ -571,31 +574,56 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting member ranking", func() {
 		It("should return specific member ranking", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "")
-			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "desc")).To(Equal(100))
+			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "", "")
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "desc", "")).To(Equal(100))
 		})
 
 		It("should return specific member ranking if asc order", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "")
-			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "asc")).To(Equal(2))
+			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "", "")
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "asc", "")).To(Equal(2))
+		})
+
+		It("should give tied members the same ranking in competition and dense rank modes", func() {
+			scores := map[string]float64{"member_a": 30, "member_b": 20, "member_c": 20, "member_d": 10}
+			for member, score := range scores {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, member, score, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_b", "desc", service.RankModeCompetition)).To(Equal(2))
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_c", "desc", service.RankModeCompetition)).To(Equal(2))
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_d", "desc", service.RankModeCompetition)).To(Equal(4))
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_d", "desc", service.RankModeDense)).To(Equal(3))
+			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_a", "asc", service.RankModeDense)).To(Equal(3))
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 2, 2, "desc", service.RankModeCompetition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].Rank).To(Equal(2))
+			Expect(members[1].Rank).To(Equal(4))
+
+			members, err = leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 4, "member_d", "desc", false, service.RankModeDense)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(4))
+			Expect([]int{members[0].Rank, members[1].Rank, members[2].Rank, members[3].Rank}).To(Equal([]int{1, 2, 2, 3}))
 		})
 
 		It("should fail if member does not exist", func() {
-			rank, err := leaderboards.GetRank(NewEmptyCtx(), uuid.NewV4().String(), "invalid-member", "desc")
+			rank, err := leaderboards.GetRank(NewEmptyCtx(), uuid.NewV4().String(), "invalid-member", "desc", "")
 			Expect(rank).To(Equal(-1))
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Could not find data for member invalid-member in leaderboard"))
 		})
 
 		It("should fail if invalid redis connection", func() {
-			rank, err := faultyLeaderboards.GetRank(NewEmptyCtx(), uuid.NewV4().String(), "invalid-member", "desc")
+			rank, err := faultyLeaderboards.GetRank(NewEmptyCtx(), uuid.NewV4().String(), "invalid-member", "desc", "")
 			Expect(rank).To(Equal(-1))
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
@@ -606,10 +634,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get specific number of leaders", func() {
 			pageSize := 25
 			for i := 0; i < 1000; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc")
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			firstOnPage := members[0]
@@ -624,10 +652,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get specific number of leaders in reverse order", func() {
 			pageSize := 25
 			for i := 0; i < 1000; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), float64(1234*i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "asc")
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "asc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			firstOnPage := members[0]
@@ -642,46 +670,46 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get leaders if repeated scores", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc")
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 			firstAroundMe := members[0]
 			lastAroundMe := members[pageSize-1]
 			Expect(len(members)).To(Equal(pageSize))
-			Expect(firstAroundMe.Score).To(Equal(int64(100)))
-			Expect(lastAroundMe.Score).To(Equal(int64(100)))
+			Expect(firstAroundMe.Score).To(Equal(float64(100)))
+			Expect(lastAroundMe.Score).To(Equal(float64(100)))
 		})
 
 		It("should get leaders for negative pages get page 1", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, -1, "desc")
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, -1, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 			firstAroundMe := members[0]
 			lastAroundMe := members[pageSize-1]
 			Expect(len(members)).To(Equal(pageSize))
-			Expect(firstAroundMe.Score).To(Equal(int64(100)))
-			Expect(lastAroundMe.Score).To(Equal(int64(100)))
+			Expect(firstAroundMe.Score).To(Equal(float64(100)))
+			Expect(lastAroundMe.Score).To(Equal(float64(100)))
 		})
 
 		It("should get empty leaders for pages greater than total pages", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
-			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 25, 99999, "desc")
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 25, 99999, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).To(HaveLen(0))
 		})
 
 		It("should fail if invalid connection to Redis", func() {
 			//testLeaderboard := NewClient(getFaultyRedis(), "test-leaderboard", 25)
-			_, err := faultyLeaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 25, 1, "desc")
+			_, err := faultyLeaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 25, 1, "desc", "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -690,14 +718,14 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("expiration of leaderboards", func() {
 		It("should fail if invalid leaderboard", func() {
 			leaderboardID := "leaderboard_from20201039to20201011"
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "", "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("day out of range"))
 		})
 
 		It("should add yearly expiration if leaderboard supports it", func() {
 			leaderboardID := fmt.Sprintf("test-leaderboard-year%d", time.Now().UTC().Year())
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			result, err := redisDatabase.TTL(context.Background(), leaderboardID)
@@ -714,23 +742,23 @@ var _ = Describe("Leaderboard integration tests", func() {
 			leaderboardID := uuid.NewV4().String()
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64((100-i)*100), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "desc")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top10).To(HaveLen(10))
 
 			Expect(top10[0].PublicID).To(Equal("friend-0"))
 			Expect(top10[0].Rank).To(Equal(1))
-			Expect(top10[0].Score).To(Equal(int64(10000)))
+			Expect(top10[0].Score).To(Equal(float64(10000)))
 
 			Expect(top10[9].PublicID).To(Equal("friend-9"))
 			Expect(top10[9].Rank).To(Equal(10))
-			Expect(top10[9].Score).To(Equal(int64(9100)))
+			Expect(top10[9].Score).To(Equal(float64(9100)))
 		})
 
 		It("should not break if order is different from asc and desc, should only default to desc", func() {
@@ -738,23 +766,23 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64((100-i)*100), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "lalala")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "lalala", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top10).To(HaveLen(10))
 
 			Expect(top10[0].PublicID).To(Equal("friend-0"))
 			Expect(top10[0].Rank).To(Equal(1))
-			Expect(top10[0].Score).To(Equal(int64(10000)))
+			Expect(top10[0].Score).To(Equal(float64(10000)))
 
 			Expect(top10[9].PublicID).To(Equal("friend-9"))
 			Expect(top10[9].Rank).To(Equal(10))
-			Expect(top10[9].Score).To(Equal(int64(9100)))
+			Expect(top10[9].Score).To(Equal(float64(9100)))
 		})
 
 		It("should get top 10 percent members in the leaderboard in reverse order", func() {
@@ -762,23 +790,23 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64((100-i)*100), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "asc")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "asc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top10).To(HaveLen(10))
 
 			Expect(top10[0].PublicID).To(Equal("friend-99"))
 			Expect(top10[0].Rank).To(Equal(1))
-			Expect(top10[0].Score).To(Equal(int64(100)))
+			Expect(top10[0].Score).To(Equal(float64(100)))
 
 			Expect(top10[9].PublicID).To(Equal("friend-90"))
 			Expect(top10[9].Rank).To(Equal(10))
-			Expect(top10[9].Score).To(Equal(int64(1000)))
+			Expect(top10[9].Score).To(Equal(float64(1000)))
 		})
 
 		It("should get max members if query too broad", func() {
@@ -786,23 +814,23 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 10; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64((100-i)*100), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top3, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 100, 3, "desc")
+			top3, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 100, 3, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top3).To(HaveLen(3))
 
 			Expect(top3[0].PublicID).To(Equal("friend-0"))
 			Expect(top3[0].Rank).To(Equal(1))
-			Expect(top3[0].Score).To(Equal(int64(10000)))
+			Expect(top3[0].Score).To(Equal(float64(10000)))
 
 			Expect(top3[2].PublicID).To(Equal("friend-2"))
 			Expect(top3[2].Rank).To(Equal(3))
-			Expect(top3[2].Score).To(Equal(int64(9800)))
+			Expect(top3[2].Score).To(Equal(float64(9800)))
 		})
 
 		It("should get top 1 percent return at least 1", func() {
@@ -810,19 +838,19 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 2; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64((100-i)*100), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 1, 2000, "desc")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 1, 2000, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top10).To(HaveLen(1))
 
 			Expect(top10[0].PublicID).To(Equal("friend-0"))
 			Expect(top10[0].Rank).To(Equal(1))
-			Expect(top10[0].Score).To(Equal(int64(10000)))
+			Expect(top10[0].Score).To(Equal(float64(10000)))
 		})
 
 		It("should get top 10 percent members in the leaderboard if repeated scores", func() {
@@ -830,34 +858,34 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), 100, false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), 100, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "desc")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 10, 2000, "desc", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(top10).To(HaveLen(10))
 
 			Expect(top10[0].Rank).To(Equal(1))
-			Expect(top10[0].Score).To(Equal(int64(100)))
+			Expect(top10[0].Score).To(Equal(float64(100)))
 
 			Expect(top10[9].Rank).To(Equal(10))
-			Expect(top10[9].Score).To(Equal(int64(100)))
+			Expect(top10[9].Score).To(Equal(float64(100)))
 		})
 
 		It("should fail if more than 100 percent", func() {
 			leaderboardID := uuid.NewV4().String()
 
-			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 101, 2000, "desc")
+			top10, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 101, 2000, "desc", "")
 			Expect(top10).To(BeNil())
 			Expect(err).To(HaveOccurred())
 			Expect(err).To(MatchError(service.NewPercentageError(101)))
 		})
 
 		It("should fail if invalid redis connection", func() {
-			members, err := faultyLeaderboards.GetTopPercentage(NewEmptyCtx(), uuid.NewV4().String(), 25, 10, 2000, "desc")
+			members, err := faultyLeaderboards.GetTopPercentage(NewEmptyCtx(), uuid.NewV4().String(), 25, 10, 2000, "desc", "")
 			Expect(members).To(BeEmpty())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
@@ -870,7 +898,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			expMembers := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				expMembers = append(expMembers, member)
 			}
@@ -897,7 +925,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), float64(100-i), false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -920,47 +948,47 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should return all member details", func() {
 			lbID := uuid.NewV4().String()
 			for i := 0; i < 100; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), float64(100-i), false, "", "")
 			}
 
-			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", false)
+			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).To(HaveLen(3))
 
 			Expect(members[0].PublicID).To(Equal("member-10"))
 			Expect(members[0].Rank).To(Equal(11))
-			Expect(members[0].Score).To(Equal(int64(90)))
+			Expect(members[0].Score).To(Equal(float64(90)))
 
 			Expect(members[1].PublicID).To(Equal("member-20"))
 			Expect(members[1].Rank).To(Equal(21))
-			Expect(members[1].Score).To(Equal(int64(80)))
+			Expect(members[1].Score).To(Equal(float64(80)))
 
 			Expect(members[2].PublicID).To(Equal("member-30"))
 			Expect(members[2].Rank).To(Equal(31))
-			Expect(members[2].Score).To(Equal(int64(70)))
+			Expect(members[2].Score).To(Equal(float64(70)))
 		})
 
 		It("should return all member details using reverse rank", func() {
 			lbID := uuid.NewV4().String()
 			for i := 0; i < 100; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), float64(100-i), false, "", "")
 			}
 
-			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "asc", false)
+			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "asc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).To(HaveLen(3))
 
 			Expect(members[0].PublicID).To(Equal("member-30"))
 			Expect(members[0].Rank).To(Equal(70))
-			Expect(members[0].Score).To(Equal(int64(70)))
+			Expect(members[0].Score).To(Equal(float64(70)))
 
 			Expect(members[1].PublicID).To(Equal("member-20"))
 			Expect(members[1].Rank).To(Equal(80))
-			Expect(members[1].Score).To(Equal(int64(80)))
+			Expect(members[1].Score).To(Equal(float64(80)))
 
 			Expect(members[2].PublicID).To(Equal("member-10"))
 			Expect(members[2].Rank).To(Equal(90))
-			Expect(members[2].Score).To(Equal(int64(90)))
+			Expect(members[2].Score).To(Equal(float64(90)))
 		})
 
 		It("should return all member details including score expiration timestamp", func() {
@@ -970,32 +998,32 @@ var _ = Describe("Leaderboard integration tests", func() {
 				if i%30 == 0 {
 					ttl = "15"
 				}
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, ttl)
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), float64(100-i), false, ttl, "")
 			}
 
-			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", true)
+			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", true, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members).To(HaveLen(3))
 
 			Expect(members[0].PublicID).To(Equal("member-10"))
 			Expect(members[0].Rank).To(Equal(10))
-			Expect(members[0].Score).To(Equal(int64(90)))
+			Expect(members[0].Score).To(Equal(float64(90)))
 			Expect(members[0].ExpireAt).To(Equal(0))
 
 			Expect(members[1].PublicID).To(Equal("member-20"))
 			Expect(members[1].Rank).To(Equal(20))
-			Expect(members[1].Score).To(Equal(int64(80)))
+			Expect(members[1].Score).To(Equal(float64(80)))
 			Expect(members[1].ExpireAt).To(Equal(0))
 
 			Expect(members[2].PublicID).To(Equal("member-30"))
 			Expect(members[2].Rank).To(Equal(30))
 			Expect(members[2].ExpireAt).To(BeNumerically("~", time.Now().Unix()+15, 1))
-			Expect(members[2].Score).To(Equal(int64(70)))
+			Expect(members[2].Score).To(Equal(float64(70)))
 		})
 
 		It("should return empty list if invalid leaderboard id", func() {
 			lbID := uuid.NewV4().String()
-			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"test"}, "desc", false)
+			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"test"}, "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(HaveLen(0))
@@ -1005,24 +1033,642 @@ var _ = Describe("Leaderboard integration tests", func() {
 			lbID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), float64(100-i), false, "", "")
 			}
 
-			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-0", "invalid-member"}, "desc", false)
+			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-0", "invalid-member"}, "desc", false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(HaveLen(1))
 			Expect(members[0].PublicID).To(Equal("member-0"))
 			Expect(members[0].Rank).To(Equal(1))
-			Expect(members[0].Score).To(Equal(int64(100)))
+			Expect(members[0].Score).To(Equal(float64(100)))
 		})
 
 		It("should fail with faulty redis", func() {
 			lbID := uuid.NewV4().String()
-			_, err := faultyLeaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-example"}, "desc", false)
+			_, err := faultyLeaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-example"}, "desc", false, "")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
 	})
 
+	Describe("key schema", func() {
+		It("should store leaderboard and ttl set in hash tagged keys if schema is V2", func() {
+			keys := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-test:"}
+			hashTaggedLeaderboards := service.NewService(&database.Redis{Client: redisDatabase.Client, Keys: keys})
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := hashTaggedLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 481516, false, "100", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = redisDatabase.Exists(context.Background(), fmt.Sprintf("podium-test:{%s}", leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Exists(context.Background(), fmt.Sprintf("podium-test:{%s}:ttl", leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Exists(context.Background(), leaderboardID)
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(leaderboardID)))
+
+			member, err := hashTaggedLeaderboards.GetMember(NewEmptyCtx(), leaderboardID, "dayvson", "desc", true, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(float64(481516)))
+			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Unix()+100, 1))
+
+			err = hashTaggedLeaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should migrate leaderboards from V1 to V2 keys", func() {
+			from := database.Keys{Version: database.KeySchemaV1, Prefix: "podium-migration:"}
+			to := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-migration:"}
+			legacyDatabase := &database.Redis{Client: redisDatabase.Client, Keys: from}
+			hashTaggedDatabase := &database.Redis{Client: redisDatabase.Client, Keys: to}
+			legacyLeaderboards := service.NewService(legacyDatabase, service.WithIdempotencyWindow(time.Minute))
+			hashTaggedLeaderboards := service.NewService(hashTaggedDatabase)
+
+			leaderboardID := fmt.Sprintf("year%d", time.Now().UTC().Year())
+			collidingLeaderboardID := uuid.NewV4().String() + ":ttl"
+			defer func() {
+				hashTaggedLeaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+				hashTaggedLeaderboards.RemoveLeaderboard(NewEmptyCtx(), collidingLeaderboardID)
+				redisDatabase.Del(context.Background(), to.LeaderboardTTL(leaderboardID))
+				redisDatabase.Del(context.Background(), to.ExpirationSet())
+			}()
+
+			_, err := legacyLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 481516, false, "100", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = legacyLeaderboards.SetMemberScore(service.ContextWithIdempotencyKey(NewEmptyCtx(), "retry-1"), leaderboardID, "arthur", 1000, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = legacyLeaderboards.SetMemberScore(NewEmptyCtx(), collidingLeaderboardID, "arthur", 10, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			migrated, err := hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{BatchSize: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(2))
+
+			members, err := hashTaggedLeaderboards.GetMembers(NewEmptyCtx(), leaderboardID, []string{"dayvson", "arthur"}, "desc", true, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].Rank).To(Equal(1))
+			Expect(members[0].ExpireAt).To(BeNumerically("~", time.Now().Unix()+100, 1))
+			Expect(members[1].Rank).To(Equal(2))
+
+			expiration, err := hashTaggedDatabase.GetLeaderboardExpiration(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiration).To(BeNumerically(">", 0))
+
+			member, err := hashTaggedLeaderboards.GetMember(NewEmptyCtx(), collidingLeaderboardID, "arthur", "desc", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(float64(10)))
+
+			expirationLeaderboards, err := hashTaggedDatabase.GetExpirationLeaderboards(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expirationLeaderboards).To(Equal([]string{leaderboardID}))
+
+			err = redisDatabase.Exists(context.Background(), from.Leaderboard(leaderboardID))
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.Leaderboard(leaderboardID))))
+			err = redisDatabase.Exists(context.Background(), from.LeaderboardTTL(leaderboardID))
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.LeaderboardTTL(leaderboardID))))
+			err = redisDatabase.Exists(context.Background(), from.LeaderboardScores(leaderboardID))
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.LeaderboardScores(leaderboardID))))
+
+			replayed, err := hashTaggedDatabase.GetIdempotentMembers(NewEmptyCtx(), leaderboardID, "retry-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(HaveLen(1))
+			Expect(replayed[0].Member).To(Equal("arthur"))
+			for _, key := range []string{from.LeaderboardIdempotencyKeys(leaderboardID), from.LeaderboardIdempotency(leaderboardID, "retry-1")} {
+				err = redisDatabase.Exists(context.Background(), key)
+				Expect(err).To(MatchError(redis.NewKeyNotFoundError(key)))
+			}
+
+			_, err = hashTaggedDatabase.CountScoresAhead(NewEmptyCtx(), leaderboardID, "desc", 1000)
+			Expect(err).To(HaveOccurred())
+
+			indexed, err := hashTaggedDatabase.IndexScores(NewEmptyCtx(), &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(indexed).To(Equal(2))
+
+			counts, err := hashTaggedDatabase.CountScoresAhead(NewEmptyCtx(), leaderboardID, "desc", 1000)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal([]int{1}))
+
+			indexed, err = hashTaggedDatabase.IndexScores(NewEmptyCtx(), &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(indexed).To(Equal(0))
+
+			migrated, err = hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(0))
+		})
+
+		It("should only move leaderboards matching the given patterns and write nothing in a dry run", func() {
+			from := database.Keys{Version: database.KeySchemaV1, Prefix: "podium-migration:"}
+			to := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-migration-v2:"}
+			legacyDatabase := &database.Redis{Client: redisDatabase.Client, Keys: from}
+			hashTaggedDatabase := &database.Redis{Client: redisDatabase.Client, Keys: to}
+			leaderboardID := "selected-" + uuid.NewV4().String()
+			otherLeaderboardID := "other-" + uuid.NewV4().String()
+			defer func() {
+				redisDatabase.Del(context.Background(), to.Leaderboard(leaderboardID))
+				redisDatabase.Del(context.Background(), from.Leaderboard(otherLeaderboardID))
+				redisDatabase.Del(context.Background(), from.LeaderboardScores(otherLeaderboardID))
+			}()
+
+			for _, id := range []string{leaderboardID, otherLeaderboardID} {
+				_, err := legacyDatabase.UpsertMembers(NewEmptyCtx(), id, []*database.Member{{Member: "dayvson", Score: 10}}, &database.UpsertOptions{Order: "desc"})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), database.Keys{Version: database.KeySchemaV1}, &database.MigrationOptions{DryRun: true})
+			Expect(err).To(HaveOccurred())
+
+			migrated, err := hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), database.Keys{Version: database.KeySchemaV1}, &database.MigrationOptions{
+				Leaderboards: []string{"podium-migration:selected-*"},
+				DryRun:       true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(BeNumerically(">=", 1))
+
+			migrated, err = hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{Leaderboards: []string{"selected-*"}, DryRun: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+			err = redisDatabase.Exists(context.Background(), from.Leaderboard(leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+
+			migrated, err = hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{Leaderboards: []string{"selected-*"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+
+			err = redisDatabase.Exists(context.Background(), to.Leaderboard(leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Exists(context.Background(), from.Leaderboard(leaderboardID))
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.Leaderboard(leaderboardID))))
+			err = redisDatabase.Exists(context.Background(), from.Leaderboard(otherLeaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should migrate quarantined scores and submission counts with their leaderboard", func() {
+			from := database.Keys{Version: database.KeySchemaV1, Prefix: "podium-migration-" + uuid.NewV4().String() + ":"}
+			to := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-migration-v2:"}
+			legacyDatabase := &database.Redis{Client: redisDatabase.Client, Keys: from}
+			hashTaggedDatabase := &database.Redis{Client: redisDatabase.Client, Keys: to}
+			leaderboardID := uuid.NewV4().String()
+			defer func() {
+				redisDatabase.Del(context.Background(), to.Leaderboard(leaderboardID))
+				redisDatabase.Del(context.Background(), to.LeaderboardQuarantine(leaderboardID))
+				redisDatabase.Del(context.Background(), to.LeaderboardSubmissions(leaderboardID))
+			}()
+
+			submittedAt := time.Unix(time.Now().Unix(), 0)
+			maxScore := 1000.0
+			rules := &database.ScoreRules{MaxScore: &maxScore, MaxSubmissions: 1, SubmissionWindow: time.Hour, Quarantine: true, Now: submittedAt}
+			_, err := legacyDatabase.UpsertMembers(NewEmptyCtx(), leaderboardID, []*database.Member{
+				{Member: "dayvson", Score: 10},
+				{Member: "arthur", Score: 5000},
+			}, &database.UpsertOptions{Order: "desc", ScoreRules: rules})
+			Expect(err).NotTo(HaveOccurred())
+
+			migrated, err := hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+
+			quarantined, err := hashTaggedDatabase.GetQuarantinedMembers(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quarantined).To(HaveLen(1))
+			Expect(quarantined[0].Member).To(Equal("arthur"))
+			Expect(quarantined[0].SubmittedAt.Equal(submittedAt)).To(BeTrue())
+
+			ttl, err := redisDatabase.Client.TTL(context.Background(), to.LeaderboardSubmissions(leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ttl).To(BeNumerically(">", 0))
+
+			rules.Quarantine = false
+			_, err = hashTaggedDatabase.UpsertMembers(NewEmptyCtx(), leaderboardID, []*database.Member{{Member: "dayvson", Score: 20}}, &database.UpsertOptions{Order: "desc", ScoreRules: rules})
+			Expect(err).To(Equal(database.NewScoreRuleViolationError(leaderboardID, "dayvson", "max_submissions", 2, 1)))
+
+			for _, key := range []string{from.LeaderboardQuarantine(leaderboardID), from.LeaderboardSubmissions(leaderboardID)} {
+				err = redisDatabase.Exists(context.Background(), key)
+				Expect(err).To(MatchError(redis.NewKeyNotFoundError(key)))
+			}
+		})
+
+		It("should migrate leaderboard definitions keeping the ones already registered", func() {
+			from := database.Keys{Version: database.KeySchemaV1, Prefix: "podium-migration:"}
+			to := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-migration-v2:"}
+			legacyDatabase := &database.Redis{Client: redisDatabase.Client, Keys: from}
+			hashTaggedDatabase := &database.Redis{Client: redisDatabase.Client, Keys: to}
+			defer func() {
+				redisDatabase.Del(context.Background(), from.LeaderboardDefinitions())
+				redisDatabase.Del(context.Background(), to.LeaderboardDefinitions())
+			}()
+
+			err := legacyDatabase.CreateLeaderboardDefinition(NewEmptyCtx(), &database.LeaderboardDefinition{ID: "weekly", Order: "asc"})
+			Expect(err).NotTo(HaveOccurred())
+			err = legacyDatabase.CreateLeaderboardDefinition(NewEmptyCtx(), &database.LeaderboardDefinition{ID: "daily", Order: "asc"})
+			Expect(err).NotTo(HaveOccurred())
+			err = hashTaggedDatabase.CreateLeaderboardDefinition(NewEmptyCtx(), &database.LeaderboardDefinition{ID: "daily", Order: "desc"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			definitions, err := hashTaggedDatabase.ListLeaderboardDefinitions(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0].ID).To(Equal("daily"))
+			Expect(definitions[0].Order).To(Equal("desc"))
+			Expect(definitions[1].ID).To(Equal("weekly"))
+			Expect(definitions[1].Order).To(Equal("asc"))
+
+			err = redisDatabase.Exists(context.Background(), from.LeaderboardDefinitions())
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.LeaderboardDefinitions())))
+		})
+
+		It("should migrate archived leaderboards", func() {
+			from := database.Keys{Version: database.KeySchemaV1, Prefix: "podium-migration:"}
+			to := database.Keys{Version: database.KeySchemaV2, Prefix: "podium-migration-v2:"}
+			legacyDatabase := &database.Redis{Client: redisDatabase.Client, Keys: from}
+			hashTaggedDatabase := &database.Redis{Client: redisDatabase.Client, Keys: to}
+			leaderboardID := uuid.NewV4().String()
+			defer func() {
+				redisDatabase.Del(context.Background(), to.Leaderboard(leaderboardID))
+				redisDatabase.Del(context.Background(), to.LeaderboardArchive(leaderboardID))
+				redisDatabase.Del(context.Background(), to.LeaderboardArchives())
+				redisDatabase.Del(context.Background(), to.SeasonRollovers())
+			}()
+
+			seasonEnd := time.Now().Add(-time.Minute)
+			_, err := legacyDatabase.UpsertMembers(NewEmptyCtx(), leaderboardID, []*database.Member{{Member: "dayvson", Score: 10}},
+				&database.UpsertOptions{Order: "desc", SeasonEnd: seasonEnd})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = legacyDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = legacyDatabase.UpsertMembers(NewEmptyCtx(), leaderboardID, []*database.Member{{Member: "arthur", Score: 20}},
+				&database.UpsertOptions{Order: "desc", SeasonEnd: seasonEnd})
+			Expect(err).NotTo(HaveOccurred())
+
+			migrated, err := hashTaggedDatabase.MigrateKeys(NewEmptyCtx(), from, &database.MigrationOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+
+			archive, err := hashTaggedDatabase.GetLeaderboardArchive(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archive.TotalMembers).To(Equal(1))
+
+			members, err := hashTaggedDatabase.GetArchivedMembers(NewEmptyCtx(), leaderboardID, "desc", "dayvson", "arthur")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members[0].Score).To(Equal(float64(10)))
+			Expect(members[1]).To(BeNil())
+
+			leaderboardsToArchive, err := hashTaggedDatabase.GetLeaderboardsToArchive(NewEmptyCtx(), time.Now(), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboardsToArchive).To(Equal([]string{leaderboardID}))
+
+			err = redisDatabase.Exists(context.Background(), from.LeaderboardArchive(leaderboardID))
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.LeaderboardArchive(leaderboardID))))
+			err = redisDatabase.Exists(context.Background(), from.SeasonRollovers())
+			Expect(err).To(MatchError(redis.NewKeyNotFoundError(from.SeasonRollovers())))
+		})
+
+		It("should index distinct scores of leaderboards written before the index existed in batches", func() {
+			leaderboardID := uuid.NewV4().String()
+			defer redisDatabase.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+
+			err := redisDatabase.ZAdd(context.Background(), leaderboardID,
+				&redis.Member{Member: "member1", Score: 10},
+				&redis.Member{Member: "member2", Score: 20},
+				&redis.Member{Member: "member3", Score: 20},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = redisDatabase.SetMembers(NewEmptyCtx(), leaderboardID, []*database.Member{{Member: "member4", Score: 30}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.CountScoresAhead(NewEmptyCtx(), leaderboardID, "desc", 30)
+			Expect(err).To(MatchError(ContainSubstring("aren't indexed")))
+
+			indexed, err := redisDatabase.IndexScores(NewEmptyCtx(), &database.MigrationOptions{DryRun: true, Leaderboards: []string{leaderboardID}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(indexed).To(Equal(1))
+
+			indexed, err = redisDatabase.IndexScores(NewEmptyCtx(), &database.MigrationOptions{Leaderboards: []string{leaderboardID}, BatchSize: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(indexed).To(Equal(1))
+
+			counts, err := redisDatabase.CountScoresAhead(NewEmptyCtx(), leaderboardID, "desc", 30, 20, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal([]int{0, 1, 2}))
+
+			err = redisDatabase.RemoveMembers(NewEmptyCtx(), leaderboardID, "member2", "member4")
+			Expect(err).NotTo(HaveOccurred())
+
+			counts, err = redisDatabase.CountScoresAhead(NewEmptyCtx(), leaderboardID, "desc", 20, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal([]int{0, 1}))
+		})
+	})
+
+	Describe("leaderboard archive", func() {
+		var archivedLeaderboards *service.Service
+		var leaderboardID string
+
+		BeforeEach(func() {
+			archivedLeaderboards = service.NewService(redisDatabase, service.WithArchive(redisDatabase, func(string) bool {
+				return true
+			}))
+
+			now := time.Now().UTC()
+			leaderboardID = fmt.Sprintf("%s-from%dto%d", uuid.NewV4().String(), now.Add(-time.Hour).Unix(), now.Add(2*time.Second).Unix())
+		})
+
+		AfterEach(func() {
+			redisDatabase.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			redisDatabase.Del(context.Background(), redisDatabase.Keys.LeaderboardArchive(leaderboardID))
+			redisDatabase.ZRem(context.Background(), redisDatabase.Keys.SeasonRollovers(), leaderboardID)
+			redisDatabase.Del(context.Background(), redisDatabase.Keys.LeaderboardArchives())
+		})
+
+		It("should keep final standings once season ends", func() {
+			for i, memberID := range []string{"dayvson", "arthur", "felipe"} {
+				_, err := archivedLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, memberID, float64(100-i), false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			leaderboardsToArchive, err := redisDatabase.GetLeaderboardsToArchive(NewEmptyCtx(), time.Now(), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboardsToArchive).NotTo(ContainElement(leaderboardID))
+
+			leaderboardsToArchive, err = redisDatabase.GetLeaderboardsToArchive(NewEmptyCtx(), time.Now().Add(time.Minute), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboardsToArchive).To(ContainElement(leaderboardID))
+
+			archive, err := redisDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archive.ID).To(Equal(leaderboardID))
+			Expect(archive.TotalMembers).To(Equal(3))
+
+			err = redisDatabase.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+
+			leaders, err := archivedLeaderboards.GetArchivedLeaders(NewEmptyCtx(), leaderboardID, 2, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaders).To(Equal([]*model.Member{{PublicID: "felipe", Score: 98, Rank: 3}}))
+
+			member, err := archivedLeaderboards.GetArchivedMember(NewEmptyCtx(), leaderboardID, "arthur", "asc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(Equal(&model.Member{PublicID: "arthur", Score: 99, Rank: 2}))
+
+			leaderboardArchive, err := archivedLeaderboards.GetLeaderboardArchive(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboardArchive.TotalMembers).To(Equal(3))
+			Expect(leaderboardArchive.SeasonEnd).To(BeNumerically(">", time.Now().Unix()-10))
+		})
+
+		It("should keep the first archive if leaderboard is archived again", func() {
+			_, err := archivedLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 100, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			first, err := redisDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = archivedLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "arthur", 100, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			second, err := redisDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(Equal(first))
+
+			_, err = archivedLeaderboards.GetArchivedMember(NewEmptyCtx(), leaderboardID, "arthur", "desc")
+			Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboardID, "arthur")))
+		})
+
+		It("should evaluate reward brackets once season ends", func() {
+			defer redisDatabase.CompleteRewardDelivery(NewEmptyCtx(), leaderboardID)
+
+			for i, memberID := range []string{"dayvson", "arthur", "felipe"} {
+				_, err := archivedLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, memberID, float64(100-i), false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := redisDatabase.ArchiveLeaderboard(NewEmptyCtx(), leaderboardID, &database.ArchiveOptions{
+				RewardTiers:    []rewards.Tier{{Name: "gold", MaxRank: 1}, {Name: "silver", TopPercent: 50}},
+				RewardOrder:    "desc",
+				DeliverRewards: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			leaderboardArchive, err := archivedLeaderboards.GetLeaderboardArchive(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboardArchive.RewardBrackets).To(Equal([]*model.RewardBracket{
+				{Name: "gold", MinRank: 1, MaxRank: 1},
+				{Name: "silver", MinRank: 2, MaxRank: 2},
+			}))
+
+			member, err := archivedLeaderboards.GetArchivedMemberReward(NewEmptyCtx(), leaderboardID, "arthur")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(Equal(&model.Member{PublicID: "arthur", Score: 99, Rank: 2, RewardBracket: "silver"}))
+
+			deliveries, err := redisDatabase.GetRewardDeliveries(NewEmptyCtx(), time.Now(), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(ContainElement(leaderboardID))
+		})
+
+		It("should retry reward deliveries until they fail max attempts times", func() {
+			defer redisDatabase.CompleteRewardDelivery(NewEmptyCtx(), leaderboardID)
+
+			attempts, err := redisDatabase.RetryRewardDelivery(NewEmptyCtx(), leaderboardID, 2, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts).To(Equal(1))
+
+			deliveries, err := redisDatabase.GetRewardDeliveries(NewEmptyCtx(), time.Now(), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).NotTo(ContainElement(leaderboardID))
+
+			deliveries, err = redisDatabase.GetRewardDeliveries(NewEmptyCtx(), time.Now().Add(time.Hour+time.Minute), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(ContainElement(leaderboardID))
+
+			attempts, err = redisDatabase.RetryRewardDelivery(NewEmptyCtx(), leaderboardID, 2, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts).To(Equal(2))
+
+			deliveries, err = redisDatabase.GetRewardDeliveries(NewEmptyCtx(), time.Now().Add(24*time.Hour), 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).NotTo(ContainElement(leaderboardID))
+		})
+
+		It("should return LeaderboardArchiveNotFoundError if leaderboard isn't archived", func() {
+			_, err := archivedLeaderboards.GetLeaderboardArchive(NewEmptyCtx(), leaderboardID)
+			Expect(err).To(Equal(service.NewLeaderboardArchiveNotFoundError(leaderboardID)))
+		})
+	})
+
+	Describe("leagues", func() {
+		var leagueLeaderboards *service.Service
+		var leagueID string
+
+		BeforeEach(func() {
+			leagueID = "league-" + uuid.NewV4().String()
+			leagueLeaderboards = service.NewService(redisDatabase, service.WithLeagues(redisDatabase, func(leaderboard string) *service.League {
+				if leaderboard == leagueID {
+					return &service.League{BucketSize: 2}
+				}
+				return nil
+			}))
+		})
+
+		AfterEach(func() {
+			err := leagueLeaderboards.RemoveLeaderboard(NewEmptyCtx(), leagueID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rank members against the members of their bucket", func() {
+			for i, memberID := range []string{"dayvson", "arthur", "felipe"} {
+				member, err := leagueLeaderboards.SetMemberScore(NewEmptyCtx(), leagueID, memberID, float64(100-i), false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(member.Rank).To(Equal(i%2 + 1))
+			}
+
+			_, err := leagueLeaderboards.IncrementMemberScore(NewEmptyCtx(), leagueID, "felipe", 10, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			members, err := leagueLeaderboards.GetAroundMe(NewEmptyCtx(), leagueID, 10, "arthur", "", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*model.Member{
+				{PublicID: "dayvson", Score: 100, Rank: 1},
+				{PublicID: "arthur", Score: 99, Rank: 2},
+			}))
+
+			bucket, err := leagueLeaderboards.GetMemberLeaderboard(NewEmptyCtx(), leagueID, "felipe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucket).To(Equal(service.LeagueBucket(leagueID, 1)))
+
+			leaders, err := leagueLeaderboards.GetLeaders(NewEmptyCtx(), bucket, 10, 1, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaders).To(Equal([]*model.Member{{PublicID: "felipe", Score: 108, Rank: 1}}))
+
+			leagueMembers, err := leagueLeaderboards.GetMembers(NewEmptyCtx(), leagueID, []string{"felipe", "dayvson", "unknown"}, "", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leagueMembers).To(Equal([]*model.Member{
+				{PublicID: "felipe", Score: 108, Rank: 1},
+				{PublicID: "dayvson", Score: 100, Rank: 1},
+			}))
+		})
+
+		It("should return MemberNotFoundError if member isn't in any bucket", func() {
+			_, err := leagueLeaderboards.GetRank(NewEmptyCtx(), leagueID, "dayvson", "", "")
+			Expect(err).To(Equal(service.NewMemberNotFoundError(leagueID, "dayvson")))
+		})
+	})
+
+	Describe("tiered leagues", func() {
+		var tieredLeaderboards *service.Service
+		var tieredLeague *service.TieredLeague
+		var competition string
+
+		BeforeEach(func() {
+			competition = "tiered-" + uuid.NewV4().String()
+			tieredLeague = &service.TieredLeague{
+				Prefix:     competition + "-",
+				Cadence:    expiration.CadenceDaily,
+				Tiers:      []string{"bronze", "silver", "gold"},
+				BucketSize: 10,
+				Promote:    1,
+				Relegate:   1,
+			}
+			tieredLeaderboards = service.NewService(redisDatabase, service.WithTieredLeagues(redisDatabase, func(leagueID string) *service.TieredLeague {
+				if leagueID == competition {
+					return tieredLeague
+				}
+				return nil
+			}))
+		})
+
+		AfterEach(func() {
+			redisDatabase.Del(NewEmptyCtx(), redisDatabase.Keys.TierHistory(competition))
+		})
+
+		It("should keep one tier movement per season in member history", func() {
+			seasonEnd := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+			movement := &database.TierMovement{
+				Leaderboard: tieredLeague.Leaderboard("bronze", seasonEnd.Add(-time.Minute)) + "~0",
+				SeasonEnd:   seasonEnd,
+				Tier:        "bronze",
+				Rank:        1,
+				Movement:    service.TierPromoted,
+				NextTier:    "silver",
+			}
+			for i := 0; i < 2; i++ {
+				err := redisDatabase.RecordTierMovements(NewEmptyCtx(), competition, map[string]*database.TierMovement{"dayvson": movement})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			memberTier, err := tieredLeaderboards.GetMemberTier(NewEmptyCtx(), competition, "dayvson")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(memberTier.Tier).To(Equal("silver"))
+			Expect(memberTier.Leaderboard).To(Equal(tieredLeague.Leaderboard("silver", time.Now())))
+			Expect(memberTier.History).To(Equal([]*model.TierMovement{{
+				Leaderboard: movement.Leaderboard,
+				SeasonEnd:   int(seasonEnd.Unix()),
+				Tier:        "bronze",
+				Rank:        1,
+				Movement:    service.TierPromoted,
+				NextTier:    "silver",
+			}}))
+
+			memberTier, err = tieredLeaderboards.GetMemberTier(NewEmptyCtx(), competition, "arthur")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(memberTier.Tier).To(Equal("bronze"))
+			Expect(memberTier.History).To(BeEmpty())
+		})
+	})
+
+	Describe("rating leaderboards", func() {
+		var ratedLeaderboards *service.Service
+		var leaderboardID string
+		var settings = rating.WithDefaults(rating.Settings{Algorithm: rating.AlgorithmGlicko2})
+
+		BeforeEach(func() {
+			leaderboardID = "rated-" + uuid.NewV4().String()
+			ratedLeaderboards = service.NewService(redisDatabase, service.WithRatings(redisDatabase, func(leaderboard string) *rating.Settings {
+				return &settings
+			}))
+		})
+
+		AfterEach(func() {
+			ratedLeaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+		})
+
+		It("should rate every concurrent match of the same members", func() {
+			matches := 5
+			errs := make(chan error, matches)
+			for i := 0; i < matches; i++ {
+				go func() {
+					_, err := ratedLeaderboards.SubmitMatchResult(NewEmptyCtx(), leaderboardID, []*model.MatchResult{
+						{PublicID: "dayvson", Outcome: service.MatchOutcomeWin},
+						{PublicID: "arthur", Outcome: service.MatchOutcomeLoss},
+					})
+					errs <- err
+				}()
+			}
+			for i := 0; i < matches; i++ {
+				Expect(<-errs).NotTo(HaveOccurred())
+			}
+
+			winner, err := ratedLeaderboards.GetMemberRating(NewEmptyCtx(), leaderboardID, "dayvson")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(winner.Matches).To(Equal(matches))
+			Expect(winner.Rank).To(Equal(1))
+
+			loser, err := ratedLeaderboards.GetMemberRating(NewEmptyCtx(), leaderboardID, "arthur")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loser.Matches).To(Equal(matches))
+			Expect(loser.Deviation).To(BeNumerically("<", rating.DefaultDeviation))
+
+			member, err := ratedLeaderboards.GetMember(NewEmptyCtx(), leaderboardID, "arthur", "desc", false, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(float64(loser.Score)))
+			Expect(member.Rank).To(Equal(2))
+		})
+	})
 })
@@ -8,3 +8,5 @@ import (
 var _ service.Leaderboard = &service.Service{}
 var _ database.Database = &database.Redis{}
 var _ database.Expiration = &database.Redis{}
+var _ database.Database = &database.Memory{}
+var _ database.Expiration = &database.Memory{}
@@ -0,0 +1,19 @@
+package model
+
+// LeaderboardArchive describe the final standings of a leaderboard season, times are unix seconds
+type LeaderboardArchive struct {
+	ID           string `json:"id"`
+	SeasonEnd    int    `json:"seasonEnd"`
+	ArchivedAt   int    `json:"archivedAt"`
+	TotalMembers int    `json:"totalMembers"`
+	// RewardOrder is the order members are ranked in by RewardBrackets
+	RewardOrder    string           `json:"rewardOrder,omitempty"`
+	RewardBrackets []*RewardBracket `json:"rewardBrackets,omitempty"`
+}
+
+// RewardBracket is the range of final ranks rewarded with a reward tier
+type RewardBracket struct {
+	Name    string `json:"name"`
+	MinRank int    `json:"minRank"`
+	MaxRank int    `json:"maxRank"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// LeaderboardDefinition is how a registered leaderboard behaves when requests don't say it,
+// times are unix seconds and zero values keep the implicit behaviour of unregistered leaderboards
+type LeaderboardDefinition struct {
+	ID              string `json:"id"`
+	DisplayName     string `json:"displayName"`
+	Order           string `json:"order"`
+	UpdatePolicy    string `json:"updatePolicy"`
+	DefaultPageSize int    `json:"defaultPageSize"`
+	MaxPageSize     int    `json:"maxPageSize"`
+	StartTime       int    `json:"startTime"`
+	EndTime         int    `json:"endTime"`
+	Retention       int    `json:"retention"`
+	CreatedAt       int    `json:"createdAt"`
+	UpdatedAt       int    `json:"updatedAt"`
+}
@@ -3,9 +3,18 @@ package model
 // Member maps a member identified by their publicID to their score and rank
 type Member struct {
 	PublicID     string            `json:"publicID"`
-	Score        int64             `json:"score"`
+	Score        float64           `json:"score"`
+	Scores       []int64           `json:"scores,omitempty"`
 	Rank         int               `json:"rank"`
 	PreviousRank int               `json:"previousRank"`
 	ExpireAt     int               `json:"expireAt"`
+	ScoreChanged bool              `json:"scoreChanged"`
 	Metadata     map[string]string `json:"metadata"`
+	// RewardBracket is the reward bracket member was assigned when its leaderboard was archived
+	RewardBracket string `json:"rewardBracket,omitempty"`
+	// Quarantined is set when the score write of member broke a score rule and was held for review instead
+	// of being ranked
+	Quarantined bool `json:"quarantined,omitempty"`
+	// Precondition, when set, must hold for member score to be written
+	Precondition *Precondition `json:"-"`
 }
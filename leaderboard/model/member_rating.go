@@ -0,0 +1,22 @@
+package model
+
+// MatchResult is how a member finished a match, either its Placement, starting at 1, or its Outcome
+type MatchResult struct {
+	PublicID  string `json:"publicID"`
+	Placement int    `json:"placement"`
+	Outcome   string `json:"outcome"`
+}
+
+// MemberRating is the rating of a member of a rating leaderboard, its score is the rounded rating and
+// Deviation and Volatility are only kept by Glicko-2
+type MemberRating struct {
+	PublicID       string  `json:"publicID"`
+	Score          int64   `json:"score"`
+	Rank           int     `json:"rank"`
+	PreviousRank   int     `json:"previousRank"`
+	Rating         float64 `json:"rating"`
+	PreviousRating float64 `json:"previousRating"`
+	Deviation      float64 `json:"deviation"`
+	Volatility     float64 `json:"volatility"`
+	Matches        int     `json:"matches"`
+}
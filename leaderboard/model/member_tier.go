@@ -0,0 +1,21 @@
+package model
+
+// MemberTier is the tier a member plays the current season of a tiered league in and how it finished
+// previous seasons, times are unix seconds
+type MemberTier struct {
+	PublicID string `json:"publicID"`
+	Tier     string `json:"tier"`
+	// Leaderboard is the tier leaderboard of the current season, member scores are written to it
+	Leaderboard string          `json:"leaderboard"`
+	History     []*TierMovement `json:"history"`
+}
+
+// TierMovement is how a member finished a season of a tiered league, from the oldest season to the latest
+type TierMovement struct {
+	Leaderboard string `json:"leaderboard"`
+	SeasonEnd   int    `json:"seasonEnd"`
+	Tier        string `json:"tier"`
+	Rank        int    `json:"rank"`
+	Movement    string `json:"movement"`
+	NextTier    string `json:"nextTier"`
+}
@@ -0,0 +1,9 @@
+package model
+
+// Precondition is what the stored score of a member must be for its score to be written, ExpectedScore is
+// compared with the score as it's returned, at most one of ExpectedScore, IfAbsent and IfPresent can be set
+type Precondition struct {
+	ExpectedScore *float64
+	IfAbsent      bool
+	IfPresent     bool
+}
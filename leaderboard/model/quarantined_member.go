@@ -0,0 +1,10 @@
+package model
+
+// QuarantinedMember is a score write held for review because it broke a leaderboard score rule, Score is the
+// score member would have ended up with and SubmittedAt is unix seconds
+type QuarantinedMember struct {
+	PublicID    string  `json:"publicID"`
+	Score       float64 `json:"score"`
+	Rule        string  `json:"rule"`
+	SubmittedAt int     `json:"submittedAt"`
+}
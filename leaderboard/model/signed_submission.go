@@ -0,0 +1,19 @@
+package model
+
+// SignedSubmission is a score submission signed by a client with its tenant signing key, Members hold the
+// submitted scores, or increments, along with their preconditions and Timestamp is unix seconds, the other
+// fields are the write options as sent, ScoreTTL is in seconds and zero if scores don't expire. NonceClaimed is
+// set once the submission is verified by claiming Nonce, so it can be released if the write fails
+type SignedSubmission struct {
+	Operation      string
+	Leaderboard    string
+	Members        []*Member
+	UpdatePolicy   string
+	ScoreTTL       int64
+	PrevRank       bool
+	IdempotencyKey string
+	Timestamp      int64
+	Nonce          string
+	Signature      string
+	NonceClaimed   bool
+}
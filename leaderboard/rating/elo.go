@@ -0,0 +1,31 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rating
+
+import "math"
+
+// updateElo moves each rating by KFactor times the mean difference between its outcomes and expected outcomes,
+// so a match moves ratings as much as a single duel regardless of how many players it has
+func updateElo(settings Settings, ratings []Rating, placements []int) []Rating {
+	updated := make([]Rating, len(ratings))
+	for i, player := range ratings {
+		difference := 0.0
+		for j, opponent := range ratings {
+			if i == j {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, (opponent.Rating-player.Rating)/400))
+			difference += outcome(placements[i], placements[j]) - expected
+		}
+
+		updated[i] = Rating{Rating: player.Rating + settings.KFactor*difference/float64(len(ratings)-1)}
+	}
+	return updated
+}
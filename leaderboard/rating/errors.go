@@ -0,0 +1,40 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rating
+
+import "fmt"
+
+// InvalidSettingsError identifies that rating settings are misconfigured
+type InvalidSettingsError struct {
+	Reason string
+}
+
+// NewInvalidSettingsError returns a new InvalidSettingsError
+func NewInvalidSettingsError(reason string) *InvalidSettingsError {
+	return &InvalidSettingsError{Reason: reason}
+}
+
+func (e *InvalidSettingsError) Error() string {
+	return fmt.Sprintf("invalid rating settings: %s", e.Reason)
+}
+
+// InvalidMatchError identifies that a match result can't be rated
+type InvalidMatchError struct {
+	Reason string
+}
+
+// NewInvalidMatchError returns a new InvalidMatchError
+func NewInvalidMatchError(reason string) *InvalidMatchError {
+	return &InvalidMatchError{Reason: reason}
+}
+
+func (e *InvalidMatchError) Error() string {
+	return fmt.Sprintf("invalid match: %s", e.Reason)
+}
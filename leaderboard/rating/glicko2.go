@@ -0,0 +1,94 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rating
+
+import "math"
+
+const (
+	// glicko2Scale converts ratings and deviations from the Glicko scale to the Glicko-2 one
+	glicko2Scale = 173.7178
+	// glicko2Center is the rating at the center of the Glicko-2 scale
+	glicko2Center = 1500
+	// glicko2Convergence is the tolerance of the volatility iteration
+	glicko2Convergence = 0.000001
+)
+
+// updateGlicko2 rates each player as if the match was a rating period in which it played every other player,
+// following Glickman's description of the Glicko-2 system
+func updateGlicko2(settings Settings, ratings []Rating, placements []int) []Rating {
+	updated := make([]Rating, len(ratings))
+	for i, player := range ratings {
+		mu := (player.Rating - glicko2Center) / glicko2Scale
+		phi := player.Deviation / glicko2Scale
+
+		variance := 0.0
+		improvement := 0.0
+		for j, opponent := range ratings {
+			if i == j {
+				continue
+			}
+			opponentMu := (opponent.Rating - glicko2Center) / glicko2Scale
+			g := 1 / math.Sqrt(1+3*math.Pow(opponent.Deviation/glicko2Scale, 2)/(math.Pi*math.Pi))
+			expected := 1 / (1 + math.Exp(-g*(mu-opponentMu)))
+
+			variance += g * g * expected * (1 - expected)
+			improvement += g * (outcome(placements[i], placements[j]) - expected)
+		}
+		variance = 1 / variance
+		delta := variance * improvement
+
+		volatility := glicko2Volatility(settings.Tau, phi, player.Volatility, variance, delta)
+		phiStar := math.Sqrt(phi*phi + volatility*volatility)
+		newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/variance)
+		newMu := mu + newPhi*newPhi*improvement
+
+		updated[i] = Rating{
+			Rating:     glicko2Center + glicko2Scale*newMu,
+			Deviation:  glicko2Scale * newPhi,
+			Volatility: volatility,
+		}
+	}
+	return updated
+}
+
+// glicko2Volatility finds the new volatility with the Illinois algorithm
+func glicko2Volatility(tau, phi, volatility, variance, delta float64) float64 {
+	a := math.Log(volatility * volatility)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		return ex*(delta*delta-phi*phi-variance-ex)/(2*math.Pow(phi*phi+variance+ex, 2)) - (x-a)/(tau*tau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+variance {
+		upper = math.Log(delta*delta - phi*phi - variance)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		upper = a - k*tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glicko2Convergence {
+		next := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fNext := f(next)
+		if fNext*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = next, fNext
+	}
+
+	return math.Exp(lower / 2)
+}
@@ -0,0 +1,129 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rating
+
+// Algorithms ratings are updated with
+const (
+	// AlgorithmElo moves ratings by KFactor times the difference between the outcome and the expected one
+	AlgorithmElo = "elo"
+	// AlgorithmGlicko2 also keeps how reliable a rating is, its deviation, and how erratic, its volatility
+	AlgorithmGlicko2 = "glicko2"
+)
+
+// Default settings of unset fields
+const (
+	DefaultRating     = 1500
+	DefaultKFactor    = 32
+	DefaultDeviation  = 350
+	DefaultVolatility = 0.06
+	DefaultTau        = 0.5
+)
+
+// Rating is the skill estimate of a player, Deviation and Volatility are only kept by Glicko-2
+type Rating struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
+// Settings configure how ratings are updated, KFactor is only used by Elo and InitialDeviation,
+// InitialVolatility and Tau only by Glicko-2
+type Settings struct {
+	Algorithm         string
+	InitialRating     float64
+	KFactor           float64
+	InitialDeviation  float64
+	InitialVolatility float64
+	Tau               float64
+}
+
+// WithDefaults returns settings with unset fields taken from the defaults, the algorithm defaults to Elo
+func WithDefaults(settings Settings) Settings {
+	if settings.Algorithm == "" {
+		settings.Algorithm = AlgorithmElo
+	}
+	if settings.InitialRating == 0 {
+		settings.InitialRating = DefaultRating
+	}
+	if settings.KFactor == 0 {
+		settings.KFactor = DefaultKFactor
+	}
+	if settings.InitialDeviation == 0 {
+		settings.InitialDeviation = DefaultDeviation
+	}
+	if settings.InitialVolatility == 0 {
+		settings.InitialVolatility = DefaultVolatility
+	}
+	if settings.Tau == 0 {
+		settings.Tau = DefaultTau
+	}
+	return settings
+}
+
+// ValidateSettings returns an error if the algorithm is unknown or any setting isn't positive
+func ValidateSettings(settings Settings) error {
+	if settings.Algorithm != AlgorithmElo && settings.Algorithm != AlgorithmGlicko2 {
+		return NewInvalidSettingsError("unknown algorithm " + settings.Algorithm)
+	}
+	if settings.InitialRating < 0 {
+		return NewInvalidSettingsError("initial rating can't be negative")
+	}
+	if settings.KFactor <= 0 || settings.InitialDeviation <= 0 || settings.InitialVolatility <= 0 || settings.Tau <= 0 {
+		return NewInvalidSettingsError("k factor, initial deviation, initial volatility and tau must be positive")
+	}
+	return nil
+}
+
+// Initial returns the rating of players that never played a match
+func Initial(settings Settings) Rating {
+	if settings.Algorithm == AlgorithmGlicko2 {
+		return Rating{
+			Rating:     settings.InitialRating,
+			Deviation:  settings.InitialDeviation,
+			Volatility: settings.InitialVolatility,
+		}
+	}
+	return Rating{Rating: settings.InitialRating}
+}
+
+// Update returns the ratings of players after a match they finished at placements, starting at 1. Every
+// player is matched against every other one, finishing ahead is a win and equal placements are a draw
+func Update(settings Settings, ratings []Rating, placements []int) ([]Rating, error) {
+	if len(ratings) < 2 {
+		return nil, NewInvalidMatchError("at least two players are required")
+	}
+	if len(ratings) != len(placements) {
+		return nil, NewInvalidMatchError("every player must have a placement")
+	}
+	for _, placement := range placements {
+		if placement < 1 {
+			return nil, NewInvalidMatchError("placements start at 1")
+		}
+	}
+
+	switch settings.Algorithm {
+	case AlgorithmElo:
+		return updateElo(settings, ratings, placements), nil
+	case AlgorithmGlicko2:
+		return updateGlicko2(settings, ratings, placements), nil
+	}
+	return nil, NewInvalidSettingsError("unknown algorithm " + settings.Algorithm)
+}
+
+// outcome returns 1 if placement is ahead of opponentPlacement, 0.5 if they are equal and 0 otherwise
+func outcome(placement, opponentPlacement int) float64 {
+	switch {
+	case placement < opponentPlacement:
+		return 1
+	case placement == opponentPlacement:
+		return 0.5
+	}
+	return 0
+}
@@ -0,0 +1,13 @@
+package rating_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRating(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rating Suite")
+}
@@ -0,0 +1,91 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rating_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+)
+
+var _ = Describe("Rating", func() {
+	elo := rating.WithDefaults(rating.Settings{Algorithm: rating.AlgorithmElo})
+	glicko2 := rating.WithDefaults(rating.Settings{Algorithm: rating.AlgorithmGlicko2})
+
+	Describe("ValidateSettings", func() {
+		It("should accept defaults of every algorithm", func() {
+			Expect(rating.ValidateSettings(elo)).To(Succeed())
+			Expect(rating.ValidateSettings(glicko2)).To(Succeed())
+		})
+
+		It("should refuse unknown algorithms and non positive settings", func() {
+			Expect(rating.ValidateSettings(rating.WithDefaults(rating.Settings{Algorithm: "trueskill"}))).To(HaveOccurred())
+
+			settings := elo
+			settings.KFactor = -1
+			Expect(rating.ValidateSettings(settings)).To(HaveOccurred())
+		})
+	})
+
+	Describe("Initial", func() {
+		It("should only keep deviation and volatility for Glicko-2", func() {
+			Expect(rating.Initial(elo)).To(Equal(rating.Rating{Rating: 1500}))
+			Expect(rating.Initial(glicko2)).To(Equal(rating.Rating{Rating: 1500, Deviation: 350, Volatility: 0.06}))
+		})
+	})
+
+	Describe("Update", func() {
+		It("should move Elo ratings of a duel by k factor", func() {
+			players := []rating.Rating{{Rating: 1500}, {Rating: 1500}}
+
+			ratings, err := rating.Update(elo, players, []int{1, 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ratings).To(Equal([]rating.Rating{{Rating: 1516}, {Rating: 1484}}))
+
+			ratings, err = rating.Update(elo, players, []int{1, 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ratings).To(Equal(players))
+		})
+
+		It("should rate Elo matches of many players against every other player", func() {
+			ratings, err := rating.Update(elo, []rating.Rating{{Rating: 1500}, {Rating: 1500}, {Rating: 1500}}, []int{1, 2, 3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ratings[0].Rating).To(BeNumerically("~", 1516, 0.001))
+			Expect(ratings[1].Rating).To(BeNumerically("~", 1500, 0.001))
+			Expect(ratings[2].Rating).To(BeNumerically("~", 1484, 0.001))
+		})
+
+		It("should follow Glickman's Glicko-2 example", func() {
+			// the player beats the first opponent and loses to the other two, who draw against each other
+			ratings, err := rating.Update(glicko2, []rating.Rating{
+				{Rating: 1500, Deviation: 200, Volatility: 0.06},
+				{Rating: 1400, Deviation: 30, Volatility: 0.06},
+				{Rating: 1550, Deviation: 100, Volatility: 0.06},
+				{Rating: 1700, Deviation: 300, Volatility: 0.06},
+			}, []int{2, 3, 1, 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ratings[0].Rating).To(BeNumerically("~", 1464.06, 0.01))
+			Expect(ratings[0].Deviation).To(BeNumerically("~", 151.52, 0.01))
+			Expect(ratings[0].Volatility).To(BeNumerically("~", 0.05999, 0.00001))
+		})
+
+		It("should refuse matches that can't be rated", func() {
+			_, err := rating.Update(elo, []rating.Rating{{Rating: 1500}}, []int{1})
+			Expect(err).To(MatchError(rating.NewInvalidMatchError("at least two players are required")))
+
+			_, err = rating.Update(elo, []rating.Rating{{Rating: 1500}, {Rating: 1500}}, []int{1})
+			Expect(err).To(MatchError(rating.NewInvalidMatchError("every player must have a placement")))
+
+			_, err = rating.Update(elo, []rating.Rating{{Rating: 1500}, {Rating: 1500}}, []int{0, 1})
+			Expect(err).To(MatchError(rating.NewInvalidMatchError("placements start at 1")))
+		})
+	})
+})
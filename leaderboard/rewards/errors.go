@@ -0,0 +1,27 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rewards
+
+import "fmt"
+
+// InvalidTierError identifies that a reward tier is misconfigured
+type InvalidTierError struct {
+	Name   string
+	Reason string
+}
+
+// NewInvalidTierError returns a new InvalidTierError
+func NewInvalidTierError(name, reason string) *InvalidTierError {
+	return &InvalidTierError{Name: name, Reason: reason}
+}
+
+func (e *InvalidTierError) Error() string {
+	return fmt.Sprintf("invalid reward tier %q: %s", e.Name, e.Reason)
+}
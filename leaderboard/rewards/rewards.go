@@ -0,0 +1,87 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rewards
+
+import "math"
+
+// Tier is a reward given to the members ranked up to MaxRank or within the TopPercent best members,
+// only one of them is set
+type Tier struct {
+	Name       string
+	MaxRank    int
+	TopPercent float64
+}
+
+// Bracket is the range of ranks, starting at 1, rewarded with a tier
+type Bracket struct {
+	Name    string `json:"name"`
+	MinRank int    `json:"minRank"`
+	MaxRank int    `json:"maxRank"`
+}
+
+// ValidateTiers returns an error if any tier has no name, has a name already used or doesn't set exactly one
+// of MaxRank and TopPercent
+func ValidateTiers(tiers []Tier) error {
+	names := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return NewInvalidTierError(tier.Name, "name is required")
+		}
+		if names[tier.Name] {
+			return NewInvalidTierError(tier.Name, "name is repeated")
+		}
+		names[tier.Name] = true
+
+		if (tier.MaxRank != 0) == (tier.TopPercent != 0) {
+			return NewInvalidTierError(tier.Name, "one of max rank or top percent must be set")
+		}
+		if tier.MaxRank < 0 {
+			return NewInvalidTierError(tier.Name, "max rank must be positive")
+		}
+		if tier.TopPercent < 0 || tier.TopPercent > 100 {
+			return NewInvalidTierError(tier.Name, "top percent must be greater than 0 and up to 100")
+		}
+	}
+	return nil
+}
+
+// NewBrackets evaluates tiers, in order, against a leaderboard with totalMembers members. Each tier rewards the
+// ranks not rewarded by previous ones, so a member gets the first tier reaching its rank, and tiers left without
+// ranks have no bracket. Percentiles are rounded up, so any tier of a leaderboard with members rewards someone.
+func NewBrackets(tiers []Tier, totalMembers int) []Bracket {
+	brackets := []Bracket{}
+	rewarded := 0
+	for _, tier := range tiers {
+		maxRank := tier.MaxRank
+		if tier.TopPercent != 0 {
+			maxRank = int(math.Ceil(float64(totalMembers) * tier.TopPercent / 100))
+		}
+		if maxRank > totalMembers {
+			maxRank = totalMembers
+		}
+		if maxRank <= rewarded {
+			continue
+		}
+
+		brackets = append(brackets, Bracket{Name: tier.Name, MinRank: rewarded + 1, MaxRank: maxRank})
+		rewarded = maxRank
+	}
+	return brackets
+}
+
+// FindBracket returns the bracket rewarding rank, reporting if there is one
+func FindBracket(brackets []Bracket, rank int) (Bracket, bool) {
+	for _, bracket := range brackets {
+		if rank >= bracket.MinRank && rank <= bracket.MaxRank {
+			return bracket, true
+		}
+	}
+	return Bracket{}, false
+}
@@ -0,0 +1,13 @@
+package rewards_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRewards(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rewards Suite")
+}
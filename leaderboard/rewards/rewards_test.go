@@ -0,0 +1,95 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package rewards_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+)
+
+var _ = Describe("Rewards", func() {
+	tiers := []rewards.Tier{
+		{Name: "champion", MaxRank: 1},
+		{Name: "top10", MaxRank: 10},
+		{Name: "top1percent", TopPercent: 1},
+	}
+
+	Describe("ValidateTiers", func() {
+		It("should accept absolute ranks and percentiles", func() {
+			Expect(rewards.ValidateTiers(tiers)).To(Succeed())
+		})
+
+		invalidTiers := []struct {
+			description string
+			tier        rewards.Tier
+			reason      string
+		}{
+			{"without name", rewards.Tier{MaxRank: 1}, "name is required"},
+			{"with repeated name", rewards.Tier{Name: "gold", MaxRank: 2}, "name is repeated"},
+			{"without limit", rewards.Tier{Name: "silver"}, "one of max rank or top percent must be set"},
+			{"with both limits", rewards.Tier{Name: "silver", MaxRank: 2, TopPercent: 1}, "one of max rank or top percent must be set"},
+			{"with negative max rank", rewards.Tier{Name: "silver", MaxRank: -1}, "max rank must be positive"},
+			{"with percent above 100", rewards.Tier{Name: "silver", TopPercent: 101}, "top percent must be greater than 0 and up to 100"},
+		}
+
+		for _, invalidTier := range invalidTiers {
+			invalidTier := invalidTier
+			It("should refuse tiers "+invalidTier.description, func() {
+				err := rewards.ValidateTiers([]rewards.Tier{{Name: "gold", MaxRank: 1}, invalidTier.tier})
+				Expect(err).To(Equal(rewards.NewInvalidTierError(invalidTier.tier.Name, invalidTier.reason)))
+			})
+		}
+	})
+
+	Describe("NewBrackets", func() {
+		It("should reward ranks not rewarded by previous tiers", func() {
+			Expect(rewards.NewBrackets(tiers, 100000)).To(Equal([]rewards.Bracket{
+				{Name: "champion", MinRank: 1, MaxRank: 1},
+				{Name: "top10", MinRank: 2, MaxRank: 10},
+				{Name: "top1percent", MinRank: 11, MaxRank: 1000},
+			}))
+		})
+
+		It("should skip tiers left without ranks", func() {
+			Expect(rewards.NewBrackets(tiers, 500)).To(Equal([]rewards.Bracket{
+				{Name: "champion", MinRank: 1, MaxRank: 1},
+				{Name: "top10", MinRank: 2, MaxRank: 10},
+			}))
+		})
+
+		It("should round percentiles up and stop at the last member", func() {
+			Expect(rewards.NewBrackets([]rewards.Tier{{Name: "top1percent", TopPercent: 1}, {Name: "top10", MaxRank: 10}}, 3)).To(Equal([]rewards.Bracket{
+				{Name: "top1percent", MinRank: 1, MaxRank: 1},
+				{Name: "top10", MinRank: 2, MaxRank: 3},
+			}))
+		})
+
+		It("should not reward empty leaderboards", func() {
+			Expect(rewards.NewBrackets(tiers, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("FindBracket", func() {
+		brackets := rewards.NewBrackets(tiers, 100000)
+
+		It("should find the bracket rewarding a rank", func() {
+			bracket, ok := rewards.FindBracket(brackets, 7)
+			Expect(ok).To(BeTrue())
+			Expect(bracket.Name).To(Equal("top10"))
+		})
+
+		It("should report ranks not rewarded", func() {
+			_, ok := rewards.FindBracket(brackets, 1001)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package service
+
+import "fmt"
+
+// maxCompositeScoreBits is how many bits a composite score can take and still be stored losslessly
+const maxCompositeScoreBits = 53
+
+// ScoreCriterion is one value of a composite score
+type ScoreCriterion struct {
+	// Name identifies the criterion in errors
+	Name string
+	// Order is asc or desc, how the criterion ranks when the leaderboard is read in desc order
+	Order string
+	// Bits is how many bits the criterion takes, its values go from 0 to 2^Bits-1
+	Bits uint
+}
+
+// CompositeScore ranks members by an ordered tuple of scores, each criterion breaks the ties of the previous ones.
+// Scores are packed losslessly into a single integer with the first criterion in the highest bits
+type CompositeScore struct {
+	Criteria []ScoreCriterion
+}
+
+// Bits is how many bits the packed score takes
+func (c *CompositeScore) Bits() uint {
+	var bits uint
+	for _, criterion := range c.Criteria {
+		bits += criterion.Bits
+	}
+	return bits
+}
+
+// EncodeScores packs scores, one per criterion, into a single score
+func (c *CompositeScore) EncodeScores(scores []int64) (int64, error) {
+	if bits := c.Bits(); bits > maxCompositeScoreBits {
+		return 0, NewInvalidScoresError(fmt.Sprintf("criteria take %d bits, at most %d can be stored", bits, maxCompositeScoreBits))
+	}
+	if len(scores) != len(c.Criteria) {
+		return 0, NewInvalidScoresError(fmt.Sprintf("expected %d scores, got %d", len(c.Criteria), len(scores)))
+	}
+
+	var score int64
+	for i, criterion := range c.Criteria {
+		max := int64(1)<<criterion.Bits - 1
+		value := scores[i]
+		if value < 0 || value > max {
+			return 0, NewInvalidScoresError(fmt.Sprintf("%s %d out of range (0, %d)", criterion.Name, value, max))
+		}
+		if criterion.Order == "asc" {
+			value = max - value
+		}
+		score = score<<criterion.Bits | value
+	}
+
+	return score, nil
+}
+
+// DecodeScore unpacks a score into one score per criterion
+func (c *CompositeScore) DecodeScore(score int64) []int64 {
+	scores := make([]int64, len(c.Criteria))
+	for i := len(c.Criteria) - 1; i >= 0; i-- {
+		criterion := c.Criteria[i]
+		max := int64(1)<<criterion.Bits - 1
+		value := score & max
+		if criterion.Order == "asc" {
+			value = max - value
+		}
+		scores[i] = value
+		score >>= criterion.Bits
+	}
+	return scores
+}
@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service CompositeScore", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	compositeScore := &service.CompositeScore{
+		Criteria: []service.ScoreCriterion{
+			{Name: "stars", Order: "desc", Bits: 4},
+			{Name: "time", Order: "asc", Bits: 16},
+			{Name: "kills", Order: "desc", Bits: 8},
+		},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = service.NewService(mock, service.WithCompositeScores(func(leaderboardID string) *service.CompositeScore {
+			if leaderboardID == leaderboard {
+				return compositeScore
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should rank by each criterion in order and decode scores back", func() {
+		encode := func(scores ...int64) int64 {
+			score, err := compositeScore.EncodeScores(scores)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(compositeScore.DecodeScore(score)).To(Equal(scores))
+			return score
+		}
+
+		Expect(encode(3, 65535, 0)).To(BeNumerically(">", encode(2, 0, 255)))
+		Expect(encode(3, 100, 0)).To(BeNumerically(">", encode(3, 101, 255)))
+		Expect(encode(3, 100, 10)).To(BeNumerically(">", encode(3, 100, 9)))
+		Expect(compositeScore.Bits()).To(Equal(uint(28)))
+	})
+
+	It("Should return InvalidScoresError if scores don't fit criteria", func() {
+		_, err := compositeScore.EncodeScores([]int64{1, 2})
+		Expect(err).To(MatchError(service.NewInvalidScoresError("expected 3 scores, got 2")))
+
+		_, err = compositeScore.EncodeScores([]int64{16, 0, 0})
+		Expect(err).To(MatchError(service.NewInvalidScoresError("stars 16 out of range (0, 15)")))
+
+		_, err = compositeScore.EncodeScores([]int64{0, -1, 0})
+		Expect(err).To(MatchError(service.NewInvalidScoresError("time -1 out of range (0, 65535)")))
+	})
+
+	It("Should store packed scores and return scores list", func() {
+		packed, err := compositeScore.EncodeScores([]int64{3, 100, 7})
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().UpsertMembers(
+			gomock.Any(),
+			gomock.Eq(leaderboard),
+			gomock.Eq([]*database.Member{{Member: "member1", Score: float64(packed)}}),
+			gomock.Eq(&database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyBest}),
+		).Return([]*database.Member{{Member: "member1", Score: float64(packed), Rank: 0, PreviousRank: -1, ScoreChanged: true}}, nil)
+
+		members := []*model.Member{{PublicID: "member1", Scores: []int64{3, 100, 7}}}
+		err = svc.SetMembersScore(context.Background(), leaderboard, members, false, "", database.UpdatePolicyBest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0]).To(Equal(&model.Member{PublicID: "member1", Score: float64(packed), Scores: []int64{3, 100, 7}, Rank: 1, ScoreChanged: true}))
+
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).
+			Return([]*database.Member{{Member: "member1", Score: float64(packed), Rank: 0}}, nil)
+
+		members, err = svc.GetMembersByRange(context.Background(), leaderboard, 0, 0, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0].Scores).To(Equal([]int64{3, 100, 7}))
+	})
+
+	It("Should return InvalidScoresError without writing if scores can't be stored", func() {
+		err := svc.SetMembersScore(context.Background(), leaderboard, []*model.Member{{PublicID: "member1", Scores: []int64{3}}}, false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("expected 3 scores, got 1")))
+
+		_, err = svc.IncrementMemberScore(context.Background(), leaderboard, "member1", 1, "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("composite scores can't be summed")))
+
+		err = svc.SetMembersScore(context.Background(), "another", []*model.Member{{PublicID: "member1", Scores: []int64{3}}}, false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("leaderboard has no composite score")))
+	})
+})
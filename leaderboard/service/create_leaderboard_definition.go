@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const createLeaderboardDefinitionServiceLabel = "create leaderboard definition"
+
+// CreateLeaderboardDefinition register a leaderboard and return its stored definition
+func (s *Service) CreateLeaderboardDefinition(ctx context.Context, definition *model.LeaderboardDefinition) (*model.LeaderboardDefinition, error) {
+	if s.registry == nil {
+		return nil, NewGeneralError(createLeaderboardDefinitionServiceLabel, registryNotConfiguredMessage)
+	}
+
+	if err := validateLeaderboardDefinition(definition); err != nil {
+		return nil, err
+	}
+
+	databaseDefinition := convertModelLeaderboardDefinitionIntoDatabaseDefinition(definition)
+	databaseDefinition.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	databaseDefinition.UpdatedAt = databaseDefinition.CreatedAt
+
+	err := s.registry.CreateLeaderboardDefinition(ctx, databaseDefinition)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardDefinitionAlreadyExistsError); ok {
+			return nil, NewLeaderboardDefinitionAlreadyExistsError(definition.ID)
+		}
+		return nil, NewGeneralError(createLeaderboardDefinitionServiceLabel, err.Error())
+	}
+	s.forgetDefinition(definition.ID)
+
+	return convertDatabaseLeaderboardDefinitionIntoModelDefinition(databaseDefinition), nil
+}
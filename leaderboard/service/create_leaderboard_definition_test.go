@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service CreateLeaderboardDefinition", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+
+		svc = service.NewService(mock, service.WithRegistry(registry))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should store definition with creation time and return it", func() {
+		var stored *database.LeaderboardDefinition
+		registry.EXPECT().CreateLeaderboardDefinition(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, definition *database.LeaderboardDefinition) error {
+				stored = definition
+				return nil
+			},
+		)
+
+		definition, err := svc.CreateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{
+			ID:          leaderboard,
+			DisplayName: "Weekly ranking",
+			Order:       "asc",
+			StartTime:   1700000000,
+			EndTime:     1700604800,
+			Retention:   3600,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stored.StartTime).To(Equal(time.Unix(1700000000, 0).UTC()))
+		Expect(stored.EndTime).To(Equal(time.Unix(1700604800, 0).UTC()))
+		Expect(stored.Retention).To(Equal(time.Hour))
+		Expect(definition.ID).To(Equal(leaderboard))
+		Expect(definition.Order).To(Equal("asc"))
+		Expect(definition.Retention).To(Equal(3600))
+		Expect(definition.CreatedAt).To(BeNumerically("~", time.Now().Unix(), 1))
+		Expect(definition.UpdatedAt).To(Equal(definition.CreatedAt))
+	})
+
+	It("Should return error InvalidLeaderboardDefinitionError if definition is invalid", func() {
+		invalidDefinitions := []*model.LeaderboardDefinition{
+			{},
+			{ID: leaderboard, Order: "invalid"},
+			{ID: leaderboard, UpdatePolicy: "invalid"},
+			{ID: leaderboard, DefaultPageSize: -1},
+			{ID: leaderboard, DefaultPageSize: 50, MaxPageSize: 10},
+			{ID: leaderboard, Retention: -1},
+			{ID: leaderboard, StartTime: 1700604800, EndTime: 1700000000},
+		}
+
+		for _, definition := range invalidDefinitions {
+			_, err := svc.CreateLeaderboardDefinition(context.Background(), definition)
+			Expect(err).To(BeAssignableToTypeOf(&service.InvalidLeaderboardDefinitionError{}))
+		}
+	})
+
+	It("Should return error LeaderboardDefinitionAlreadyExistsError if leaderboard is registered", func() {
+		registry.EXPECT().CreateLeaderboardDefinition(gomock.Any(), gomock.Any()).
+			Return(database.NewLeaderboardDefinitionAlreadyExistsError(leaderboard))
+
+		_, err := svc.CreateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{ID: leaderboard})
+		Expect(err).To(Equal(service.NewLeaderboardDefinitionAlreadyExistsError(leaderboard)))
+	})
+
+	It("Should return error if service has no registry", func() {
+		svc = &service.Service{Database: mock}
+
+		_, err := svc.CreateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{ID: leaderboard})
+		Expect(err).To(Equal(service.NewGeneralError("create leaderboard definition", "leaderboard registry is not configured")))
+	})
+})
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// WithDefinitionCache sets how long leaderboard definitions read from the registry are kept in memory, so
+// writes and reads don't look their definition up every time. Definitions changed through this service are
+// forgotten at once, changes made by other instances are seen once the cached ones expire. Without it
+// definitions are read on every call
+func WithDefinitionCache(ttl time.Duration) Option {
+	return func(s *Service) {
+		if ttl <= 0 {
+			s.definitions = nil
+			return
+		}
+		s.definitions = &definitionCache{ttl: ttl, entries: map[string]*cachedDefinition{}}
+	}
+}
+
+// definitionCache keep registered definitions by leaderboard id, including leaderboards that aren't registered
+type definitionCache struct {
+	ttl time.Duration
+
+	mutex     sync.Mutex
+	entries   map[string]*cachedDefinition
+	nextSweep time.Time
+}
+
+type cachedDefinition struct {
+	definition *database.LeaderboardDefinition
+	expireAt   time.Time
+}
+
+// get return the cached definition of leaderboard, nil if leaderboard isn't registered, and false if it isn't
+// cached
+func (c *definitionCache) get(leaderboard string) (*database.LeaderboardDefinition, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[leaderboard]
+	if !ok || !entry.expireAt.After(time.Now()) {
+		return nil, false
+	}
+	return entry.definition, true
+}
+
+// set cache definition of leaderboard, forgetting expired definitions at most once per ttl
+func (c *definitionCache) set(leaderboard string, definition *database.LeaderboardDefinition) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if now.After(c.nextSweep) {
+		for cached, entry := range c.entries {
+			if !entry.expireAt.After(now) {
+				delete(c.entries, cached)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	c.entries[leaderboard] = &cachedDefinition{definition: definition, expireAt: now.Add(c.ttl)}
+}
+
+// forget drop the cached definition of leaderboard after it's created, updated or removed
+func (c *definitionCache) forget(leaderboard string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, leaderboard)
+}
+
+// forgetDefinition drop the cached definition of leaderboard, if service caches definitions
+func (s *Service) forgetDefinition(leaderboard string) {
+	if s.definitions != nil {
+		s.definitions.forget(leaderboard)
+	}
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service definition cache", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should read a definition once until it expires or is changed through the service", func() {
+		svc := service.NewService(mock, service.WithRegistry(registry), service.WithDefinitionCache(100*time.Millisecond))
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.Order).To(Equal("asc"))
+				return []*database.Member{{Member: "member1", Score: 10, Rank: 0}}, nil
+			}).Times(5)
+
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(&database.LeaderboardDefinition{ID: leaderboard, Order: "asc"}, nil).Times(3)
+		registry.EXPECT().RemoveLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 10, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		time.Sleep(150 * time.Millisecond)
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 10, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(svc.RemoveLeaderboardDefinition(context.Background(), leaderboard)).To(Succeed())
+		for i := 0; i < 2; i++ {
+			_, err = svc.SetMemberScore(context.Background(), leaderboard, "member1", 10, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("Should cache leaderboards that aren't registered", func() {
+		svc := service.NewService(mock, service.WithRegistry(registry), service.WithDefinitionCache(time.Minute))
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+			Return([]*database.Member{{Member: "member1", Score: 10, Rank: 0}}, nil).Times(2)
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardDefinitionNotFoundError(leaderboard))
+
+		for i := 0; i < 2; i++ {
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 10, false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+})
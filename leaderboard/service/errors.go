@@ -1,6 +1,9 @@
 package service
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // GeneralError is an error threw when a not handled error was found
 type GeneralError struct {
@@ -72,6 +75,58 @@ func NewLeaderboardExpiredError(leaderboard string) *LeaderboardExpiredError {
 	}
 }
 
+// SeasonNotStartedError is an error threw when a score is written before the leaderboard season starts
+type SeasonNotStartedError struct {
+	leaderboard string
+	start       time.Time
+}
+
+func (snse *SeasonNotStartedError) Error() string {
+	return fmt.Sprintf("season of leaderboard %s starts at %s", snse.leaderboard, snse.start.UTC().Format(time.RFC3339))
+}
+
+// NewSeasonNotStartedError create a new SeasonNotStartedError
+func NewSeasonNotStartedError(leaderboard string, start time.Time) *SeasonNotStartedError {
+	return &SeasonNotStartedError{
+		leaderboard: leaderboard,
+		start:       start,
+	}
+}
+
+// SeasonClosedError is an error threw when a score is written after the leaderboard season ends
+type SeasonClosedError struct {
+	leaderboard string
+	end         time.Time
+}
+
+func (sce *SeasonClosedError) Error() string {
+	return fmt.Sprintf("season of leaderboard %s closed at %s", sce.leaderboard, sce.end.UTC().Format(time.RFC3339))
+}
+
+// NewSeasonClosedError create a new SeasonClosedError
+func NewSeasonClosedError(leaderboard string, end time.Time) *SeasonClosedError {
+	return &SeasonClosedError{
+		leaderboard: leaderboard,
+		end:         end,
+	}
+}
+
+// InvalidSeasonSelectorError is an error threw when a season family is read with an unknown season selector
+type InvalidSeasonSelectorError struct {
+	leaderboard string
+}
+
+func (isse *InvalidSeasonSelectorError) Error() string {
+	return fmt.Sprintf("invalid season selector: %s, it must be current, previous or an integer offset", isse.leaderboard)
+}
+
+// NewInvalidSeasonSelectorError create a new InvalidSeasonSelectorError
+func NewInvalidSeasonSelectorError(leaderboard string) *InvalidSeasonSelectorError {
+	return &InvalidSeasonSelectorError{
+		leaderboard: leaderboard,
+	}
+}
+
 // PercentageError is an error threw when a not handled error was found
 type PercentageError struct {
 	percentage int
@@ -87,3 +142,346 @@ func NewPercentageError(percentage int) *PercentageError {
 		percentage: percentage,
 	}
 }
+
+// ScoreOutOfRangeError is an error threw when a score can't be stored without losing precision
+type ScoreOutOfRangeError struct {
+	score float64
+	min   float64
+	max   float64
+}
+
+func (soore *ScoreOutOfRangeError) Error() string {
+	return fmt.Sprintf("score %s out of range (%s, %s)", formatScore(soore.score), formatScore(soore.min), formatScore(soore.max))
+}
+
+// NewScoreOutOfRangeError create a new ScoreOutOfRangeError
+func NewScoreOutOfRangeError(score, min, max float64) *ScoreOutOfRangeError {
+	return &ScoreOutOfRangeError{
+		score: score,
+		min:   min,
+		max:   max,
+	}
+}
+
+// InvalidScoresError is an error threw when scores don't fit the leaderboard composite score
+type InvalidScoresError struct {
+	msg string
+}
+
+func (ise *InvalidScoresError) Error() string {
+	return fmt.Sprintf("invalid scores: %s", ise.msg)
+}
+
+// NewInvalidScoresError create a new InvalidScoresError
+func NewInvalidScoresError(msg string) *InvalidScoresError {
+	return &InvalidScoresError{
+		msg: msg,
+	}
+}
+
+// InvalidRankModeError is an error threw when rank mode is not one of the rank modes
+type InvalidRankModeError struct {
+	mode string
+}
+
+func (irme *InvalidRankModeError) Error() string {
+	return fmt.Sprintf("invalid rank mode: %s", irme.mode)
+}
+
+// NewInvalidRankModeError create a new InvalidRankModeError
+func NewInvalidRankModeError(mode string) *InvalidRankModeError {
+	return &InvalidRankModeError{
+		mode: mode,
+	}
+}
+
+// TieBreakRankModeError is an error threw when a leaderboard with tie-break is ranked in a rank mode that
+// gives tied members the same rank
+type TieBreakRankModeError struct {
+	leaderboard string
+	mode        string
+}
+
+func (tbrme *TieBreakRankModeError) Error() string {
+	return fmt.Sprintf("rank mode %s is not supported by leaderboard %s, its ties are broken by submission time", tbrme.mode, tbrme.leaderboard)
+}
+
+// NewTieBreakRankModeError create a new TieBreakRankModeError
+func NewTieBreakRankModeError(leaderboard, mode string) *TieBreakRankModeError {
+	return &TieBreakRankModeError{
+		leaderboard: leaderboard,
+		mode:        mode,
+	}
+}
+
+// LeaderboardDefinitionNotFoundError is an error threw when leaderboard isn't registered
+type LeaderboardDefinitionNotFoundError struct {
+	leaderboard string
+}
+
+func (ldnfe *LeaderboardDefinitionNotFoundError) Error() string {
+	return fmt.Sprintf("leaderboard %s is not registered", ldnfe.leaderboard)
+}
+
+// NewLeaderboardDefinitionNotFoundError create a new LeaderboardDefinitionNotFoundError
+func NewLeaderboardDefinitionNotFoundError(leaderboard string) *LeaderboardDefinitionNotFoundError {
+	return &LeaderboardDefinitionNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+// LeaderboardDefinitionAlreadyExistsError is an error threw when leaderboard is already registered
+type LeaderboardDefinitionAlreadyExistsError struct {
+	leaderboard string
+}
+
+func (ldaee *LeaderboardDefinitionAlreadyExistsError) Error() string {
+	return fmt.Sprintf("leaderboard %s is already registered", ldaee.leaderboard)
+}
+
+// NewLeaderboardDefinitionAlreadyExistsError create a new LeaderboardDefinitionAlreadyExistsError
+func NewLeaderboardDefinitionAlreadyExistsError(leaderboard string) *LeaderboardDefinitionAlreadyExistsError {
+	return &LeaderboardDefinitionAlreadyExistsError{
+		leaderboard: leaderboard,
+	}
+}
+
+// InvalidLeaderboardDefinitionError is an error threw when a leaderboard definition has invalid settings
+type InvalidLeaderboardDefinitionError struct {
+	msg string
+}
+
+func (ilde *InvalidLeaderboardDefinitionError) Error() string {
+	return fmt.Sprintf("invalid leaderboard definition: %s", ilde.msg)
+}
+
+// NewInvalidLeaderboardDefinitionError create a new InvalidLeaderboardDefinitionError
+func NewInvalidLeaderboardDefinitionError(msg string) *InvalidLeaderboardDefinitionError {
+	return &InvalidLeaderboardDefinitionError{
+		msg: msg,
+	}
+}
+
+// PageSizeOutOfRangeError is an error threw when a page size is above the leaderboard max page size
+type PageSizeOutOfRangeError struct {
+	pageSize    int
+	maxPageSize int
+}
+
+func (psoore *PageSizeOutOfRangeError) Error() string {
+	return fmt.Sprintf("Max pageSize allowed: %d. pageSize requested: %d", psoore.maxPageSize, psoore.pageSize)
+}
+
+// NewPageSizeOutOfRangeError create a new PageSizeOutOfRangeError
+func NewPageSizeOutOfRangeError(pageSize, maxPageSize int) *PageSizeOutOfRangeError {
+	return &PageSizeOutOfRangeError{
+		pageSize:    pageSize,
+		maxPageSize: maxPageSize,
+	}
+}
+
+// LeaderboardArchiveNotFoundError is an error threw when leaderboard final standings weren't archived
+type LeaderboardArchiveNotFoundError struct {
+	leaderboard string
+}
+
+func (lanfe *LeaderboardArchiveNotFoundError) Error() string {
+	return fmt.Sprintf("leaderboard %s is not archived", lanfe.leaderboard)
+}
+
+// NewLeaderboardArchiveNotFoundError create a new LeaderboardArchiveNotFoundError
+func NewLeaderboardArchiveNotFoundError(leaderboard string) *LeaderboardArchiveNotFoundError {
+	return &LeaderboardArchiveNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+// TieredLeagueNotFoundError is an error threw when a competition isn't a configured tiered league
+type TieredLeagueNotFoundError struct {
+	competition string
+}
+
+func (tlnfe *TieredLeagueNotFoundError) Error() string {
+	return fmt.Sprintf("tiered league %s not found", tlnfe.competition)
+}
+
+// NewTieredLeagueNotFoundError create a new TieredLeagueNotFoundError
+func NewTieredLeagueNotFoundError(competition string) *TieredLeagueNotFoundError {
+	return &TieredLeagueNotFoundError{
+		competition: competition,
+	}
+}
+
+// LeaderboardRewardsNotFoundError is an error threw when no reward bracket was assigned when leaderboard was archived
+type LeaderboardRewardsNotFoundError struct {
+	leaderboard string
+}
+
+func (lrnfe *LeaderboardRewardsNotFoundError) Error() string {
+	return fmt.Sprintf("leaderboard %s has no reward brackets", lrnfe.leaderboard)
+}
+
+// NewLeaderboardRewardsNotFoundError create a new LeaderboardRewardsNotFoundError
+func NewLeaderboardRewardsNotFoundError(leaderboard string) *LeaderboardRewardsNotFoundError {
+	return &LeaderboardRewardsNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+// LeaderboardNotRatedError is an error threw when match results are submitted to a leaderboard without rating settings
+type LeaderboardNotRatedError struct {
+	leaderboard string
+}
+
+func (lnre *LeaderboardNotRatedError) Error() string {
+	return fmt.Sprintf("leaderboard %s is not a rating leaderboard", lnre.leaderboard)
+}
+
+// NewLeaderboardNotRatedError create a new LeaderboardNotRatedError
+func NewLeaderboardNotRatedError(leaderboard string) *LeaderboardNotRatedError {
+	return &LeaderboardNotRatedError{
+		leaderboard: leaderboard,
+	}
+}
+
+// InvalidMatchResultError is an error threw when match results can't be rated
+type InvalidMatchResultError struct {
+	msg string
+}
+
+func (imre *InvalidMatchResultError) Error() string {
+	return fmt.Sprintf("invalid match result: %s", imre.msg)
+}
+
+// NewInvalidMatchResultError create a new InvalidMatchResultError
+func NewInvalidMatchResultError(msg string) *InvalidMatchResultError {
+	return &InvalidMatchResultError{
+		msg: msg,
+	}
+}
+
+// RatingConflictError is an error threw when member ratings kept changing while a match result was rated
+type RatingConflictError struct {
+	leaderboard string
+}
+
+func (rce *RatingConflictError) Error() string {
+	return fmt.Sprintf("ratings of leaderboard %s changed concurrently, try again", rce.leaderboard)
+}
+
+// NewRatingConflictError create a new RatingConflictError
+func NewRatingConflictError(leaderboard string) *RatingConflictError {
+	return &RatingConflictError{
+		leaderboard: leaderboard,
+	}
+}
+
+// LeaderboardNotLadderError is an error threw when challenge results are reported to a leaderboard that isn't a ladder
+type LeaderboardNotLadderError struct {
+	leaderboard string
+}
+
+func (lnle *LeaderboardNotLadderError) Error() string {
+	return fmt.Sprintf("leaderboard %s is not a ladder", lnle.leaderboard)
+}
+
+// NewLeaderboardNotLadderError create a new LeaderboardNotLadderError
+func NewLeaderboardNotLadderError(leaderboard string) *LeaderboardNotLadderError {
+	return &LeaderboardNotLadderError{
+		leaderboard: leaderboard,
+	}
+}
+
+// InvalidChallengeError is an error threw when a challenge result can't be applied to a ladder
+type InvalidChallengeError struct {
+	msg string
+}
+
+func (ice *InvalidChallengeError) Error() string {
+	return fmt.Sprintf("invalid challenge: %s", ice.msg)
+}
+
+// NewInvalidChallengeError create a new InvalidChallengeError
+func NewInvalidChallengeError(msg string) *InvalidChallengeError {
+	return &InvalidChallengeError{
+		msg: msg,
+	}
+}
+
+// ScoreRuleViolationError is an error threw when a score write breaks a leaderboard score rule
+type ScoreRuleViolationError struct {
+	member string
+	rule   string
+	value  float64
+	limit  float64
+}
+
+func (srve *ScoreRuleViolationError) Error() string {
+	return fmt.Sprintf("score of member %s breaks rule %s: %s exceeds %s", srve.member, srve.rule, formatScore(srve.value), formatScore(srve.limit))
+}
+
+// NewScoreRuleViolationError create a new ScoreRuleViolationError
+func NewScoreRuleViolationError(member, rule string, value, limit float64) *ScoreRuleViolationError {
+	return &ScoreRuleViolationError{
+		member: member,
+		rule:   rule,
+		value:  value,
+		limit:  limit,
+	}
+}
+
+// InvalidSubmissionSignatureError is an error threw when a signed score submission can't be verified
+type InvalidSubmissionSignatureError struct {
+	reason string
+}
+
+func (isse *InvalidSubmissionSignatureError) Error() string {
+	return "invalid submission signature: " + isse.reason
+}
+
+// NewInvalidSubmissionSignatureError create a new InvalidSubmissionSignatureError
+func NewInvalidSubmissionSignatureError(reason string) *InvalidSubmissionSignatureError {
+	return &InvalidSubmissionSignatureError{
+		reason: reason,
+	}
+}
+
+// InvalidPreconditionError is an error threw when a score write precondition can't be evaluated
+type InvalidPreconditionError struct {
+	member string
+	msg    string
+}
+
+func (ipe *InvalidPreconditionError) Error() string {
+	return fmt.Sprintf("invalid precondition of member %s: %s", ipe.member, ipe.msg)
+}
+
+// NewInvalidPreconditionError create a new InvalidPreconditionError
+func NewInvalidPreconditionError(member, msg string) *InvalidPreconditionError {
+	return &InvalidPreconditionError{
+		member: member,
+		msg:    msg,
+	}
+}
+
+// PreconditionFailedError is an error threw when the stored score of a member doesn't match the precondition of
+// its write, nothing is written then
+type PreconditionFailedError struct {
+	member       string
+	currentScore *float64
+}
+
+func (pfe *PreconditionFailedError) Error() string {
+	if pfe.currentScore == nil {
+		return fmt.Sprintf("precondition of member %s failed: member is absent", pfe.member)
+	}
+	return fmt.Sprintf("precondition of member %s failed: current score is %s", pfe.member, formatScore(*pfe.currentScore))
+}
+
+// NewPreconditionFailedError create a new PreconditionFailedError, currentScore is nil if member is absent
+func NewPreconditionFailedError(member string, currentScore *float64) *PreconditionFailedError {
+	return &PreconditionFailedError{
+		member:       member,
+		currentScore: currentScore,
+	}
+}
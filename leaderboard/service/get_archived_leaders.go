@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getArchivedLeadersServiceLabel = "get archived leaders"
+
+// GetArchivedLeaders return a page of leaderboard final standings, members with equal scores are ranked
+// by public ID
+func (s *Service) GetArchivedLeaders(ctx context.Context, leaderboard string, pageSize, page int, order string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := s.getLeaderboardArchive(ctx, getArchivedLeadersServiceLabel, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getArchivedLeadersServiceLabel, err.Error())
+	}
+	order = definitionOrder(definition, order)
+
+	pageSize, err = definitionPageSize(definition, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(archive.TotalMembers) / float64(pageSize)))
+	if page < 1 {
+		page = 1
+	}
+	if page > totalPages {
+		return []*model.Member{}, nil
+	}
+
+	index := getIndexesByPage(pageSize, page)
+
+	databaseMembers, err := s.archive.GetArchivedOrderedMembers(ctx, leaderboard, index.Start, index.Stop, order)
+	if err != nil {
+		return nil, NewGeneralError(getArchivedLeadersServiceLabel, err.Error())
+	}
+
+	return convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboard)), nil
+}
@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetArchivedLeaders", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var archive *database.MockArchive
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var leaderboardArchive = &database.LeaderboardArchive{ID: leaderboard, TotalMembers: 3}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		archive = database.NewMockArchive(ctrl)
+
+		svc = service.NewService(mock, service.WithArchive(archive, nil))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return archived members of the page", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(2), gomock.Eq(3), gomock.Eq("desc")).
+			Return([]*database.Member{{Member: "member3", Score: 10, Rank: 2}}, nil)
+
+		members, err := svc.GetArchivedLeaders(context.Background(), leaderboard, 2, 2, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.Member{{PublicID: "member3", Score: 10, Rank: 3}}))
+	})
+
+	It("Should return empty list if page is after the last one", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+
+		members, err := svc.GetArchivedLeaders(context.Background(), leaderboard, 2, 3, "asc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(BeEmpty())
+	})
+
+	It("Should return error LeaderboardArchiveNotFoundError if leaderboard isn't archived", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardArchiveNotFoundError(leaderboard))
+
+		_, err := svc.GetArchivedLeaders(context.Background(), leaderboard, 2, 1, "desc")
+		Expect(err).To(Equal(service.NewLeaderboardArchiveNotFoundError(leaderboard)))
+	})
+
+	It("Should return error GeneralError if database return in error", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(1), gomock.Eq("desc")).
+			Return(nil, fmt.Errorf("New database error"))
+
+		_, err := svc.GetArchivedLeaders(context.Background(), leaderboard, 2, 1, "desc")
+		Expect(err).To(Equal(service.NewGeneralError("get archived leaders", "New database error")))
+	})
+})
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getArchivedMemberServiceLabel = "get archived member"
+
+// GetArchivedMember return member final score and rank, members with equal scores are ranked by public ID
+func (s *Service) GetArchivedMember(ctx context.Context, leaderboard, member, order string) (*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getLeaderboardArchive(ctx, getArchivedMemberServiceLabel, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.getOrder(ctx, leaderboard, order)
+	if err != nil {
+		return nil, NewGeneralError(getArchivedMemberServiceLabel, err.Error())
+	}
+
+	databaseMembers, err := s.archive.GetArchivedMembers(ctx, leaderboard, order, member)
+	if err != nil {
+		return nil, NewGeneralError(getArchivedMemberServiceLabel, err.Error())
+	}
+
+	if databaseMembers[0] == nil {
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	return convertDatabaseMemberIntoModelMember(databaseMembers[0], s.scoreEncoding(leaderboard)), nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+)
+
+const getArchivedMemberRewardServiceLabel = "get archived member reward"
+
+// GetArchivedMemberReward return member final score and rank in the order reward brackets were evaluated in,
+// with the reward bracket member was assigned, which is empty if member rank wasn't rewarded
+func (s *Service) GetArchivedMemberReward(ctx context.Context, leaderboard, member string) (*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := s.getLeaderboardArchive(ctx, getArchivedMemberRewardServiceLabel, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(archive.RewardBrackets) == 0 {
+		return nil, NewLeaderboardRewardsNotFoundError(leaderboard)
+	}
+
+	databaseMembers, err := s.archive.GetArchivedMembers(ctx, leaderboard, archive.RewardOrder, member)
+	if err != nil {
+		return nil, NewGeneralError(getArchivedMemberRewardServiceLabel, err.Error())
+	}
+
+	if databaseMembers[0] == nil {
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	modelMember := convertDatabaseMemberIntoModelMember(databaseMembers[0], s.scoreEncoding(leaderboard))
+	if bracket, ok := rewards.FindBracket(archive.RewardBrackets, modelMember.Rank); ok {
+		modelMember.RewardBracket = bracket.Name
+	}
+
+	return modelMember, nil
+}
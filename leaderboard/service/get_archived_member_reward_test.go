@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetArchivedMemberReward", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var archive *database.MockArchive
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member"
+	var leaderboardArchive = &database.LeaderboardArchive{
+		ID:             leaderboard,
+		TotalMembers:   100,
+		RewardOrder:    "asc",
+		RewardBrackets: []rewards.Bracket{{Name: "gold", MinRank: 1, MaxRank: 1}, {Name: "silver", MinRank: 2, MaxRank: 10}},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		archive = database.NewMockArchive(ctrl)
+
+		svc = service.NewService(mock, service.WithArchive(archive, nil))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return member final rank in reward order and its reward bracket", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("asc"), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 10, Rank: 4}}, nil)
+
+		archivedMember, err := svc.GetArchivedMemberReward(context.Background(), leaderboard, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archivedMember).To(Equal(&model.Member{PublicID: member, Score: 10, Rank: 5, RewardBracket: "silver"}))
+	})
+
+	It("Should return member without reward bracket if its rank wasn't rewarded", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("asc"), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 10, Rank: 10}}, nil)
+
+		archivedMember, err := svc.GetArchivedMemberReward(context.Background(), leaderboard, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archivedMember.RewardBracket).To(BeEmpty())
+	})
+
+	It("Should return error LeaderboardRewardsNotFoundError if no reward bracket was assigned", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(&database.LeaderboardArchive{ID: leaderboard, TotalMembers: 3}, nil)
+
+		_, err := svc.GetArchivedMemberReward(context.Background(), leaderboard, member)
+		Expect(err).To(Equal(service.NewLeaderboardRewardsNotFoundError(leaderboard)))
+	})
+
+	It("Should return error MemberNotFoundError if member wasn't in leaderboard", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("asc"), gomock.Eq(member)).
+			Return([]*database.Member{nil}, nil)
+
+		_, err := svc.GetArchivedMemberReward(context.Background(), leaderboard, member)
+		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
+	})
+
+	It("Should return error GeneralError if database return in error", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("asc"), gomock.Eq(member)).
+			Return(nil, fmt.Errorf("New database error"))
+
+		_, err := svc.GetArchivedMemberReward(context.Background(), leaderboard, member)
+		Expect(err).To(Equal(service.NewGeneralError("get archived member reward", "New database error")))
+	})
+})
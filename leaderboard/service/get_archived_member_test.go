@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetArchivedMember", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var archive *database.MockArchive
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member"
+	var leaderboardArchive = &database.LeaderboardArchive{ID: leaderboard, TotalMembers: 3}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		archive = database.NewMockArchive(ctrl)
+
+		svc = service.NewService(mock, service.WithArchive(archive, nil))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return member final score and rank", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 10, Rank: 1}}, nil)
+
+		archivedMember, err := svc.GetArchivedMember(context.Background(), leaderboard, member, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archivedMember).To(Equal(&model.Member{PublicID: member, Score: 10, Rank: 2}))
+	})
+
+	It("Should return error MemberNotFoundError if member wasn't in leaderboard", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("asc"), gomock.Eq(member)).
+			Return([]*database.Member{nil}, nil)
+
+		_, err := svc.GetArchivedMember(context.Background(), leaderboard, member, "asc")
+		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
+	})
+
+	It("Should return error LeaderboardArchiveNotFoundError if leaderboard isn't archived", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardArchiveNotFoundError(leaderboard))
+
+		_, err := svc.GetArchivedMember(context.Background(), leaderboard, member, "desc")
+		Expect(err).To(Equal(service.NewLeaderboardArchiveNotFoundError(leaderboard)))
+	})
+
+	It("Should return error GeneralError if database return in error", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(leaderboardArchive, nil)
+		archive.EXPECT().GetArchivedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(member)).
+			Return(nil, fmt.Errorf("New database error"))
+
+		_, err := svc.GetArchivedMember(context.Background(), leaderboard, member, "desc")
+		Expect(err).To(Equal(service.NewGeneralError("get archived member", "New database error")))
+	})
+})
@@ -9,8 +9,34 @@ import (
 
 const getAroundMeServiceLabel = "get around me"
 
-// GetAroundMe find users around a certain member
-func (s *Service) GetAroundMe(ctx context.Context, leaderboard string, pageSize int, member string, order string, getLastIfNotFound bool) ([]*model.Member, error) {
+// GetAroundMe find users around a certain member, ranked in rankMode
+func (s *Service) GetAroundMe(ctx context.Context, leaderboard string, pageSize int, member string, order string, getLastIfNotFound bool, rankMode string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateRankMode(leaderboard, rankMode)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard, err = s.memberLeaderboard(ctx, getAroundMeServiceLabel, leaderboard, member)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
+	}
+	order = definitionOrder(definition, order)
+
+	pageSize, err = definitionPageSize(definition, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
 	memberRank, err := s.fetchMemberRank(ctx, leaderboard, member, order, getLastIfNotFound)
 	if err != nil {
 		if _, ok := err.(*database.MemberNotFoundError); ok {
@@ -30,7 +56,12 @@ func (s *Service) GetAroundMe(ctx context.Context, leaderboard string, pageSize
 		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	err = s.rankOrderedMembers(ctx, leaderboard, order, rankMode, databaseMembers)
+	if err != nil {
+		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
+	}
+
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboard))
 
 	return members, nil
 }
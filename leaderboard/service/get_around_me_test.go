@@ -27,7 +27,7 @@ var _ = Describe("Service GetAroundMe", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -81,7 +81,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(membersDatabaseReturn, nil)
 
-			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(membersFromService).To(Equal(membersReturn))
@@ -90,14 +90,14 @@ var _ = Describe("Service GetAroundMe", func() {
 		It("Should return error if getRank return member not found", func() {
 			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, database.NewMemberNotFoundError(leaderboard, member))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
 		})
 
 		It("Should return error if getRank return in error", func() {
 			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -107,7 +107,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(rank, nil)
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(-1, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -120,7 +120,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(nil, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -135,7 +135,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			//this is the assertation relevant to this test
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Times(1).Return(nil, fmt.Errorf("database error"))
 
-			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 		})
 
 		It("Should ask for last members if user is the last one", func() {
@@ -149,7 +149,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			//this is the assertation relevant to this test
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Times(1).Return(nil, fmt.Errorf("database error"))
 
-			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 		})
 
 		It("Should ask for all members if totalMembers is less than pageSize", func() {
@@ -164,7 +164,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			//this is the assertation relevant to this test
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Times(1).Return(nil, fmt.Errorf("database error"))
 
-			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 		})
 	})
 
@@ -215,7 +215,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(membersDatabaseReturn, nil)
 
-			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(membersFromService).To(Equal(membersReturn))
@@ -265,7 +265,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(membersDatabaseReturn, nil)
 
-			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			membersFromService, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(membersFromService).To(Equal(membersReturn))
@@ -274,7 +274,7 @@ var _ = Describe("Service GetAroundMe", func() {
 		It("Should return error if getRank return in error", func() {
 			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -282,7 +282,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, database.NewMemberNotFoundError(leaderboard, member))
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(-1, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -295,7 +295,7 @@ var _ = Describe("Service GetAroundMe", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(nil, fmt.Errorf("database error"))
 
-			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			_, err := svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 			Expect(err).To(Equal(service.NewGeneralError("get around me", "database error")))
 		})
 
@@ -310,7 +310,7 @@ var _ = Describe("Service GetAroundMe", func() {
 
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Times(1).Return(nil, fmt.Errorf("database error"))
 
-			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound)
+			svc.GetAroundMe(context.Background(), leaderboard, pageSize, member, order, getLastIfNotFound, "")
 		})
 	})
 })
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
@@ -14,7 +13,23 @@ const (
 )
 
 // GetAroundScore find members around an score
-func (s *Service) GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score int64, order string) ([]*model.Member, error) {
+func (s *Service) GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score float64, order string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getAroundScoreServiceLabel, err.Error())
+	}
+	order = definitionOrder(definition, order)
+
+	pageSize, err = definitionPageSize(definition, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
 	member, err := s.getMemberIDWithClosestScore(ctx, leaderboard, score)
 	if err != nil {
 		return nil, err
@@ -35,13 +50,14 @@ func (s *Service) GetAroundScore(ctx context.Context, leaderboard string, pageSi
 		return nil, NewGeneralError(getAroundScoreServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboard))
 
 	return members, nil
 }
 
-func (s *Service) getMemberIDWithClosestScore(ctx context.Context, leaderboard string, score int64) (string, error) {
-	memberSlice, err := s.Database.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", strconv.FormatInt(score, 10), 0, 1)
+func (s *Service) getMemberIDWithClosestScore(ctx context.Context, leaderboard string, score float64) (string, error) {
+	max := s.scoreEncoding(leaderboard).maxStoredScore(score)
+	memberSlice, err := s.Database.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", max, 0, 1)
 	if err != nil {
 		return "", NewGeneralError(getAroundScoreServiceLabel, err.Error())
 	}
@@ -20,7 +20,7 @@ var _ = Describe("Service GetAroundScore", func() {
 	var leaderboard string = "leaderboardTest"
 	var totalMembers int = 10
 	var pageSize int = 3
-	var score int64 = 1
+	var score float64 = 1
 	var member string = "member"
 	var order string = "asc"
 
@@ -28,7 +28,7 @@ var _ = Describe("Service GetAroundScore", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
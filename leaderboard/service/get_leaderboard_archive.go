@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getLeaderboardArchiveServiceLabel = "get leaderboard archive"
+
+// GetLeaderboardArchive return when leaderboard final standings were archived and how many members they have
+func (s *Service) GetLeaderboardArchive(ctx context.Context, leaderboard string) (*model.LeaderboardArchive, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := s.getLeaderboardArchive(ctx, getLeaderboardArchiveServiceLabel, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDatabaseLeaderboardArchiveIntoModelArchive(archive), nil
+}
@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetLeaderboardArchive", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var archive *database.MockArchive
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		archive = database.NewMockArchive(ctrl)
+
+		svc = service.NewService(mock, service.WithArchive(archive, nil))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return archive with times in unix seconds", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardArchive{
+			ID:           leaderboard,
+			SeasonEnd:    time.Unix(1700604800, 0),
+			ArchivedAt:   time.Unix(1700604860, 0),
+			TotalMembers: 3,
+		}, nil)
+
+		leaderboardArchive, err := svc.GetLeaderboardArchive(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboardArchive).To(Equal(&model.LeaderboardArchive{
+			ID:           leaderboard,
+			SeasonEnd:    1700604800,
+			ArchivedAt:   1700604860,
+			TotalMembers: 3,
+		}))
+	})
+
+	It("Should return error LeaderboardArchiveNotFoundError if leaderboard isn't archived", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardArchiveNotFoundError(leaderboard))
+
+		_, err := svc.GetLeaderboardArchive(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewLeaderboardArchiveNotFoundError(leaderboard)))
+	})
+
+	It("Should return error GeneralError if database return in error", func() {
+		archive.EXPECT().GetLeaderboardArchive(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("New database error"))
+
+		_, err := svc.GetLeaderboardArchive(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("get leaderboard archive", "New database error")))
+	})
+
+	It("Should return error GeneralError if service has no archive", func() {
+		svc = service.NewService(mock)
+
+		_, err := svc.GetLeaderboardArchive(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("get leaderboard archive", "leaderboard archive is not configured")))
+	})
+})
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getLeaderboardDefinitionServiceLabel = "get leaderboard definition"
+
+// GetLeaderboardDefinition return a registered leaderboard definition
+func (s *Service) GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*model.LeaderboardDefinition, error) {
+	if s.registry == nil {
+		return nil, NewGeneralError(getLeaderboardDefinitionServiceLabel, registryNotConfiguredMessage)
+	}
+
+	definition, err := s.registry.GetLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardDefinitionNotFoundError); ok {
+			return nil, NewLeaderboardDefinitionNotFoundError(leaderboard)
+		}
+		return nil, NewGeneralError(getLeaderboardDefinitionServiceLabel, err.Error())
+	}
+
+	return convertDatabaseLeaderboardDefinitionIntoModelDefinition(definition), nil
+}
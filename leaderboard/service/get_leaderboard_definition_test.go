@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetLeaderboardDefinition", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+
+		svc = service.NewService(mock, service.WithRegistry(registry))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return definition with times in unix seconds", func() {
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardDefinition{
+			ID:        leaderboard,
+			Order:     "asc",
+			EndTime:   time.Unix(1700604800, 0),
+			CreatedAt: time.Unix(1690000000, 0),
+		}, nil)
+
+		definition, err := svc.GetLeaderboardDefinition(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(definition).To(Equal(&model.LeaderboardDefinition{
+			ID:        leaderboard,
+			Order:     "asc",
+			EndTime:   1700604800,
+			CreatedAt: 1690000000,
+		}))
+	})
+
+	It("Should return error LeaderboardDefinitionNotFoundError if leaderboard isn't registered", func() {
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardDefinitionNotFoundError(leaderboard))
+
+		_, err := svc.GetLeaderboardDefinition(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+	})
+
+	It("Should return error if registry return in error", func() {
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.GetLeaderboardDefinition(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("get leaderboard definition", database.NewGeneralError("unknown error").Error())))
+	})
+})
@@ -9,9 +9,30 @@ import (
 
 const getLeadersServiceLabel = "get leaders"
 
-// GetLeaders reurn leaders
-func (s *Service) GetLeaders(ctx context.Context, leaderboard string, pageSize, page int, order string) ([]*model.Member, error) {
-	page, err := s.ensureValidPage(ctx, leaderboard, pageSize, page)
+// GetLeaders reurn leaders ranked in rankMode
+func (s *Service) GetLeaders(ctx context.Context, leaderboard string, pageSize, page int, order, rankMode string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateRankMode(leaderboard, rankMode)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getLeadersServiceLabel, err.Error())
+	}
+	order = definitionOrder(definition, order)
+
+	pageSize, err = definitionPageSize(definition, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err = s.ensureValidPage(ctx, leaderboard, pageSize, page)
 	if err != nil {
 		if _, ok := err.(*PageOutOfRangeError); ok {
 			return []*model.Member{}, nil
@@ -27,7 +48,12 @@ func (s *Service) GetLeaders(ctx context.Context, leaderboard string, pageSize,
 		return nil, NewGeneralError(getLeadersServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	err = s.rankOrderedMembers(ctx, leaderboard, order, rankMode, databaseMembers)
+	if err != nil {
+		return nil, NewGeneralError(getLeadersServiceLabel, err.Error())
+	}
+
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboard))
 	return members, nil
 }
 
@@ -31,7 +31,7 @@ var _ = Describe("Service GetLeaders", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -78,7 +78,7 @@ var _ = Describe("Service GetLeaders", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order)
+		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -124,7 +124,7 @@ var _ = Describe("Service GetLeaders", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, -1, order)
+		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, -1, order, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -135,7 +135,7 @@ var _ = Describe("Service GetLeaders", func() {
 
 		totalPages := int(math.Ceil(float64(totalMembers) / float64(pageSize)))
 
-		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, totalPages+1, order)
+		membersFromService, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, totalPages+1, order, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(BeEmpty())
@@ -144,7 +144,7 @@ var _ = Describe("Service GetLeaders", func() {
 	It("Should return error if database return in error on GetTotalPages", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(-1, fmt.Errorf("Database error example"))
 
-		_, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order)
+		_, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order, "")
 		Expect(err).To(Equal(service.NewGeneralError("get leaders", "Database error example")))
 	})
 
@@ -152,7 +152,90 @@ var _ = Describe("Service GetLeaders", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(start), gomock.Eq(stop), gomock.Eq(order)).Return(nil, fmt.Errorf("Database error example"))
 
-		_, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order)
+		_, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order, "")
 		Expect(err).To(Equal(service.NewGeneralError("get leaders", "Database error example")))
 	})
+
+	It("Should give tied members the same rank in competition and dense rank modes", func() {
+		newMembersDatabaseReturn := func() []*database.Member {
+			return []*database.Member{
+				{Member: "member1", Score: 10, Rank: 0},
+				{Member: "member2", Score: 20, Rank: 1},
+				{Member: "member3", Score: 20, Rank: 2},
+				{Member: "member4", Score: 30, Rank: 3},
+			}
+		}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil).Times(2)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(3), gomock.Eq(order)).Return(newMembersDatabaseReturn(), nil)
+		mock.EXPECT().CountMembersAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(10.0)).Return([]int{0}, nil)
+
+		members, err := svc.GetLeaders(context.Background(), leaderboard, 4, 1, order, service.RankModeCompetition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ranks(members)).To(Equal([]int{1, 2, 2, 4}))
+
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(3), gomock.Eq(order)).Return(newMembersDatabaseReturn(), nil)
+		mock.EXPECT().CountScoresAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(10.0)).Return([]int{0}, nil)
+
+		members, err = svc.GetLeaders(context.Background(), leaderboard, 4, 1, order, service.RankModeDense)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ranks(members)).To(Equal([]int{1, 2, 2, 3}))
+	})
+
+	It("Should continue ranks from first member of page in competition rank mode", func() {
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(3), gomock.Eq(5), gomock.Eq(order)).Return([]*database.Member{
+			{Member: "member4", Score: 20, Rank: 3},
+			{Member: "member5", Score: 20, Rank: 4},
+			{Member: "member6", Score: 30, Rank: 5},
+		}, nil)
+		mock.EXPECT().CountMembersAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(20.0)).Return([]int{1}, nil)
+
+		members, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, 2, order, service.RankModeCompetition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ranks(members)).To(Equal([]int{2, 2, 6}))
+	})
+
+	It("Should return error InvalidRankModeError if rank mode is invalid", func() {
+		_, err := svc.GetLeaders(context.Background(), leaderboard, pageSize, page, order, "invalid")
+		Expect(err).To(Equal(service.NewInvalidRankModeError("invalid")))
+	})
+
+	Describe("When leaderboard is registered", func() {
+		var registry *database.MockRegistry
+
+		BeforeEach(func() {
+			registry = database.NewMockRegistry(ctrl)
+			svc = service.NewService(mock, service.WithRegistry(registry))
+
+			registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardDefinition{
+				ID:              leaderboard,
+				Order:           "asc",
+				DefaultPageSize: 5,
+				MaxPageSize:     8,
+			}, nil)
+		})
+
+		It("Should use leaderboard order and default page size if request doesn't set them", func() {
+			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(totalMembers, nil)
+			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(4), gomock.Eq("asc")).
+				Return([]*database.Member{}, nil)
+
+			_, err := svc.GetLeaders(context.Background(), leaderboard, 0, page, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return error PageSizeOutOfRangeError if page size is above leaderboard max page size", func() {
+			_, err := svc.GetLeaders(context.Background(), leaderboard, 10, page, "", "")
+			Expect(err).To(Equal(service.NewPageSizeOutOfRangeError(10, 8)))
+		})
+	})
 })
+
+func ranks(members []*model.Member) []int {
+	memberRanks := make([]int, 0, len(members))
+	for _, member := range members {
+		memberRanks = append(memberRanks, member.Rank)
+	}
+	return memberRanks
+}
@@ -9,8 +9,28 @@ import (
 
 const getMemberServiceLabel = "get member"
 
-// GetMember return a member info
-func (s *Service) GetMember(ctx context.Context, leaderboard, member string, order string, includeTTL bool) (*model.Member, error) {
+// GetMember return a member info, ranked in rankMode
+func (s *Service) GetMember(ctx context.Context, leaderboard, member string, order string, includeTTL bool, rankMode string) (*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateRankMode(leaderboard, rankMode)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard, err = s.memberLeaderboard(ctx, getMemberServiceLabel, leaderboard, member)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.getOrder(ctx, leaderboard, order)
+	if err != nil {
+		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, includeTTL, member)
 	if err != nil {
 		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
@@ -20,15 +40,22 @@ func (s *Service) GetMember(ctx context.Context, leaderboard, member string, ord
 		return nil, NewMemberNotFoundError(leaderboard, member)
 	}
 
+	err = s.rankMembers(ctx, leaderboard, order, rankMode, databaseMembers)
+	if err != nil {
+		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
+	}
+
 	var ttl int64 = 0
 	if (databaseMembers[0].TTL != time.Time{}) {
 		ttl = databaseMembers[0].TTL.Unix()
 	}
 
-	return &model.Member{
+	modelMember := &model.Member{
 		PublicID: databaseMembers[0].Member,
-		Score:    int64(databaseMembers[0].Score),
 		Rank:     int(databaseMembers[0].Rank) + 1,
 		ExpireAt: int(ttl),
-	}, nil
+	}
+	s.scoreEncoding(leaderboard).decode(modelMember, databaseMembers[0].Score)
+
+	return modelMember, nil
 }
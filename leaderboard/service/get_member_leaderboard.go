@@ -0,0 +1,16 @@
+package service
+
+import "context"
+
+const getMemberLeaderboardServiceLabel = "get member leaderboard"
+
+// GetMemberLeaderboard return the leaderboard member is ranked in, the bucket member was assigned to if
+// leaderboard is a league, otherwise leaderboard itself
+func (s *Service) GetMemberLeaderboard(ctx context.Context, leaderboard, member string) (string, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return "", err
+	}
+
+	return s.memberLeaderboard(ctx, getMemberLeaderboardServiceLabel, leaderboard, member)
+}
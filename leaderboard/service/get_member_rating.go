@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getMemberRatingServiceLabel = "get member rating"
+
+// GetMemberRating return the rating of a member of a rating leaderboard and its rank in leaderboard default order
+func (s *Service) GetMemberRating(ctx context.Context, leaderboard, member string) (*model.MemberRating, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.getRatingSettings(leaderboard) == nil || s.getLeague(leaderboard) != nil {
+		return nil, NewLeaderboardNotRatedError(leaderboard)
+	}
+
+	ratings, err := s.ratings.GetRatings(ctx, leaderboard, member)
+	if err != nil {
+		return nil, NewGeneralError(getMemberRatingServiceLabel, err.Error())
+	}
+
+	storedRating, ok := ratings[member]
+	if !ok {
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	order, err := s.getOrder(ctx, leaderboard, "")
+	if err != nil {
+		return nil, NewGeneralError(getMemberRatingServiceLabel, err.Error())
+	}
+
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, false, member)
+	if err != nil {
+		return nil, NewGeneralError(getMemberRatingServiceLabel, err.Error())
+	}
+
+	if databaseMembers[0] == nil {
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	return &model.MemberRating{
+		PublicID:       member,
+		Score:          int64(databaseMembers[0].Score),
+		Rank:           int(databaseMembers[0].Rank) + 1,
+		PreviousRank:   -1,
+		Rating:         storedRating.Rating,
+		PreviousRating: storedRating.Rating,
+		Deviation:      storedRating.Deviation,
+		Volatility:     storedRating.Volatility,
+		Matches:        storedRating.Matches,
+	}, nil
+}
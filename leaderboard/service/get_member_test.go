@@ -27,7 +27,7 @@ var _ = Describe("Service GetMember", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -53,7 +53,7 @@ var _ = Describe("Service GetMember", func() {
 
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq(member)).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL)
+		membersFromService, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -78,7 +78,7 @@ var _ = Describe("Service GetMember", func() {
 
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq(member)).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL)
+		membersFromService, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -91,7 +91,7 @@ var _ = Describe("Service GetMember", func() {
 
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq(member)).Return(membersDatabaseReturn, nil)
 
-		_, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL)
+		_, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL, "")
 		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
 
 	})
@@ -99,7 +99,7 @@ var _ = Describe("Service GetMember", func() {
 	It("Should return error if database return in error", func() {
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq(member)).Return(nil, fmt.Errorf("Database error example"))
 
-		_, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL)
+		_, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL, "")
 		Expect(err).To(Equal(service.NewGeneralError("get member", "Database error example")))
 	})
 })
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getMemberTierServiceLabel = "get member tier"
+
+// GetMemberTier return the tier member plays the current season of competition in, the tier it was moved to
+// when its latest season ended or the lowest one, and its tier history
+func (s *Service) GetMemberTier(ctx context.Context, competition, member string) (*model.MemberTier, error) {
+	var tieredLeague *TieredLeague
+	if s.tieredLeagues != nil {
+		tieredLeague = s.tieredLeagues(competition)
+	}
+	if tieredLeague == nil || len(tieredLeague.Tiers) == 0 {
+		return nil, NewTieredLeagueNotFoundError(competition)
+	}
+
+	history := []*database.TierMovement{}
+	if s.tiers != nil {
+		var err error
+		history, err = s.tiers.GetTierHistory(ctx, competition, member)
+		if err != nil {
+			return nil, NewGeneralError(getMemberTierServiceLabel, err.Error())
+		}
+	}
+
+	tier := tieredLeague.Tiers[0]
+	if len(history) > 0 {
+		tier = tieredLeague.Tiers[tieredLeague.tierIndex(history[len(history)-1].NextTier)]
+	}
+
+	memberTier := &model.MemberTier{
+		PublicID:    member,
+		Tier:        tier,
+		Leaderboard: tieredLeague.Leaderboard(tier, time.Now()),
+		History:     make([]*model.TierMovement, 0, len(history)),
+	}
+	for _, movement := range history {
+		memberTier.History = append(memberTier.History, &model.TierMovement{
+			Leaderboard: movement.Leaderboard,
+			SeasonEnd:   timeToUnix(movement.SeasonEnd),
+			Tier:        movement.Tier,
+			Rank:        movement.Rank,
+			Movement:    movement.Movement,
+			NextTier:    movement.NextTier,
+		})
+	}
+
+	return memberTier, nil
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetMemberTier", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var tiersMock *database.MockTiers
+	var svc *service.Service
+
+	var competition string = "ranked"
+	var member string = "member1"
+	var tieredLeague = &service.TieredLeague{
+		Prefix:  "ranked-",
+		Cadence: expiration.CadenceWeekly,
+		Tiers:   []string{"bronze", "silver", "gold"},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		tiersMock = database.NewMockTiers(ctrl)
+
+		svc = service.NewService(mock, service.WithTieredLeagues(tiersMock, func(id string) *service.TieredLeague {
+			if id == competition {
+				return tieredLeague
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return the tier member was moved to and its history", func() {
+		seasonEnd := time.Unix(1792800000, 0)
+		tiersMock.EXPECT().GetTierHistory(gomock.Any(), gomock.Eq(competition), gomock.Eq(member)).Return([]*database.TierMovement{
+			{Leaderboard: "ranked-bronze-year2026week41~0", SeasonEnd: seasonEnd, Tier: "bronze", Rank: 1, Movement: service.TierPromoted, NextTier: "silver"},
+		}, nil)
+
+		memberTier, err := svc.GetMemberTier(context.Background(), competition, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(memberTier).To(Equal(&model.MemberTier{
+			PublicID:    member,
+			Tier:        "silver",
+			Leaderboard: tieredLeague.Leaderboard("silver", time.Now()),
+			History: []*model.TierMovement{
+				{Leaderboard: "ranked-bronze-year2026week41~0", SeasonEnd: 1792800000, Tier: "bronze", Rank: 1, Movement: service.TierPromoted, NextTier: "silver"},
+			},
+		}))
+	})
+
+	It("Should place members without history in the lowest tier", func() {
+		tiersMock.EXPECT().GetTierHistory(gomock.Any(), gomock.Eq(competition), gomock.Eq(member)).Return([]*database.TierMovement{}, nil)
+
+		memberTier, err := svc.GetMemberTier(context.Background(), competition, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(memberTier.Tier).To(Equal("bronze"))
+		Expect(memberTier.History).To(BeEmpty())
+	})
+
+	It("Should return error TieredLeagueNotFoundError if competition isn't a tiered league", func() {
+		_, err := svc.GetMemberTier(context.Background(), "casual", member)
+		Expect(err).To(MatchError(service.NewTieredLeagueNotFoundError("casual")))
+	})
+
+	It("Should return error if database return in error", func() {
+		tiersMock.EXPECT().GetTierHistory(gomock.Any(), gomock.Eq(competition), gomock.Eq(member)).Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.GetMemberTier(context.Background(), competition, member)
+		Expect(err).To(Equal(service.NewGeneralError("get member tier", database.NewGeneralError("unknown error").Error())))
+	})
+})
@@ -10,31 +10,58 @@ import (
 
 const getMembersServiceLabel = "get members"
 
-// GetMembers return member informations that is
-func (s *Service) GetMembers(ctx context.Context, leaderboard string, members []string, order string, includeTTL bool) ([]*model.Member, error) {
-	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, includeTTL, members...)
+// GetMembers return member informations that is, ranked in rankMode
+func (s *Service) GetMembers(ctx context.Context, leaderboard string, members []string, order string, includeTTL bool, rankMode string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateRankMode(leaderboard, rankMode)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.getOrder(ctx, leaderboard, order)
 	if err != nil {
 		return nil, NewGeneralError(getMembersServiceLabel, err.Error())
 	}
 
-	membersToReturn := make([]*model.Member, 0, len(databaseMembers))
-	for _, member := range databaseMembers {
-		if member == nil {
-			continue
-		}
+	leaderboards, leaderboardMembers, err := s.membersLeaderboards(ctx, leaderboard, members)
+	if err != nil {
+		return nil, NewGeneralError(getMembersServiceLabel, err.Error())
+	}
 
-		var ttl int64
-		if (member.TTL != time.Time{}) {
-			ttl = member.TTL.Unix()
+	membersToReturn := make([]*model.Member, 0, len(members))
+	for _, leaderboard := range leaderboards {
+		databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, includeTTL, leaderboardMembers[leaderboard]...)
+		if err != nil {
+			return nil, NewGeneralError(getMembersServiceLabel, err.Error())
 		}
-		newMember := &model.Member{
-			PublicID: member.Member,
-			Score:    int64(member.Score),
-			Rank:     int(member.Rank) + 1,
-			ExpireAt: int(ttl),
+
+		err = s.rankMembers(ctx, leaderboard, order, rankMode, databaseMembers)
+		if err != nil {
+			return nil, NewGeneralError(getMembersServiceLabel, err.Error())
 		}
-		membersToReturn = append(membersToReturn, newMember)
 
+		encoding := s.scoreEncoding(leaderboard)
+		for _, member := range databaseMembers {
+			if member == nil {
+				continue
+			}
+
+			var ttl int64
+			if (member.TTL != time.Time{}) {
+				ttl = member.TTL.Unix()
+			}
+			newMember := &model.Member{
+				PublicID: member.Member,
+				Rank:     int(member.Rank) + 1,
+				ExpireAt: int(ttl),
+			}
+			encoding.decode(newMember, member.Score)
+			membersToReturn = append(membersToReturn, newMember)
+		}
 	}
 
 	sort.SliceStable(membersToReturn, func(i, j int) bool { return membersToReturn[i].Rank < membersToReturn[j].Rank })
@@ -10,11 +10,21 @@ const getMembersByRangeServiceLabel = "get members by range"
 
 // GetMembersByRange reurn how many pages members have in a leaderboard according to pageSize
 func (s *Service) GetMembersByRange(ctx context.Context, leaderboard string, start int, stop int, order string) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.getOrder(ctx, leaderboard, order)
+	if err != nil {
+		return nil, NewGeneralError(getMembersByRangeServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetOrderedMembers(ctx, leaderboard, start, stop, order)
 	if err != nil {
 		return nil, NewGeneralError(getMembersByRangeServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboard))
 	return members, nil
 }
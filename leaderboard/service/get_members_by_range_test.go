@@ -26,7 +26,7 @@ var _ = Describe("Service GetMembersByRange", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
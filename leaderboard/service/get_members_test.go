@@ -27,7 +27,7 @@ var _ = Describe("Service GetMembers", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -68,7 +68,7 @@ var _ = Describe("Service GetMembers", func() {
 
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL)
+		membersFromService, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -120,7 +120,7 @@ var _ = Describe("Service GetMembers", func() {
 
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return(membersDatabaseReturn, nil)
 
-		membersFromService, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL)
+		membersFromService, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(membersFromService).To(Equal(membersReturn))
@@ -129,7 +129,23 @@ var _ = Describe("Service GetMembers", func() {
 	It("Should return error if database return in error", func() {
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return(nil, fmt.Errorf("Database error example"))
 
-		_, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL)
+		_, err := svc.GetMembers(context.Background(), leaderboard, members, order, includeTTL, "")
 		Expect(err).To(Equal(service.NewGeneralError("get members", "Database error example")))
 	})
+
+	It("Should rank members in dense rank mode with a single lookup", func() {
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(false), gomock.Eq("member1"), gomock.Eq("invalid"), gomock.Eq("member2")).Return([]*database.Member{
+			{Member: "member1", Score: 20, Rank: 4},
+			nil,
+			{Member: "member2", Score: 10, Rank: 1},
+		}, nil)
+		mock.EXPECT().CountScoresAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(20.0), gomock.Eq(10.0)).Return([]int{2, 0}, nil)
+
+		members, err := svc.GetMembers(context.Background(), leaderboard, []string{"member1", "invalid", "member2"}, order, false, service.RankModeDense)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.Member{
+			{PublicID: "member2", Score: 10, Rank: 1},
+			{PublicID: "member1", Score: 20, Rank: 3},
+		}))
+	})
 })
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getQuarantinedMembersServiceLabel = "get quarantined members"
+
+// GetQuarantinedMembers return the latest score write of each member held for review in leaderboard, oldest first
+func (s *Service) GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*model.QuarantinedMember, error) {
+	if s.submissions == nil {
+		return []*model.QuarantinedMember{}, nil
+	}
+
+	entries, err := s.submissions.GetQuarantinedMembers(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getQuarantinedMembersServiceLabel, err.Error())
+	}
+
+	members := make([]*model.QuarantinedMember, 0, len(entries))
+	for _, entry := range entries {
+		members = append(members, &model.QuarantinedMember{
+			PublicID:    entry.Member,
+			Score:       entry.Score,
+			Rule:        entry.Rule,
+			SubmittedAt: timeToUnix(entry.SubmittedAt),
+		})
+	}
+
+	return members, nil
+}
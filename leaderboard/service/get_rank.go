@@ -8,8 +8,32 @@ import (
 
 const getRankServiceLabel = "get rank"
 
-// GetRank return the current member rank in a specific order
-func (s *Service) GetRank(ctx context.Context, leaderboard, member, order string) (int, error) {
+// GetRank return the current member rank in a specific order and rank mode
+func (s *Service) GetRank(ctx context.Context, leaderboard, member, order, rankMode string) (int, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return -1, err
+	}
+
+	err = s.validateRankMode(leaderboard, rankMode)
+	if err != nil {
+		return -1, err
+	}
+
+	leaderboard, err = s.memberLeaderboard(ctx, getRankServiceLabel, leaderboard, member)
+	if err != nil {
+		return -1, err
+	}
+
+	order, err = s.getOrder(ctx, leaderboard, order)
+	if err != nil {
+		return -1, NewGeneralError(getRankServiceLabel, err.Error())
+	}
+
+	if !isOrdinalRankMode(rankMode) {
+		return s.getRankByScore(ctx, leaderboard, member, order, rankMode)
+	}
+
 	rank, err := s.Database.GetRank(ctx, leaderboard, member, order)
 	if err != nil {
 		if _, ok := err.(*database.MemberNotFoundError); ok {
@@ -21,3 +45,21 @@ func (s *Service) GetRank(ctx context.Context, leaderboard, member, order string
 
 	return int(rank + 1), nil
 }
+
+func (s *Service) getRankByScore(ctx context.Context, leaderboard, member, order, rankMode string) (int, error) {
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, false, member)
+	if err != nil {
+		return -1, NewGeneralError(getRankServiceLabel, err.Error())
+	}
+
+	if databaseMembers[0] == nil {
+		return -1, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	counts, err := s.countAhead(ctx, leaderboard, order, rankMode, databaseMembers[0].Score)
+	if err != nil {
+		return -1, NewGeneralError(getRankServiceLabel, err.Error())
+	}
+
+	return counts[0] + 1, nil
+}
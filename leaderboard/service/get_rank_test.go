@@ -25,7 +25,7 @@ var _ = Describe("Service GetRank", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -35,7 +35,7 @@ var _ = Describe("Service GetRank", func() {
 	It("Should return member position if database returns OK", func() {
 		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(rank, nil)
 
-		rankReturned, err := svc.GetRank(context.Background(), leaderboard, member, order)
+		rankReturned, err := svc.GetRank(context.Background(), leaderboard, member, order, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(rankReturned).To(Equal(rank + 1))
@@ -44,14 +44,48 @@ var _ = Describe("Service GetRank", func() {
 	It("Should return error MemberNotFoundError if database return in error", func() {
 		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, database.NewMemberNotFoundError(leaderboard, member))
 
-		_, err := svc.GetRank(context.Background(), leaderboard, member, order)
+		_, err := svc.GetRank(context.Background(), leaderboard, member, order, "")
 		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
 	})
 
 	It("Should return error if database return in error", func() {
 		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(order)).Return(-1, fmt.Errorf("Database error example"))
 
-		_, err := svc.GetRank(context.Background(), leaderboard, member, order)
+		_, err := svc.GetRank(context.Background(), leaderboard, member, order, "")
 		Expect(err).To(Equal(service.NewGeneralError("get rank", "Database error example")))
 	})
+
+	It("Should count members with better scores in competition rank mode", func() {
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 20, Rank: 5},
+		}, nil)
+		mock.EXPECT().CountMembersAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(20.0)).Return([]int{3}, nil)
+
+		rankReturned, err := svc.GetRank(context.Background(), leaderboard, member, order, service.RankModeCompetition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rankReturned).To(Equal(4))
+	})
+
+	It("Should count better distinct scores in dense rank mode", func() {
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 20, Rank: 5},
+		}, nil)
+		mock.EXPECT().CountScoresAhead(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(20.0)).Return([]int{2}, nil)
+
+		rankReturned, err := svc.GetRank(context.Background(), leaderboard, member, order, service.RankModeDense)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rankReturned).To(Equal(3))
+	})
+
+	It("Should return error MemberNotFoundError in dense rank mode if member is not in leaderboard", func() {
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{nil}, nil)
+
+		_, err := svc.GetRank(context.Background(), leaderboard, member, order, service.RankModeDense)
+		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, member)))
+	})
+
+	It("Should return error InvalidRankModeError if rank mode is invalid", func() {
+		_, err := svc.GetRank(context.Background(), leaderboard, member, order, "invalid")
+		Expect(err).To(Equal(service.NewInvalidRankModeError("invalid")))
+	})
 })
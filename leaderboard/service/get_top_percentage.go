@@ -9,12 +9,27 @@ import (
 
 const getTopPercentageServiceLabel = "get top percentage"
 
-// GetTopPercentage retrieves top x% members from the leaderboard.
-func (s *Service) GetTopPercentage(ctx context.Context, leaderboardID string, pageSize, amount, maxMembers int, order string) ([]*model.Member, error) {
+// GetTopPercentage retrieves top x% members from the leaderboard ranked in rankMode.
+func (s *Service) GetTopPercentage(ctx context.Context, leaderboardID string, pageSize, amount, maxMembers int, order, rankMode string) ([]*model.Member, error) {
+	leaderboardID, err := s.resolveLeaderboard(leaderboardID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateRankMode(leaderboardID, rankMode)
+	if err != nil {
+		return nil, err
+	}
+
 	if amount < 1 || amount > 100 {
 		return nil, NewPercentageError(amount)
 	}
 
+	order, err = s.getOrder(ctx, leaderboardID, order)
+	if err != nil {
+		return nil, NewGeneralError(getTopPercentageServiceLabel, err.Error())
+	}
+
 	if order != "desc" && order != "asc" {
 		order = "desc"
 	}
@@ -40,6 +55,11 @@ func (s *Service) GetTopPercentage(ctx context.Context, leaderboardID string, pa
 		return nil, NewGeneralError(getTopPercentageServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	err = s.rankOrderedMembers(ctx, leaderboardID, order, rankMode, databaseMembers)
+	if err != nil {
+		return nil, NewGeneralError(getTopPercentageServiceLabel, err.Error())
+	}
+
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, s.scoreEncoding(leaderboardID))
 	return members, nil
 }
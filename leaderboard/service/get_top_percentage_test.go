@@ -63,7 +63,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -80,7 +80,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(100, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(2), gomock.Eq(order)).Return(membersReturnedByDatabase, nil)
 
-			members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+			members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(members).To(HaveLen(3))
 			Expect(members).To(Equal(expectedMembersToReturn))
@@ -109,7 +109,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(100, nil)
 			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(2), gomock.Eq(order)).Return(membersDatabaseWillReturn, nil)
 
-			members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+			members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(members).To(HaveLen(3))
 			Expect(members).To(Equal(expectedReturn))
@@ -122,17 +122,17 @@ var _ = Describe("Service GetTopPercentage", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(100, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(2), gomock.Eq("desc")).Return(membersReturnedByDatabase, nil)
 
-		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(members).To(HaveLen(3))
 		Expect(members).To(Equal(expectedMembersToReturn))
 	})
 
 	It("Should return PercentageError if percentage is grater than 100 or small than 1", func() {
-		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, 0, maxMembers, order)
+		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, 0, maxMembers, order, "")
 		Expect(err).To(MatchError(service.NewPercentageError(0)))
 
-		_, err = svc.GetTopPercentage(context.Background(), leaderboard, pageSize, 101, maxMembers, order)
+		_, err = svc.GetTopPercentage(context.Background(), leaderboard, pageSize, 101, maxMembers, order, "")
 		Expect(err).To(MatchError(service.NewPercentageError(101)))
 	})
 
@@ -144,7 +144,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(10, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(0), gomock.Eq(order)).Return([]*database.Member{}, nil)
 
-		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(members).To(HaveLen(0))
 	})
@@ -157,7 +157,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1000, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Any(), gomock.Eq(order)).Return(membersReturnedByDatabase, nil)
 
-		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		members, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(members).To(HaveLen(maxMembers))
 	})
@@ -166,7 +166,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		amount = 0
 		order = "desc"
 
-		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -174,7 +174,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		amount = 101
 		order = "desc"
 
-		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -186,7 +186,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(0, errors.New("Database error example"))
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
-		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -198,7 +198,7 @@ var _ = Describe("Service GetTopPercentage", func() {
 		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(100, nil)
 		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(2), gomock.Eq(order)).Return([]*database.Member{}, errors.New("Database error example"))
 
-		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order)
+		_, err := svc.GetTopPercentage(context.Background(), leaderboard, pageSize, amount, maxMembers, order, "")
 		Expect(err).Should(HaveOccurred())
 	})
 })
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyWindow sets how long the result of a write made with an idempotency key is kept, writes
+// repeated with the same key within the window return the first result instead of being applied again.
+// Without it idempotency keys are ignored
+func WithIdempotencyWindow(window time.Duration) Option {
+	return func(s *Service) {
+		s.idempotencyWindow = window
+	}
+}
+
+// ContextWithIdempotencyKey return a copy of ctx whose score writes, match results and challenge results are
+// applied once per leaderboard for key, an empty key leaves writes as they are
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// idempotencyKey return the idempotency key of ctx, empty if it has none or service keeps no results
+func (s *Service) idempotencyKey(ctx context.Context) string {
+	if s.idempotencyWindow <= 0 {
+		return ""
+	}
+
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// replayIdempotentWrite fill members with the result kept for the idempotency key of ctx on leaderboard,
+// including the score TTL of the first write and the members it held for review, returning false if there's none
+func (s *Service) replayIdempotentWrite(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool) (bool, error) {
+	key := s.idempotencyKey(ctx)
+	if key == "" {
+		return false, nil
+	}
+
+	replayedMembers, err := s.Database.GetIdempotentMembers(ctx, leaderboard, key)
+	if err != nil {
+		return false, err
+	}
+	if replayedMembers == nil {
+		return false, nil
+	}
+
+	encoding := s.scoreEncoding(leaderboard)
+	for _, replayed := range replayedMembers {
+		for _, member := range members {
+			if member.PublicID == replayed.Member {
+				fillUpsertedMember(member, replayed, encoding, prevRank)
+			}
+		}
+	}
+	return true, nil
+}
+
+// isIdempotentRetry return whether submission repeats a write whose result is still kept, retries carry the
+// same signature and nonce as the first write. Results of league writes are kept in the bucket of each member
+func (s *Service) isIdempotentRetry(ctx context.Context, submission *model.SignedSubmission) (bool, error) {
+	if s.idempotencyWindow <= 0 || submission.IdempotencyKey == "" {
+		return false, nil
+	}
+
+	leaderboard, err := s.resolveLeaderboard(submission.Leaderboard)
+	if err != nil {
+		return false, nil
+	}
+
+	members := make([]string, len(submission.Members))
+	for i, member := range submission.Members {
+		members[i] = member.PublicID
+	}
+	leaderboards, _, err := s.membersLeaderboards(ctx, leaderboard, members)
+	if err != nil {
+		return false, err
+	}
+
+	for _, leaderboard := range leaderboards {
+		replayedMembers, err := s.Database.GetIdempotentMembers(ctx, leaderboard, submission.IdempotencyKey)
+		if err != nil {
+			return false, err
+		}
+		if replayedMembers != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service Idempotency", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should write scores with the idempotency key of the context", func() {
+		svc := service.NewService(mock, service.WithIdempotencyWindow(time.Hour))
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Eq("retry-1")).Return(nil, nil)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.IdempotencyKey).To(Equal("retry-1"))
+				Expect(options.IdempotencyWindow).To(Equal(time.Hour))
+				return []*database.Member{{Member: "member1", Score: 10, Rank: 0}}, nil
+			})
+
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "retry-1")
+		member, err := svc.IncrementMemberScore(ctx, "leaderboard", "member1", 10, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(10.0))
+	})
+
+	It("Should replay a kept write without checking season or score rules again", func() {
+		submissions := database.NewMockSubmissions(ctrl)
+		maxScore := 100.0
+		svc := service.NewService(mock, service.WithIdempotencyWindow(time.Hour),
+			service.WithScoreRules(submissions, func(string) *service.ScoreRules {
+				return &service.ScoreRules{MaxScore: &maxScore, MaxSubmissions: 1, SubmissionWindow: time.Minute}
+			}),
+			service.WithRegistry(database.NewMockRegistry(ctrl)))
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Eq("retry-1")).
+			Return([]*database.Member{{Member: "member1", Score: 50, Rank: 2, PreviousRank: -1, ScoreChanged: true}}, nil)
+
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "retry-1")
+		members := []*model.Member{{PublicID: "member1", Score: 50}, {PublicID: "member2", Score: 500}}
+		Expect(svc.SetMembersScore(ctx, "leaderboard", members, true, "", "")).To(Succeed())
+		Expect(members).To(Equal([]*model.Member{
+			{PublicID: "member1", Score: 50, Rank: 3, PreviousRank: -1, ScoreChanged: true},
+			{PublicID: "member2", Score: 500},
+		}))
+	})
+
+	It("Should replay the score TTL of the kept write instead of the retry one", func() {
+		svc := service.NewService(mock, service.WithIdempotencyWindow(time.Hour))
+		keptTTL := time.Unix(1700000000, 0)
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Eq("retry-1")).
+			Return([]*database.Member{{Member: "member1", Score: 10, Rank: 0, TTL: keptTTL}}, nil)
+
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "retry-1")
+		member, err := svc.SetMemberScore(ctx, "leaderboard", "member1", 10, false, "60", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.ExpireAt).To(Equal(int(keptTTL.Unix())))
+	})
+
+	It("Should replay the members held for review by the kept write as quarantined", func() {
+		svc := service.NewService(mock, service.WithIdempotencyWindow(time.Hour))
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Eq("retry-1")).
+			Return([]*database.Member{
+				{Member: "member1", Score: 10, Rank: 0},
+				{Member: "member2", Score: 5000, Rank: -1, PreviousRank: -1, QuarantineRule: service.ScoreRuleMaxScore},
+			}, nil)
+
+		members := []*model.Member{{PublicID: "member1", Score: 10}, {PublicID: "member2", Score: 5000}}
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "retry-1")
+		Expect(svc.SetMembersScore(ctx, "leaderboard", members, false, "", "")).To(Succeed())
+		Expect(members).To(Equal([]*model.Member{
+			{PublicID: "member1", Score: 10, Rank: 1},
+			{PublicID: "member2", Score: 5000, Quarantined: true},
+		}))
+	})
+
+	It("Should ignore idempotency keys without an idempotency window", func() {
+		svc := service.NewService(mock)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("leaderboard"), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.IdempotencyKey).To(BeEmpty())
+				return []*database.Member{{Member: "member1", Score: 10, Rank: 0}}, nil
+			})
+
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "retry-1")
+		_, err := svc.SetMemberScore(ctx, "leaderboard", "member1", 10, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
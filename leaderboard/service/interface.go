@@ -10,26 +10,50 @@ import (
 type Leaderboard interface {
 	Healthcheck(ctx context.Context) error
 
-	IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment int, scoreTTL string) (*model.Member, error)
-	SetMemberScore(ctx context.Context, leaderboard, member string, score int64, prevRank bool, scoreTTL string) (*model.Member, error)
-	SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string) error
+	IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment float64, scoreTTL string) (*model.Member, error)
+	SetMemberScore(ctx context.Context, leaderboard, member string, score float64, prevRank bool, scoreTTL, updatePolicy string) (*model.Member, error)
+	SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL, updatePolicy string) error
 
 	RemoveLeaderboard(ctx context.Context, leaderboard string) error
 	RemoveMember(ctx context.Context, leaderboard, member string) error
 	RemoveMembers(ctx context.Context, leaderboard string, members []string) error
 
-	GetMember(ctx context.Context, leaderboard, member string, order string, includeTTL bool) (*model.Member, error)
-	GetMembers(ctx context.Context, leaderboard string, members []string, order string, includeTTL bool) ([]*model.Member, error)
+	GetMember(ctx context.Context, leaderboard, member string, order string, includeTTL bool, rankMode string) (*model.Member, error)
+	GetMembers(ctx context.Context, leaderboard string, members []string, order string, includeTTL bool, rankMode string) ([]*model.Member, error)
 	GetMembersByRange(ctx context.Context, leaderboard string, start int, stop int, order string) ([]*model.Member, error)
-	GetRank(ctx context.Context, leaderboard, member, order string) (int, error)
+	GetRank(ctx context.Context, leaderboard, member, order, rankMode string) (int, error)
+	GetMemberLeaderboard(ctx context.Context, leaderboard, member string) (string, error)
 
 	TotalMembers(ctx context.Context, leaderboard string) (int, error)
 	TotalPages(ctx context.Context, leaderboard string, pageSize int) (int, error)
 
-	GetLeaders(ctx context.Context, leaderboard string, pageSize, page int, order string) ([]*model.Member, error)
-	GetTopPercentage(ctx context.Context, leaderboard string, pageSize, amount, maxMembers int, order string) ([]*model.Member, error)
+	GetLeaders(ctx context.Context, leaderboard string, pageSize, page int, order, rankMode string) ([]*model.Member, error)
+	GetTopPercentage(ctx context.Context, leaderboard string, pageSize, amount, maxMembers int, order, rankMode string) ([]*model.Member, error)
 
-	GetAroundMe(ctx context.Context, leaderboard string, pageSize int, member string, order string, getLastIfNotFound bool) ([]*model.Member, error)
+	GetAroundMe(ctx context.Context, leaderboard string, pageSize int, member string, order string, getLastIfNotFound bool, rankMode string) ([]*model.Member, error)
 
-	GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score int64, order string) ([]*model.Member, error)
+	GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score float64, order string) ([]*model.Member, error)
+
+	CreateLeaderboardDefinition(ctx context.Context, definition *model.LeaderboardDefinition) (*model.LeaderboardDefinition, error)
+	GetLeaderboardDefinition(ctx context.Context, leaderboard string) (*model.LeaderboardDefinition, error)
+	ListLeaderboardDefinitions(ctx context.Context) ([]*model.LeaderboardDefinition, error)
+	UpdateLeaderboardDefinition(ctx context.Context, definition *model.LeaderboardDefinition) (*model.LeaderboardDefinition, error)
+	RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error
+
+	GetLeaderboardArchive(ctx context.Context, leaderboard string) (*model.LeaderboardArchive, error)
+	GetArchivedLeaders(ctx context.Context, leaderboard string, pageSize, page int, order string) ([]*model.Member, error)
+	GetArchivedMember(ctx context.Context, leaderboard, member, order string) (*model.Member, error)
+	GetArchivedMemberReward(ctx context.Context, leaderboard, member string) (*model.Member, error)
+
+	GetMemberTier(ctx context.Context, competition, member string) (*model.MemberTier, error)
+
+	SubmitMatchResult(ctx context.Context, leaderboard string, results []*model.MatchResult) ([]*model.MemberRating, error)
+	GetMemberRating(ctx context.Context, leaderboard, member string) (*model.MemberRating, error)
+
+	ReportChallengeResult(ctx context.Context, leaderboard, challenger, defender string, challengerWon bool) ([]*model.Member, error)
+
+	GetQuarantinedMembers(ctx context.Context, leaderboard string) ([]*model.QuarantinedMember, error)
+
+	VerifySubmission(ctx context.Context, tenant string, submission *model.SignedSubmission) error
+	ReleaseSubmission(ctx context.Context, tenant string, submission *model.SignedSubmission) error
 }
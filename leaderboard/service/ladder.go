@@ -0,0 +1,35 @@
+package service
+
+import (
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// ladderOrder is the order ladder members are ranked in, their scores are positions and lower ones rank higher
+const ladderOrder = "asc"
+
+// Ladder is a leaderboard whose members hold positions instead of scores, a challenger that beats a defender
+// ranked above it takes the defender position and everyone in between moves one position down
+type Ladder struct {
+	// MaxChallengeDistance is how many ranks above the challenger a defender can be, zero means any
+	MaxChallengeDistance int
+}
+
+// getLadder return the ladder leaderboard is, nil if it isn't a ladder
+func (s *Service) getLadder(leaderboard string) *Ladder {
+	if s.ladders == nil || s.ladderConfigs == nil {
+		return nil
+	}
+	return s.ladderConfigs(s.leagueOf(leaderboard))
+}
+
+// ladderDefinition return definition ranking members in ladder order, an unregistered ladder gets a
+// definition with only its order
+func ladderDefinition(definition *database.LeaderboardDefinition) *database.LeaderboardDefinition {
+	if definition == nil {
+		return &database.LeaderboardDefinition{Order: ladderOrder}
+	}
+
+	ladder := *definition
+	ladder.Order = ladderOrder
+	return &ladder
+}
@@ -8,28 +8,202 @@ import (
 	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 )
 
-func (s *Service) persistLeaderboardExpirationTime(ctx context.Context, leaderboard string) error {
-	expireAt, err := expiration.GetExpireAt(leaderboard)
+const (
+	defaultOrder    = "desc"
+	defaultPageSize = 20
+)
+
+// getSeasonPolicy return the leaderboard season policy, the zero one if service has no season policies
+func (s *Service) getSeasonPolicy(leaderboard string) expiration.Policy {
+	if s.seasonPolicies == nil {
+		return expiration.Policy{}
+	}
+	return s.seasonPolicies(s.leagueOf(leaderboard))
+}
+
+func (s *Service) getLeaderboardExpireAt(leaderboard string) (time.Time, error) {
+	expireAt, err := s.getSeasonPolicy(leaderboard).GetExpireAt(s.leagueOf(leaderboard))
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	if expireAt == -1 {
-		return nil
+		return time.Time{}, nil
+	}
+
+	return time.Unix(expireAt, 0), nil
+}
+
+// getLeaderboardDefinition return leaderboard definition, nil if service has no registry or leaderboard isn't registered,
+// ladder leaderboards always rank their members in ascending position order
+func (s *Service) getLeaderboardDefinition(ctx context.Context, leaderboard string) (*database.LeaderboardDefinition, error) {
+	definition, err := s.getRegisteredDefinition(ctx, leaderboard)
+	if err != nil || s.getLadder(leaderboard) == nil {
+		return definition, err
+	}
+
+	return ladderDefinition(definition), nil
+}
+
+// getRegisteredDefinition return leaderboard definition as registered, nil if service has no registry or
+// leaderboard isn't registered
+func (s *Service) getRegisteredDefinition(ctx context.Context, leaderboard string) (*database.LeaderboardDefinition, error) {
+	if s.registry == nil {
+		return nil, nil
+	}
+
+	id := s.leagueOf(leaderboard)
+	if s.definitions != nil {
+		if definition, ok := s.definitions.get(id); ok {
+			return definition, nil
+		}
 	}
 
-	_, err = s.Database.GetLeaderboardExpiration(ctx, leaderboard)
+	definition, err := s.registry.GetLeaderboardDefinition(ctx, id)
 	if err != nil {
-		if _, ok := err.(*database.TTLNotFoundError); ok {
-			err = s.Database.SetLeaderboardExpiration(ctx, leaderboard, time.Unix(expireAt, 0))
-			if err != nil {
-				return err
-			}
-
-		} else {
-			return err
+		if _, ok := err.(*database.LeaderboardDefinitionNotFoundError); !ok {
+			return nil, err
 		}
+		definition = nil
+	}
+
+	if s.definitions != nil {
+		s.definitions.set(id, definition)
+	}
+	return definition, nil
+}
+
+// getOrder return order if set, otherwise the leaderboard default order
+func (s *Service) getOrder(ctx context.Context, leaderboard, order string) (string, error) {
+	if order != "" {
+		return order, nil
+	}
+
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return "", err
+	}
+
+	return definitionOrder(definition, order), nil
+}
+
+// definitionOrder return order if set, otherwise definition order and desc for unregistered leaderboards
+func definitionOrder(definition *database.LeaderboardDefinition, order string) string {
+	if order != "" {
+		return order
+	}
+
+	if definition != nil && definition.Order != "" {
+		return definition.Order
+	}
+
+	return defaultOrder
+}
+
+// definitionPageSize return pageSize if set, otherwise definition default page size, pageSize can't be above
+// definition max page size
+func definitionPageSize(definition *database.LeaderboardDefinition, pageSize int) (int, error) {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+		if definition != nil && definition.DefaultPageSize > 0 {
+			pageSize = definition.DefaultPageSize
+		}
+	}
+
+	if definition != nil && definition.MaxPageSize > 0 && pageSize > definition.MaxPageSize {
+		return -1, NewPageSizeOutOfRangeError(pageSize, definition.MaxPageSize)
+	}
+
+	return pageSize, nil
+}
+
+// definitionUpdatePolicy return updatePolicy if set, otherwise definition update policy and then leaderboard
+// configured one, empty means database.UpdatePolicyLast
+func (s *Service) definitionUpdatePolicy(definition *database.LeaderboardDefinition, leaderboard, updatePolicy string) string {
+	if updatePolicy != "" {
+		return updatePolicy
+	}
+
+	if definition != nil && definition.UpdatePolicy != "" {
+		return definition.UpdatePolicy
+	}
+
+	if s.updatePolicies != nil {
+		return s.updatePolicies(s.leagueOf(leaderboard))
+	}
+
+	return ""
+}
+
+// definitionExpireAt return when leaderboard expires, definition end time plus retention if it's set,
+// otherwise the expiration inferred from leaderboard name
+func (s *Service) definitionExpireAt(definition *database.LeaderboardDefinition, leaderboard string) (time.Time, error) {
+	if definition == nil || definition.EndTime.IsZero() {
+		return s.getLeaderboardExpireAt(leaderboard)
+	}
+
+	expireAt := definition.EndTime.Add(definition.Retention)
+	if !expireAt.After(time.Now()) {
+		return time.Time{}, &expiration.LeaderboardExpiredError{LeaderboardPublicID: leaderboard}
+	}
+
+	return expireAt, nil
+}
+
+// seasonWindow return when the leaderboard season starts and ends, definition start and end time if any of them
+// is set, otherwise the season inferred from leaderboard name, zero values mean the season is unbounded
+func (s *Service) seasonWindow(definition *database.LeaderboardDefinition, leaderboard string) (time.Time, time.Time, error) {
+	if definition != nil && (!definition.StartTime.IsZero() || !definition.EndTime.IsZero()) {
+		return definition.StartTime, definition.EndTime, nil
+	}
+
+	season, err := s.getSeasonPolicy(leaderboard).GetSeason(s.leagueOf(leaderboard))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if season == nil {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	return season.Start, season.End, nil
+}
+
+// checkSeasonWindow return an error if now is out of the leaderboard season, see seasonWindow
+func (s *Service) checkSeasonWindow(start, end time.Time, leaderboard string, now time.Time) error {
+	if !start.IsZero() && now.Before(start) {
+		return NewSeasonNotStartedError(leaderboard, start)
+	}
+	if !end.IsZero() && !now.Before(end) {
+		return NewSeasonClosedError(leaderboard, end)
 	}
 
 	return nil
 }
+
+// openSeasonWrite return leaderboard definition, when leaderboard expires and, if it's archived, when its season
+// ends, it fails if leaderboard season isn't open to writes
+func (s *Service) openSeasonWrite(ctx context.Context, leaderboard string) (*database.LeaderboardDefinition, time.Time, time.Time, error) {
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	expireAt, err := s.definitionExpireAt(definition, leaderboard)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	seasonStart, seasonEnd, err := s.seasonWindow(definition, leaderboard)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	if err := s.checkSeasonWindow(seasonStart, seasonEnd, leaderboard, time.Now()); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	if !s.isArchived(leaderboard) {
+		seasonEnd = time.Time{}
+	}
+	return definition, expireAt, seasonEnd, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const archiveNotConfiguredMessage = "leaderboard archive is not configured"
+
+// isArchived report if leaderboard final standings are archived when its season ends
+func (s *Service) isArchived(leaderboard string) bool {
+	return s.archive != nil && s.archived != nil && s.archived(s.leagueOf(leaderboard))
+}
+
+// getLeaderboardArchive return leaderboard archive, serviceLabel names the GeneralError of unexpected failures
+func (s *Service) getLeaderboardArchive(ctx context.Context, serviceLabel, leaderboard string) (*database.LeaderboardArchive, error) {
+	if s.archive == nil {
+		return nil, NewGeneralError(serviceLabel, archiveNotConfiguredMessage)
+	}
+
+	archive, err := s.archive.GetLeaderboardArchive(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardArchiveNotFoundError); ok {
+			return nil, NewLeaderboardArchiveNotFoundError(leaderboard)
+		}
+		return nil, NewGeneralError(serviceLabel, err.Error())
+	}
+
+	return archive, nil
+}
+
+func convertDatabaseLeaderboardArchiveIntoModelArchive(archive *database.LeaderboardArchive) *model.LeaderboardArchive {
+	modelArchive := &model.LeaderboardArchive{
+		ID:           archive.ID,
+		SeasonEnd:    timeToUnix(archive.SeasonEnd),
+		ArchivedAt:   timeToUnix(archive.ArchivedAt),
+		TotalMembers: archive.TotalMembers,
+		RewardOrder:  archive.RewardOrder,
+	}
+	for _, bracket := range archive.RewardBrackets {
+		modelArchive.RewardBrackets = append(modelArchive.RewardBrackets, &model.RewardBracket{
+			Name:    bracket.Name,
+			MinRank: bracket.MinRank,
+			MaxRank: bracket.MaxRank,
+		})
+	}
+	return modelArchive
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const registryNotConfiguredMessage = "leaderboard registry is not configured"
+
+func validateLeaderboardDefinition(definition *model.LeaderboardDefinition) error {
+	switch {
+	case definition.ID == "":
+		return NewInvalidLeaderboardDefinitionError("id is required")
+	case definition.Order != "" && definition.Order != "asc" && definition.Order != "desc":
+		return NewInvalidLeaderboardDefinitionError(fmt.Sprintf("invalid order: %s", definition.Order))
+	case !database.IsValidUpdatePolicy(definition.UpdatePolicy):
+		return NewInvalidLeaderboardDefinitionError(fmt.Sprintf("invalid update policy: %s", definition.UpdatePolicy))
+	case definition.DefaultPageSize < 0 || definition.MaxPageSize < 0:
+		return NewInvalidLeaderboardDefinitionError("page sizes can't be negative")
+	case definition.MaxPageSize > 0 && definition.DefaultPageSize > definition.MaxPageSize:
+		return NewInvalidLeaderboardDefinitionError(fmt.Sprintf(
+			"default page size %d is above max page size %d", definition.DefaultPageSize, definition.MaxPageSize,
+		))
+	case definition.StartTime < 0 || definition.EndTime < 0 || definition.Retention < 0:
+		return NewInvalidLeaderboardDefinitionError("times can't be negative")
+	case definition.StartTime > 0 && definition.EndTime > 0 && definition.EndTime <= definition.StartTime:
+		return NewInvalidLeaderboardDefinitionError("end time must be after start time")
+	}
+
+	return nil
+}
+
+func convertModelLeaderboardDefinitionIntoDatabaseDefinition(definition *model.LeaderboardDefinition) *database.LeaderboardDefinition {
+	return &database.LeaderboardDefinition{
+		ID:              definition.ID,
+		DisplayName:     definition.DisplayName,
+		Order:           definition.Order,
+		UpdatePolicy:    definition.UpdatePolicy,
+		DefaultPageSize: definition.DefaultPageSize,
+		MaxPageSize:     definition.MaxPageSize,
+		StartTime:       unixToTime(definition.StartTime),
+		EndTime:         unixToTime(definition.EndTime),
+		Retention:       time.Duration(definition.Retention) * time.Second,
+		CreatedAt:       unixToTime(definition.CreatedAt),
+		UpdatedAt:       unixToTime(definition.UpdatedAt),
+	}
+}
+
+func convertDatabaseLeaderboardDefinitionIntoModelDefinition(definition *database.LeaderboardDefinition) *model.LeaderboardDefinition {
+	return &model.LeaderboardDefinition{
+		ID:              definition.ID,
+		DisplayName:     definition.DisplayName,
+		Order:           definition.Order,
+		UpdatePolicy:    definition.UpdatePolicy,
+		DefaultPageSize: definition.DefaultPageSize,
+		MaxPageSize:     definition.MaxPageSize,
+		StartTime:       timeToUnix(definition.StartTime),
+		EndTime:         timeToUnix(definition.EndTime),
+		Retention:       int(definition.Retention / time.Second),
+		CreatedAt:       timeToUnix(definition.CreatedAt),
+		UpdatedAt:       timeToUnix(definition.UpdatedAt),
+	}
+}
+
+// unixToTime convert unix seconds to time, zero is kept as zero time
+func unixToTime(seconds int) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0).UTC()
+}
+
+// timeToUnix convert time to unix seconds, zero time is kept as zero
+func timeToUnix(t time.Time) int {
+	if t.IsZero() {
+		return 0
+	}
+	return int(t.Unix())
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// LeagueBucketSeparator splits a league ID from its bucket number, like league-year2026week42~3
+const LeagueBucketSeparator = "~"
+
+// League is a leaderboard whose members are split in buckets of fixed size, members are assigned to the open
+// bucket on their first write and ranked only against the members of their bucket
+type League struct {
+	// BucketSize is how many members a bucket holds before the next one is opened
+	BucketSize int
+}
+
+// LeagueBucket return the ID of a league bucket leaderboard
+func LeagueBucket(league string, bucket int) string {
+	return league + LeagueBucketSeparator + strconv.Itoa(bucket)
+}
+
+// leagueOf return the league of a bucket leaderboard, otherwise leaderboard itself, buckets share the
+// configuration of their league
+func (s *Service) leagueOf(leaderboard string) string {
+	if s.leagues == nil {
+		return leaderboard
+	}
+
+	index := strings.LastIndex(leaderboard, LeagueBucketSeparator)
+	if index < 0 {
+		return leaderboard
+	}
+
+	bucket, err := strconv.Atoi(leaderboard[index+len(LeagueBucketSeparator):])
+	if err != nil || bucket < 0 {
+		return leaderboard
+	}
+
+	league := leaderboard[:index]
+	if config := s.leagueConfigs(league); config == nil || config.BucketSize <= 0 {
+		return leaderboard
+	}
+	return league
+}
+
+// getLeague return the league leaderboard is, nil if it isn't a league or it's a league bucket
+func (s *Service) getLeague(leaderboard string) *League {
+	if s.leagues == nil {
+		return nil
+	}
+
+	if s.leagueOf(leaderboard) != leaderboard {
+		return nil
+	}
+
+	league := s.leagueConfigs(leaderboard)
+	if league == nil || league.BucketSize <= 0 {
+		return nil
+	}
+	return league
+}
+
+// memberLeaderboard return the bucket member is in if leaderboard is a league, otherwise leaderboard itself,
+// serviceLabel names the GeneralError of unexpected failures
+func (s *Service) memberLeaderboard(ctx context.Context, serviceLabel, leaderboard, member string) (string, error) {
+	if s.getLeague(leaderboard) == nil {
+		return leaderboard, nil
+	}
+
+	buckets, err := s.leagues.GetLeagueBuckets(ctx, leaderboard, member)
+	if err != nil {
+		return "", NewGeneralError(serviceLabel, err.Error())
+	}
+
+	bucket, ok := buckets[member]
+	if !ok {
+		return "", NewMemberNotFoundError(leaderboard, member)
+	}
+	return LeagueBucket(leaderboard, bucket), nil
+}
+
+// membersLeaderboards group members by the bucket they are in if leaderboard is a league, keeping members order,
+// otherwise all members are in leaderboard itself, members that aren't in any bucket are left out
+func (s *Service) membersLeaderboards(ctx context.Context, leaderboard string, members []string) ([]string, map[string][]string, error) {
+	if s.getLeague(leaderboard) == nil {
+		return []string{leaderboard}, map[string][]string{leaderboard: members}, nil
+	}
+
+	buckets, err := s.leagues.GetLeagueBuckets(ctx, leaderboard, members...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaderboards := []string{}
+	leaderboardMembers := map[string][]string{}
+	for _, member := range members {
+		bucket, ok := buckets[member]
+		if !ok {
+			continue
+		}
+
+		bucketLeaderboard := LeagueBucket(leaderboard, bucket)
+		if _, ok := leaderboardMembers[bucketLeaderboard]; !ok {
+			leaderboards = append(leaderboards, bucketLeaderboard)
+		}
+		leaderboardMembers[bucketLeaderboard] = append(leaderboardMembers[bucketLeaderboard], member)
+	}
+
+	return leaderboards, leaderboardMembers, nil
+}
+
+// upsertLeagueMembers assign members that aren't in a bucket to the open one and write each member score
+// in its bucket, the league season is checked first so closed seasons don't get new members
+func (s *Service) upsertLeagueMembers(ctx context.Context, league string, bucketSize int, members []*model.Member, updatePolicy string, prevRank bool, scoreTTL string) error {
+	definition, err := s.getLeaderboardDefinition(ctx, league)
+	if err != nil {
+		return err
+	}
+
+	expireAt, err := s.definitionExpireAt(definition, league)
+	if err != nil {
+		return err
+	}
+
+	seasonStart, seasonEnd, err := s.seasonWindow(definition, league)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkSeasonWindow(seasonStart, seasonEnd, league, time.Now()); err != nil {
+		return err
+	}
+
+	publicIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		publicIDs = append(publicIDs, member.PublicID)
+	}
+
+	buckets, err := s.leagues.AssignLeagueBuckets(ctx, league, publicIDs, bucketSize, expireAt)
+	if err != nil {
+		return err
+	}
+
+	leaderboards := []string{}
+	leaderboardMembers := map[string][]*model.Member{}
+	for _, member := range members {
+		leaderboard := LeagueBucket(league, buckets[member.PublicID])
+		if _, ok := leaderboardMembers[leaderboard]; !ok {
+			leaderboards = append(leaderboards, leaderboard)
+		}
+		leaderboardMembers[leaderboard] = append(leaderboardMembers[leaderboard], member)
+	}
+
+	for _, leaderboard := range leaderboards {
+		err = s.upsertMembers(ctx, leaderboard, leaderboardMembers[leaderboard], updatePolicy, prevRank, scoreTTL)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeLeague remove every league bucket and then forget which bucket each member is in
+func (s *Service) removeLeague(ctx context.Context, league string) error {
+	count, err := s.leagues.GetLeagueBucketsCount(ctx, league)
+	if err != nil {
+		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
+	}
+
+	for bucket := 0; bucket < count; bucket++ {
+		err = s.Database.RemoveLeaderboard(ctx, LeagueBucket(league, bucket))
+		if err != nil {
+			return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
+		}
+	}
+
+	err = s.leagues.RemoveLeague(ctx, league)
+	if err != nil {
+		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service leagues", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var leaguesMock *database.MockLeagues
+	var svc *service.Service
+
+	var league string = "bronze-league"
+	var member string = "member1"
+	var bucketSize int = 50
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		leaguesMock = database.NewMockLeagues(ctrl)
+
+		svc = service.NewService(mock,
+			service.WithLeagues(leaguesMock, func(leaderboard string) *service.League {
+				if leaderboard == league {
+					return &service.League{BucketSize: bucketSize}
+				}
+				return nil
+			}),
+			service.WithUpdatePolicies(func(leaderboard string) string {
+				if leaderboard == league {
+					return database.UpdatePolicyBest
+				}
+				return ""
+			}),
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should name league buckets", func() {
+		Expect(service.LeagueBucket(league, 3)).To(Equal("bronze-league~3"))
+	})
+
+	It("Should write member score in the bucket it's assigned to", func() {
+		bucket := service.LeagueBucket(league, 2)
+		leaguesMock.EXPECT().AssignLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Eq([]string{member}), gomock.Eq(bucketSize), gomock.Any()).Return(map[string]int{member: 2}, nil)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(bucket), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, members []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.UpdatePolicy).To(Equal(database.UpdatePolicyBest))
+				return []*database.Member{{Member: member, Score: 10, Rank: 0}}, nil
+			})
+
+		modelMember, err := svc.SetMemberScore(context.Background(), league, member, 10, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modelMember).To(Equal(&model.Member{PublicID: member, Score: 10, Rank: 1}))
+	})
+
+	It("Should write members score grouped by bucket", func() {
+		members := []*model.Member{
+			{PublicID: "member1", Score: 1},
+			{PublicID: "member2", Score: 2},
+			{PublicID: "member3", Score: 3},
+		}
+
+		leaguesMock.EXPECT().AssignLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Eq([]string{"member1", "member2", "member3"}), gomock.Eq(bucketSize), gomock.Any()).Return(map[string]int{"member1": 0, "member2": 1, "member3": 0}, nil)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(service.LeagueBucket(league, 0)), gomock.Eq([]*database.Member{
+			{Member: "member1", Score: 1},
+			{Member: "member3", Score: 3},
+		}), gomock.Any()).Return([]*database.Member{
+			{Member: "member1", Score: 1, Rank: 1},
+			{Member: "member3", Score: 3, Rank: 0},
+		}, nil)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(service.LeagueBucket(league, 1)), gomock.Eq([]*database.Member{
+			{Member: "member2", Score: 2},
+		}), gomock.Any()).Return([]*database.Member{
+			{Member: "member2", Score: 2, Rank: 0},
+		}, nil)
+
+		err := svc.SetMembersScore(context.Background(), league, members, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0].Rank).To(Equal(2))
+		Expect(members[1].Rank).To(Equal(1))
+		Expect(members[2].Rank).To(Equal(1))
+	})
+
+	It("Should read members around member in its bucket", func() {
+		bucket := service.LeagueBucket(league, 1)
+		leaguesMock.EXPECT().GetLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Eq(member)).Return(map[string]int{member: 1}, nil)
+		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(bucket), gomock.Eq(member), gomock.Eq("desc")).Return(0, nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(bucket)).Return(1, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(bucket), gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).Return([]*database.Member{{Member: member, Score: 10, Rank: 0}}, nil)
+
+		members, err := svc.GetAroundMe(context.Background(), league, 1, member, "", false, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.Member{{PublicID: member, Score: 10, Rank: 1}}))
+	})
+
+	It("Should return error MemberNotFoundError if member isn't in any bucket", func() {
+		leaguesMock.EXPECT().GetLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Eq(member)).Return(map[string]int{}, nil)
+
+		_, err := svc.GetMember(context.Background(), league, member, "", false, "")
+		Expect(err).To(MatchError(service.NewMemberNotFoundError(league, member)))
+	})
+
+	It("Should return the leaderboard member is ranked in", func() {
+		leaguesMock.EXPECT().GetLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Eq(member)).Return(map[string]int{member: 4}, nil)
+
+		leaderboard, err := svc.GetMemberLeaderboard(context.Background(), league, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboard).To(Equal(service.LeagueBucket(league, 4)))
+
+		leaderboard, err = svc.GetMemberLeaderboard(context.Background(), "not-a-league", member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboard).To(Equal("not-a-league"))
+	})
+
+	It("Should use bucket leaderboards as they are", func() {
+		bucket := service.LeagueBucket(league, 0)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(bucket), gomock.Any(), gomock.Any()).Return([]*database.Member{{Member: member, Score: 10, Rank: 0}}, nil)
+
+		_, err := svc.SetMemberScore(context.Background(), bucket, member, 10, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should remove every bucket of a league", func() {
+		leaguesMock.EXPECT().GetLeagueBucketsCount(gomock.Any(), gomock.Eq(league)).Return(2, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(service.LeagueBucket(league, 0))).Return(nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(service.LeagueBucket(league, 1))).Return(nil)
+		leaguesMock.EXPECT().RemoveLeague(gomock.Any(), gomock.Eq(league)).Return(nil)
+
+		err := svc.RemoveLeaderboard(context.Background(), league)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return error if league buckets can't be assigned", func() {
+		leaguesMock.EXPECT().AssignLeagueBuckets(gomock.Any(), gomock.Eq(league), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.SetMemberScore(context.Background(), league, member, 10, false, "", "")
+		Expect(err).To(Equal(service.NewGeneralError("set member score", database.NewGeneralError("unknown error").Error())))
+	})
+})
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const listLeaderboardDefinitionsServiceLabel = "list leaderboard definitions"
+
+// ListLeaderboardDefinitions return every registered leaderboard definition ordered by leaderboard id
+func (s *Service) ListLeaderboardDefinitions(ctx context.Context) ([]*model.LeaderboardDefinition, error) {
+	if s.registry == nil {
+		return nil, NewGeneralError(listLeaderboardDefinitionsServiceLabel, registryNotConfiguredMessage)
+	}
+
+	databaseDefinitions, err := s.registry.ListLeaderboardDefinitions(ctx)
+	if err != nil {
+		return nil, NewGeneralError(listLeaderboardDefinitionsServiceLabel, err.Error())
+	}
+
+	definitions := make([]*model.LeaderboardDefinition, 0, len(databaseDefinitions))
+	for _, definition := range databaseDefinitions {
+		definitions = append(definitions, convertDatabaseLeaderboardDefinitionIntoModelDefinition(definition))
+	}
+
+	return definitions, nil
+}
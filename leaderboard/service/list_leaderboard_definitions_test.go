@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ListLeaderboardDefinitions", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+	var svc *service.Service
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+
+		svc = service.NewService(mock, service.WithRegistry(registry))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return every definition", func() {
+		registry.EXPECT().ListLeaderboardDefinitions(gomock.Any()).Return([]*database.LeaderboardDefinition{
+			{ID: "daily"},
+			{ID: "weekly", Order: "asc"},
+		}, nil)
+
+		definitions, err := svc.ListLeaderboardDefinitions(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(definitions).To(Equal([]*model.LeaderboardDefinition{
+			{ID: "daily"},
+			{ID: "weekly", Order: "asc"},
+		}))
+	})
+
+	It("Should return error if registry return in error", func() {
+		registry.EXPECT().ListLeaderboardDefinitions(gomock.Any()).Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.ListLeaderboardDefinitions(context.Background())
+		Expect(err).To(Equal(service.NewGeneralError("list leaderboard definitions", database.NewGeneralError("unknown error").Error())))
+	})
+})
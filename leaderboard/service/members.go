@@ -6,25 +6,28 @@ import (
 	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
 
-func convertDatabaseMembersIntoModelMembers(databaseMembers []*database.Member) []*model.Member {
+func convertDatabaseMembersIntoModelMembers(databaseMembers []*database.Member, encoding scoreEncoding) []*model.Member {
 	members := make([]*model.Member, 0, len(databaseMembers))
 	for _, member := range databaseMembers {
-		modelMember := convertDatabaseMemberIntoModelMember(member)
+		modelMember := convertDatabaseMemberIntoModelMember(member, encoding)
 		members = append(members, modelMember)
 	}
 
 	return members
 }
 
-func convertDatabaseMemberIntoModelMember(member *database.Member) *model.Member {
-	return &model.Member{
+func convertDatabaseMemberIntoModelMember(member *database.Member, encoding scoreEncoding) *model.Member {
+	modelMember := &model.Member{
 		PublicID: member.Member,
-		Score:    int64(member.Score),
 		Rank:     int(member.Rank + 1),
 	}
+	encoding.decode(modelMember, member.Score)
+
+	return modelMember
 }
 
 func (s *Service) fetchMemberRank(ctx context.Context, leaderboard, member, order string, getLastIfNotFound bool) (int, error) {
@@ -47,91 +50,172 @@ func (s *Service) fetchMemberRank(ctx context.Context, leaderboard, member, orde
 	return memberRank + 1, nil
 }
 
-func (s *Service) setMembersPreviousRank(ctx context.Context, leaderboard string, members []*model.Member, order string) error {
-	databaseMembers, err := s.getDatabaseMembers(ctx, leaderboard, members, order)
-	if err != nil {
-		return err
+// upsertMembers write members score ranking them in leaderboard default order, an empty updatePolicy means
+// leaderboard default update policy
+func (s *Service) upsertMembers(ctx context.Context, leaderboard string, members []*model.Member, updatePolicy string, prevRank bool, scoreTTL string) error {
+	if s.getLadder(leaderboard) != nil {
+		return NewInvalidScoresError("scores of ladder leaderboards are positions only written by challenge results")
+	}
+	if s.getRatingSettings(leaderboard) != nil {
+		return NewInvalidScoresError("scores of rating leaderboards are ratings only written by match results")
 	}
 
-	for i, member := range members {
-		if databaseMembers[i] != nil {
-			member.PreviousRank = int(databaseMembers[i].Rank + 1)
-			continue
-		}
-
-		member.PreviousRank = -1
+	if league := s.getLeague(leaderboard); league != nil {
+		return s.upsertLeagueMembers(ctx, leaderboard, league.BucketSize, members, updatePolicy, prevRank, scoreTTL)
 	}
 
-	return nil
-}
+	ttl, err := parseScoreTTL(scoreTTL)
+	if err != nil {
+		return err
+	}
 
-func (s *Service) persistMembers(ctx context.Context, leaderboard string, members []*model.Member) error {
-	databaseMembers := make([]*database.Member, 0, len(members))
-	for _, member := range members {
-		databaseMembers = append(databaseMembers, &database.Member{
-			Member: member.PublicID,
-			Score:  float64(member.Score),
-		})
+	// a retry is answered with the kept result before anything that could have changed since the first write
+	// is checked again, such as the season window, score rules or submissions count
+	if replayed, err := s.replayIdempotentWrite(ctx, leaderboard, members, prevRank); err != nil || replayed {
+		return err
 	}
 
-	err := s.Database.SetMembers(ctx, leaderboard, databaseMembers)
+	definition, err := s.getLeaderboardDefinition(ctx, leaderboard)
 	if err != nil {
 		return err
 	}
+	updatePolicy = s.definitionUpdatePolicy(definition, leaderboard, updatePolicy)
 
-	return nil
-}
+	expireAt, err := s.definitionExpireAt(definition, leaderboard)
+	if err != nil {
+		return err
+	}
 
-func (s *Service) setMembersValues(ctx context.Context, leaderboard string, members []*model.Member, order string) error {
-	databaseMembers, err := s.getDatabaseMembers(ctx, leaderboard, members, order)
+	seasonStart, seasonEnd, err := s.seasonWindow(definition, leaderboard)
 	if err != nil {
 		return err
 	}
 
-	for i, member := range databaseMembers {
-		members[i].Rank = int(member.Rank + 1)
-		members[i].Score = int64(member.Score)
+	submittedAt := time.Now()
+	if err := s.checkSeasonWindow(seasonStart, seasonEnd, leaderboard, submittedAt); err != nil {
+		return err
+	}
+
+	options := &database.UpsertOptions{
+		Order:             definitionOrder(definition, ""),
+		UpdatePolicy:      updatePolicy,
+		PreviousRank:      prevRank,
+		ExpireAt:          expireAt,
+		TTL:               ttl,
+		IdempotencyKey:    s.idempotencyKey(ctx),
+		IdempotencyWindow: s.idempotencyWindow,
 	}
 
-	return nil
-}
+	if s.isArchived(leaderboard) {
+		options.SeasonEnd = seasonEnd
+	}
 
-func (s *Service) persistMembersTTL(ctx context.Context, leaderboard string, members []*model.Member, scoreTTL string) error {
-	ttl, err := strconv.ParseInt(scoreTTL, 10, 64)
-	if err != nil {
+	encoding := s.scoreEncoding(leaderboard)
+	if err := encoding.validateUpdatePolicy(updatePolicy); err != nil {
 		return err
 	}
-
-	timeToExpire := time.Now().UTC().Add(time.Duration(ttl) * time.Second)
+	options.ScoreScale = encoding.scoreScale()
+	if updatePolicy == database.UpdatePolicySum {
+		options.SumRange = encoding.sumRange()
+	}
 
 	databaseMembers := make([]*database.Member, 0, len(members))
 	for _, member := range members {
+		score, err := encoding.encode(member, submittedAt)
+		if err != nil {
+			return err
+		}
+
 		databaseMembers = append(databaseMembers, &database.Member{
 			Member: member.PublicID,
-			TTL:    timeToExpire,
+			Score:  score,
 		})
-		member.ExpireAt = int(timeToExpire.Unix())
+
+		if member.Precondition != nil {
+			precondition, err := encoding.encodePrecondition(member.PublicID, member.Precondition)
+			if err != nil {
+				return err
+			}
+			if options.Preconditions == nil {
+				options.Preconditions = map[string]*database.Precondition{}
+			}
+			options.Preconditions[member.PublicID] = precondition
+		}
 	}
 
-	err = s.Database.SetMembersTTL(ctx, leaderboard, databaseMembers)
+	if rules := s.getScoreRules(leaderboard); rules != nil {
+		options.ScoreRules = rules.databaseRules(encoding, submittedAt)
+	}
+
+	upsertedMembers, err := s.Database.UpsertMembers(ctx, leaderboard, databaseMembers, options)
 	if err != nil {
+		if failed, ok := err.(*database.PreconditionFailedError); ok {
+			return encoding.preconditionFailed(failed)
+		}
+		if outOfRange, ok := err.(*database.SumOutOfRangeError); ok {
+			return encoding.sumOutOfRange(outOfRange)
+		}
+		if violation, ok := err.(*database.ScoreRuleViolationError); ok {
+			return s.scoreRuleViolation(violation)
+		}
 		return err
 	}
 
+	for i, member := range upsertedMembers {
+		if member.QuarantineRule != "" {
+			s.countScoreRuleViolation(member.QuarantineRule, "quarantined")
+		}
+		fillUpsertedMember(members[i], member, encoding, prevRank)
+	}
+
 	return nil
+}
 
+// writeError keep errors of score writes that clients can fix as they are and wrap the others in a GeneralError
+func writeError(serviceLabel, leaderboard string, err error) error {
+	switch err.(type) {
+	case *expiration.LeaderboardExpiredError:
+		return NewLeaderboardExpiredError(leaderboard)
+	case *SeasonNotStartedError, *SeasonClosedError, *ScoreOutOfRangeError, *InvalidScoresError, *ScoreRuleViolationError,
+		*InvalidPreconditionError, *PreconditionFailedError:
+		return err
+	}
+	return NewGeneralError(serviceLabel, err.Error())
 }
 
-func (s *Service) getDatabaseMembers(ctx context.Context, leaderboard string, members []*model.Member, order string) ([]*database.Member, error) {
-	memberIDs := make([]string, 0, len(members))
-	for _, member := range members {
-		memberIDs = append(memberIDs, member.PublicID)
+// fillUpsertedMember set member score, ranks and expiration from the member written to the database, members
+// held for review keep their submitted score and are only marked as quarantined
+func fillUpsertedMember(member *model.Member, upserted *database.Member, encoding scoreEncoding, prevRank bool) {
+	if upserted.QuarantineRule != "" {
+		member.Quarantined = true
+		return
 	}
 
-	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, true, memberIDs...)
-	if err != nil {
-		return nil, err
+	encoding.decode(member, upserted.Score)
+	member.Rank = int(upserted.Rank + 1)
+	member.ScoreChanged = upserted.ScoreChanged
+
+	if prevRank {
+		member.PreviousRank = -1
+		if upserted.PreviousRank >= 0 {
+			member.PreviousRank = int(upserted.PreviousRank + 1)
+		}
 	}
 
-	return databaseMembers, nil
+	if !upserted.TTL.IsZero() {
+		member.ExpireAt = int(upserted.TTL.Unix())
+	}
+}
+
+// parseScoreTTL return when a score written with scoreTTL seconds to live expires, zero if it doesn't
+func parseScoreTTL(scoreTTL string) (time.Time, error) {
+	if scoreTTL == "" {
+		return time.Time{}, nil
+	}
+
+	ttl, err := strconv.ParseInt(scoreTTL, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().UTC().Add(time.Duration(ttl) * time.Second), nil
 }
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// Rank modes define which rank members with equal scores get
+const (
+	// RankModeOrdinal ranks members by their position, ties are ordered by member id, like 1234
+	RankModeOrdinal = "ordinal"
+	// RankModeCompetition gives tied members the same rank and leaves a gap after them, like 1224
+	RankModeCompetition = "competition"
+	// RankModeDense gives tied members the same rank without gaps, like 1223
+	RankModeDense = "dense"
+)
+
+// IsValidRankMode reports if mode is one of the rank modes, empty means RankModeOrdinal
+func IsValidRankMode(mode string) bool {
+	switch mode {
+	case "", RankModeOrdinal, RankModeCompetition, RankModeDense:
+		return true
+	}
+	return false
+}
+
+func isOrdinalRankMode(mode string) bool {
+	return mode == "" || mode == RankModeOrdinal
+}
+
+// validateRankMode check leaderboard members can be ranked in rankMode, members of leaderboards with tie-break
+// never have equal stored scores, so ranks that are shared by tied members can't be computed for them
+func (s *Service) validateRankMode(leaderboard, rankMode string) error {
+	if !IsValidRankMode(rankMode) {
+		return NewInvalidRankModeError(rankMode)
+	}
+	if !isOrdinalRankMode(rankMode) && s.scoreEncoding(leaderboard).tieBreak != nil {
+		return NewTieBreakRankModeError(leaderboard, rankMode)
+	}
+	return nil
+}
+
+// countAhead return how many members, or distinct scores in dense mode, are better than each score,
+// which is the 0-based rank of a member with that score
+func (s *Service) countAhead(ctx context.Context, leaderboard, order, rankMode string, scores ...float64) ([]int, error) {
+	if rankMode == RankModeDense {
+		return s.Database.CountScoresAhead(ctx, leaderboard, order, scores...)
+	}
+	return s.Database.CountMembersAhead(ctx, leaderboard, order, scores...)
+}
+
+// rankMembers replace ordinal ranks of database members by their rank in rankMode, nil members are skipped
+func (s *Service) rankMembers(ctx context.Context, leaderboard, order, rankMode string, databaseMembers []*database.Member) error {
+	if isOrdinalRankMode(rankMode) {
+		return nil
+	}
+
+	scores := make([]float64, 0, len(databaseMembers))
+	for _, member := range databaseMembers {
+		if member != nil {
+			scores = append(scores, member.Score)
+		}
+	}
+	if len(scores) == 0 {
+		return nil
+	}
+
+	counts, err := s.countAhead(ctx, leaderboard, order, rankMode, scores...)
+	if err != nil {
+		return err
+	}
+
+	i := 0
+	for _, member := range databaseMembers {
+		if member != nil {
+			member.Rank = int64(counts[i])
+			i++
+		}
+	}
+
+	return nil
+}
+
+// rankOrderedMembers is rankMembers for a contiguous slice of ordered members, only the first member
+// rank is looked up and the others follow from score changes along the slice
+func (s *Service) rankOrderedMembers(ctx context.Context, leaderboard, order, rankMode string, databaseMembers []*database.Member) error {
+	if isOrdinalRankMode(rankMode) || len(databaseMembers) == 0 {
+		return nil
+	}
+
+	counts, err := s.countAhead(ctx, leaderboard, order, rankMode, databaseMembers[0].Score)
+	if err != nil {
+		return err
+	}
+
+	rank := int64(counts[0])
+	for i, member := range databaseMembers {
+		if i > 0 && member.Score != databaseMembers[i-1].Score {
+			if rankMode == RankModeDense {
+				rank++
+			} else {
+				rank = member.Rank
+			}
+		}
+		member.Rank = rank
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+)
+
+// Outcomes of a match result, they are placements of a match where every winner finishes ahead of every loser
+const (
+	// MatchOutcomeWin members finish ahead of the losers
+	MatchOutcomeWin = "win"
+	// MatchOutcomeLoss members finish behind the winners
+	MatchOutcomeLoss = "loss"
+	// MatchOutcomeDraw members finish level with each other
+	MatchOutcomeDraw = "draw"
+)
+
+// submitMatchResultMaxAttempts is how many times ratings are read and written again when other matches
+// of the same members are rated concurrently
+const submitMatchResultMaxAttempts = 5
+
+// getRatingSettings return the rating settings of leaderboard, nil if it isn't a rating leaderboard
+func (s *Service) getRatingSettings(leaderboard string) *rating.Settings {
+	if s.ratings == nil || s.ratingSettings == nil {
+		return nil
+	}
+	return s.ratingSettings(s.leagueOf(leaderboard))
+}
+
+// matchPlacements validate match results and return the placement of each member, outcomes are
+// converted to placements
+func matchPlacements(results []*model.MatchResult) ([]int, error) {
+	if len(results) < 2 {
+		return nil, NewInvalidMatchResultError("a match needs at least two members")
+	}
+
+	members := make(map[string]bool, len(results))
+	placements := make([]int, 0, len(results))
+	for _, result := range results {
+		if result.PublicID == "" {
+			return nil, NewInvalidMatchResultError("member public ID can't be empty")
+		}
+		if members[result.PublicID] {
+			return nil, NewInvalidMatchResultError("member " + result.PublicID + " is repeated")
+		}
+		members[result.PublicID] = true
+
+		if result.Outcome != "" && result.Placement != 0 {
+			return nil, NewInvalidMatchResultError("member " + result.PublicID + " must have either a placement or an outcome")
+		}
+
+		switch result.Outcome {
+		case "":
+			if result.Placement < 1 {
+				return nil, NewInvalidMatchResultError("member " + result.PublicID + " placement must start at 1")
+			}
+			placements = append(placements, result.Placement)
+		case MatchOutcomeWin, MatchOutcomeDraw:
+			placements = append(placements, 1)
+		case MatchOutcomeLoss:
+			placements = append(placements, 2)
+		default:
+			return nil, NewInvalidMatchResultError("unknown outcome " + result.Outcome)
+		}
+	}
+
+	return placements, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const releaseSubmissionServiceLabel = "release submission"
+
+// ReleaseSubmission forget the nonce VerifySubmission claimed for submission, so it can be sent again after its
+// write failed, nonces of retries that were accepted by a kept result aren't released
+func (s *Service) ReleaseSubmission(ctx context.Context, tenant string, submission *model.SignedSubmission) error {
+	if !submission.NonceClaimed {
+		return nil
+	}
+
+	err := s.nonces.ReleaseNonce(ctx, tenant, submission.Nonce)
+	if err != nil {
+		return NewGeneralError(releaseSubmissionServiceLabel, err.Error())
+	}
+	submission.NonceClaimed = false
+
+	return nil
+}
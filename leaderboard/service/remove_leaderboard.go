@@ -6,7 +6,16 @@ const removeLeaderboardServiceLabel = "remove leaderboard"
 
 // RemoveLeaderboard reurn how many members have in a leaderboard
 func (s *Service) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
-	err := s.Database.RemoveLeaderboard(ctx, leaderboard)
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return err
+	}
+
+	if s.getLeague(leaderboard) != nil {
+		return s.removeLeague(ctx, leaderboard)
+	}
+
+	err = s.Database.RemoveLeaderboard(ctx, leaderboard)
 	if err != nil {
 		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
 	}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+const removeLeaderboardDefinitionServiceLabel = "remove leaderboard definition"
+
+// RemoveLeaderboardDefinition unregister a leaderboard, its members are kept and it goes back to the
+// implicit behaviour of unregistered leaderboards
+func (s *Service) RemoveLeaderboardDefinition(ctx context.Context, leaderboard string) error {
+	if s.registry == nil {
+		return NewGeneralError(removeLeaderboardDefinitionServiceLabel, registryNotConfiguredMessage)
+	}
+
+	err := s.registry.RemoveLeaderboardDefinition(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardDefinitionNotFoundError); ok {
+			return NewLeaderboardDefinitionNotFoundError(leaderboard)
+		}
+		return NewGeneralError(removeLeaderboardDefinitionServiceLabel, err.Error())
+	}
+	s.forgetDefinition(leaderboard)
+
+	return nil
+}
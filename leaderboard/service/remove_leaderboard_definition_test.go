@@ -0,0 +1,47 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service RemoveLeaderboardDefinition", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+
+		svc = service.NewService(mock, service.WithRegistry(registry))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return nil if all is OK", func() {
+		registry.EXPECT().RemoveLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+
+		err := svc.RemoveLeaderboardDefinition(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return error LeaderboardDefinitionNotFoundError if leaderboard isn't registered", func() {
+		registry.EXPECT().RemoveLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(database.NewLeaderboardDefinitionNotFoundError(leaderboard))
+
+		err := svc.RemoveLeaderboardDefinition(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+	})
+})
@@ -6,7 +6,20 @@ const removeMemberServiceLabel = "remove member"
 
 // RemoveMember dele specific member from leaderboard
 func (s *Service) RemoveMember(ctx context.Context, leaderboard, member string) error {
-	err := s.Database.RemoveMembers(ctx, leaderboard, member)
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return err
+	}
+
+	leaderboard, err = s.memberLeaderboard(ctx, removeMemberServiceLabel, leaderboard, member)
+	if err != nil {
+		if _, ok := err.(*MemberNotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+
+	err = s.Database.RemoveMembers(ctx, leaderboard, member)
 	if err != nil {
 		return NewGeneralError(removeMemberServiceLabel, err.Error())
 	}
@@ -6,9 +6,21 @@ const removeMembersServiceLabel = "remove members"
 
 // RemoveMembers remove members from a certain leaderboard
 func (s *Service) RemoveMembers(ctx context.Context, leaderboard string, members []string) error {
-	err := s.Database.RemoveMembers(ctx, leaderboard, members...)
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return err
+	}
+
+	leaderboards, leaderboardMembers, err := s.membersLeaderboards(ctx, leaderboard, members)
 	if err != nil {
 		return NewGeneralError(removeMembersServiceLabel, err.Error())
 	}
+
+	for _, leaderboard := range leaderboards {
+		err = s.Database.RemoveMembers(ctx, leaderboard, leaderboardMembers[leaderboard]...)
+		if err != nil {
+			return NewGeneralError(removeMembersServiceLabel, err.Error())
+		}
+	}
 	return nil
 }
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const reportChallengeResultServiceLabel = "report challenge result"
+
+// ReportChallengeResult apply the result of a challenger challenging a defender ranked above it in a ladder,
+// a winning challenger takes the defender position and everyone in between moves one position down, members
+// that aren't in the ladder join it at the bottom. It return the challenger and the defender, in this order
+func (s *Service) ReportChallengeResult(ctx context.Context, leaderboard, challenger, defender string, challengerWon bool) ([]*model.Member, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	ladder := s.getLadder(leaderboard)
+	if ladder == nil || s.getLeague(leaderboard) != nil {
+		return nil, NewLeaderboardNotLadderError(leaderboard)
+	}
+
+	if challenger == "" || defender == "" {
+		return nil, NewInvalidChallengeError("challenger and defender are required")
+	}
+	if challenger == defender {
+		return nil, NewInvalidChallengeError("member " + challenger + " can't challenge itself")
+	}
+
+	_, expireAt, seasonEnd, err := s.openSeasonWrite(ctx, leaderboard)
+	if err != nil {
+		return nil, writeError(reportChallengeResultServiceLabel, leaderboard, err)
+	}
+
+	databaseMembers, err := s.ladders.ReportChallenge(ctx, leaderboard, &database.Challenge{
+		Challenger:    challenger,
+		Defender:      defender,
+		ChallengerWon: challengerWon,
+		MaxDistance:   ladder.MaxChallengeDistance,
+	}, &database.LadderOptions{
+		ExpireAt:          expireAt,
+		SeasonEnd:         seasonEnd,
+		IdempotencyKey:    s.idempotencyKey(ctx),
+		IdempotencyWindow: s.idempotencyWindow,
+	})
+	if err != nil {
+		if _, ok := err.(*database.ChallengeOutOfRangeError); ok {
+			return nil, NewInvalidChallengeError(err.Error())
+		}
+		return nil, NewGeneralError(reportChallengeResultServiceLabel, err.Error())
+	}
+
+	members := make([]*model.Member, 0, len(databaseMembers))
+	for _, databaseMember := range databaseMembers {
+		member := &model.Member{
+			PublicID:     databaseMember.Member,
+			Score:        databaseMember.Score,
+			Rank:         int(databaseMember.Rank + 1),
+			PreviousRank: -1,
+			ScoreChanged: databaseMember.ScoreChanged,
+		}
+		if databaseMember.PreviousRank >= 0 {
+			member.PreviousRank = int(databaseMember.PreviousRank + 1)
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
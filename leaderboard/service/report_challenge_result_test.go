@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ReportChallengeResult", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var laddersMock *database.MockLadders
+	var svc *service.Service
+
+	var leaderboard string = "ladder"
+	var ladder = &service.Ladder{MaxChallengeDistance: 3}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		laddersMock = database.NewMockLadders(ctrl)
+
+		svc = service.NewService(mock, service.WithLadders(laddersMock, func(id string) *service.Ladder {
+			if id == leaderboard {
+				return ladder
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return the challenger and the defender with their new positions", func() {
+		laddersMock.EXPECT().ReportChallenge(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Challenge{
+			Challenger:    "member1",
+			Defender:      "member2",
+			ChallengerWon: true,
+			MaxDistance:   3,
+		}), gomock.Eq(&database.LadderOptions{})).Return([]*database.Member{
+			{Member: "member1", Score: 2, Rank: 1, PreviousRank: 3, ScoreChanged: true},
+			{Member: "member2", Score: 3, Rank: 2, PreviousRank: 1, ScoreChanged: true},
+		}, nil)
+
+		members, err := svc.ReportChallengeResult(context.Background(), leaderboard, "member1", "member2", true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.Member{
+			{PublicID: "member1", Score: 2, Rank: 2, PreviousRank: 4, ScoreChanged: true},
+			{PublicID: "member2", Score: 3, Rank: 3, PreviousRank: 2, ScoreChanged: true},
+		}))
+	})
+
+	It("Should rank ladder members in ascending position order", func() {
+		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("asc")).Return(0, nil)
+
+		rank, err := svc.GetRank(context.Background(), leaderboard, "member1", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(1))
+	})
+
+	It("Should return error InvalidScoresError if scores are written to a ladder", func() {
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 1, false, "", "")
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidScoresError{}))
+	})
+
+	It("Should return error InvalidChallengeError if the challenge can't be applied", func() {
+		_, err := svc.ReportChallengeResult(context.Background(), leaderboard, "member1", "member1", true)
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidChallengeError{}))
+
+		laddersMock.EXPECT().ReportChallenge(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(nil, database.NewChallengeOutOfRangeError(leaderboard, "member1", "member2"))
+
+		_, err = svc.ReportChallengeResult(context.Background(), leaderboard, "member1", "member2", true)
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidChallengeError{}))
+	})
+
+	It("Should return error LeaderboardNotLadderError if leaderboard isn't a ladder", func() {
+		_, err := svc.ReportChallengeResult(context.Background(), "scores", "member1", "member2", true)
+		Expect(err).To(MatchError(service.NewLeaderboardNotLadderError("scores")))
+	})
+
+	It("Should return error if database return in error", func() {
+		laddersMock.EXPECT().ReportChallenge(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.ReportChallengeResult(context.Background(), leaderboard, "member1", "member2", false)
+		Expect(err).To(MatchError(service.NewGeneralError("report challenge result", "database error: unknown error")))
+	})
+})
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// scoreEncoding converts members score into the one stored in a leaderboard and back
+type scoreEncoding struct {
+	composite *CompositeScore
+	tieBreak  *TieBreak
+	scoreType *ScoreType
+}
+
+func (s *Service) scoreEncoding(leaderboard string) scoreEncoding {
+	leaderboard = s.leagueOf(leaderboard)
+
+	var encoding scoreEncoding
+	if s.compositeScores != nil {
+		encoding.composite = s.compositeScores(leaderboard)
+	}
+	if s.tieBreaks != nil {
+		encoding.tieBreak = s.tieBreaks(leaderboard)
+	}
+	if s.scoreTypes != nil {
+		encoding.scoreType = s.scoreTypes(leaderboard)
+	}
+	if encoding.scoreType == nil {
+		encoding.scoreType = &ScoreType{Type: ScoreTypeInteger}
+	}
+	return encoding
+}
+
+// validateUpdatePolicy checks update policy can combine stored scores
+func (e scoreEncoding) validateUpdatePolicy(updatePolicy string) error {
+	if e.composite != nil && updatePolicy == database.UpdatePolicySum {
+		return NewInvalidScoresError("composite scores can't be summed")
+	}
+	return nil
+}
+
+// encode packs member scores into its score if leaderboard has a composite score and returns the stored score,
+// scores that aren't exactly representable by the leaderboard score type are rejected
+func (e scoreEncoding) encode(member *model.Member, submittedAt time.Time) (float64, error) {
+	if e.scoreType.Type != ScoreTypeInteger && (e.composite != nil || e.tieBreak != nil) {
+		return 0, NewInvalidScoresError(e.scoreType.Type + " scores can't have a composite score or a tie-break")
+	}
+
+	switch {
+	case e.composite != nil:
+		score, err := e.composite.EncodeScores(member.Scores)
+		if err != nil {
+			return 0, err
+		}
+		member.Score = float64(score)
+	case len(member.Scores) > 0:
+		return 0, NewInvalidScoresError("leaderboard has no composite score")
+	}
+
+	stored, err := e.scoreType.Encode(member.Score)
+	if err != nil {
+		return 0, err
+	}
+
+	if e.tieBreak != nil {
+		return e.tieBreak.EncodeScore(int64(stored), submittedAt)
+	}
+	return stored, nil
+}
+
+// decode fills member score, and scores if leaderboard has a composite score, from the stored score
+func (e scoreEncoding) decode(member *model.Member, stored float64) {
+	member.Score = e.scoreType.Decode(stored)
+	if e.tieBreak != nil {
+		member.Score = float64(e.tieBreak.DecodeScore(stored))
+	}
+	if e.composite != nil {
+		member.Scores = e.composite.DecodeScore(int64(member.Score))
+	}
+}
+
+// encodePrecondition converts the expected score of a precondition to the stored score type, stored scores are
+// divided by the score scale before they're compared with it
+func (e scoreEncoding) encodePrecondition(member string, precondition *model.Precondition) (*database.Precondition, error) {
+	set := 0
+	for _, condition := range []bool{precondition.ExpectedScore != nil, precondition.IfAbsent, precondition.IfPresent} {
+		if condition {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, NewInvalidPreconditionError(member, "only one of expected score, if absent and if present can be set")
+	}
+
+	if precondition.ExpectedScore == nil {
+		return &database.Precondition{Absent: precondition.IfAbsent, Present: precondition.IfPresent}, nil
+	}
+
+	expectedScore, err := e.scoreType.Encode(*precondition.ExpectedScore)
+	if err != nil {
+		return nil, NewInvalidPreconditionError(member, err.Error())
+	}
+	return &database.Precondition{ExpectedScore: &expectedScore}, nil
+}
+
+// preconditionFailed returns the PreconditionFailedError of failed with the current score decoded
+func (e scoreEncoding) preconditionFailed(failed *database.PreconditionFailedError) *PreconditionFailedError {
+	stored, ok := failed.CurrentScore()
+	if !ok {
+		return NewPreconditionFailedError(failed.Member(), nil)
+	}
+
+	currentScore := decodedScore(e, stored)
+	return NewPreconditionFailedError(failed.Member(), &currentScore)
+}
+
+// scoreScale is the factor scores are multiplied by when stored
+func (e scoreEncoding) scoreScale() float64 {
+	if e.tieBreak == nil {
+		return 0
+	}
+	return float64(e.tieBreak.Scale())
+}
+
+// sumRange returns the range of stored scores, divided by the score scale, sums can result in without losing
+// precision, nil for float scores which are stored as they are
+func (e scoreEncoding) sumRange() *database.ScoreRange {
+	switch {
+	case e.tieBreak != nil:
+		min, max := e.tieBreak.ScoreRange()
+		return &database.ScoreRange{Min: float64(min), Max: float64(max)}
+	case e.scoreType.Type == ScoreTypeFloat:
+		return nil
+	}
+	return &database.ScoreRange{Min: -MaxExactScore, Max: MaxExactScore}
+}
+
+// sumOutOfRange returns the ScoreOutOfRangeError of a sum out of sumRange
+func (e scoreEncoding) sumOutOfRange(outOfRange *database.SumOutOfRangeError) *ScoreOutOfRangeError {
+	scoreRange := e.sumRange()
+	scale := e.scoreType.scale()
+	return NewScoreOutOfRangeError(outOfRange.Sum()/scale, scoreRange.Min/scale, scoreRange.Max/scale)
+}
+
+// maxStoredScore returns the highest stored score of members with score
+func (e scoreEncoding) maxStoredScore(score float64) string {
+	if e.scoreType.Type == ScoreTypeFloat {
+		return strconv.FormatFloat(score, 'f', -1, 64)
+	}
+	if e.tieBreak == nil {
+		return strconv.FormatFloat(math.Floor(score*e.scoreType.scale()), 'f', -1, 64)
+	}
+	return strconv.FormatFloat(e.tieBreak.MaxStoredScore(int64(math.Floor(score))), 'f', -1, 64)
+}
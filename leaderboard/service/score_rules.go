@@ -0,0 +1,106 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Score rules a submission can break
+const (
+	// ScoreRuleMinScore is broken by scores that would leave a member below the minimum score
+	ScoreRuleMinScore = database.ScoreRuleMinScore
+	// ScoreRuleMaxScore is broken by scores that would leave a member above the maximum score
+	ScoreRuleMaxScore = database.ScoreRuleMaxScore
+	// ScoreRuleMaxIncrement is broken by increments larger than the maximum increment
+	ScoreRuleMaxIncrement = database.ScoreRuleMaxIncrement
+	// ScoreRuleMaxDelta is broken by scores that would change a member score more than the maximum delta
+	// since its delta window started
+	ScoreRuleMaxDelta = database.ScoreRuleMaxDelta
+	// ScoreRuleMaxSubmissions is broken by submissions past the maximum of a member submission window
+	ScoreRuleMaxSubmissions = database.ScoreRuleMaxSubmissions
+)
+
+const scoreRuleViolationsMetric = "score_rule_violations"
+
+// ScoreRules bound the scores written to a leaderboard, they are checked against member scores as seen by
+// clients and zero limits are not enforced
+type ScoreRules struct {
+	// MinScore and MaxScore bound the score a member ends up with, nil means unbounded
+	MinScore *float64
+	MaxScore *float64
+	// MaxIncrement bounds the absolute value of increments and of scores written with the sum update policy
+	MaxIncrement float64
+	// MaxDelta bounds how much a member score can change since its delta window started, members without a
+	// score count from zero
+	MaxDelta    float64
+	DeltaWindow time.Duration
+	// MaxSubmissions bounds how many submissions, refused ones included, a member makes per submission window
+	MaxSubmissions   int
+	SubmissionWindow time.Duration
+	// Quarantine holds writes that break a rule for review instead of refusing them, they are not ranked
+	Quarantine bool
+}
+
+// Validate returns an error if a limit can't be enforced
+func (r *ScoreRules) Validate() error {
+	if r.MinScore != nil && r.MaxScore != nil && *r.MinScore > *r.MaxScore {
+		return fmt.Errorf("min score %s is greater than max score %s", formatScore(*r.MinScore), formatScore(*r.MaxScore))
+	}
+	if r.MaxIncrement < 0 || r.MaxDelta < 0 || r.MaxSubmissions < 0 {
+		return fmt.Errorf("limits can't be negative")
+	}
+	if (r.MaxDelta > 0) != (r.DeltaWindow > 0) {
+		return fmt.Errorf("max delta and delta window must be set together")
+	}
+	if (r.MaxSubmissions > 0) != (r.SubmissionWindow > 0) {
+		return fmt.Errorf("max submissions and submission window must be set together")
+	}
+	return nil
+}
+
+// getScoreRules return the score rules of leaderboard, nil if it has none
+func (s *Service) getScoreRules(leaderboard string) *ScoreRules {
+	if s.submissions == nil || s.scoreRules == nil {
+		return nil
+	}
+	return s.scoreRules(s.leagueOf(leaderboard))
+}
+
+// databaseRules return the rules the database checks when scores encoded with encoding are submitted at
+// submittedAt, in the same call that writes them so concurrent writes can't get past them
+func (r *ScoreRules) databaseRules(encoding scoreEncoding, submittedAt time.Time) *database.ScoreRules {
+	return &database.ScoreRules{
+		MinScore:         r.MinScore,
+		MaxScore:         r.MaxScore,
+		MaxIncrement:     r.MaxIncrement,
+		MaxDelta:         r.MaxDelta,
+		DeltaWindow:      r.DeltaWindow,
+		MaxSubmissions:   r.MaxSubmissions,
+		SubmissionWindow: r.SubmissionWindow,
+		Quarantine:       r.Quarantine,
+		ScoreUnit:        encoding.scoreType.scale(),
+		Now:              submittedAt,
+	}
+}
+
+// scoreRuleViolation count a write refused for breaking a score rule and return its ScoreRuleViolationError
+func (s *Service) scoreRuleViolation(violation *database.ScoreRuleViolationError) *ScoreRuleViolationError {
+	s.countScoreRuleViolation(violation.Rule(), "rejected")
+	return NewScoreRuleViolationError(violation.Member(), violation.Rule(), violation.Value(), violation.Limit())
+}
+
+func (s *Service) countScoreRuleViolation(rule, action string) {
+	if s.metricsReporter == nil {
+		return
+	}
+	_ = s.metricsReporter.Increment(scoreRuleViolationsMetric, "rule:"+rule, "action:"+action)
+}
+
+func decodedScore(encoding scoreEncoding, stored float64) float64 {
+	member := &model.Member{}
+	encoding.decode(member, stored)
+	return member.Score
+}
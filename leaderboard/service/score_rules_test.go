@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	mock_extensions "github.com/topfreegames/extensions/middleware/mocks"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ScoreRules", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var submissions *database.MockSubmissions
+	var metricsReporter *mock_extensions.MockMetricsReporter
+	var svc *service.Service
+
+	minScore := 0.0
+	maxScore := 1000.0
+	scoreRules := map[string]*service.ScoreRules{
+		"arena": {
+			MinScore:         &minScore,
+			MaxScore:         &maxScore,
+			MaxIncrement:     100,
+			MaxDelta:         300,
+			DeltaWindow:      time.Hour,
+			MaxSubmissions:   5,
+			SubmissionWindow: time.Minute,
+		},
+		"tournament": {
+			MaxScore:   &maxScore,
+			Quarantine: true,
+		},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		submissions = database.NewMockSubmissions(ctrl)
+		metricsReporter = mock_extensions.NewMockMetricsReporter(ctrl)
+
+		svc = service.NewService(mock, service.WithScoreRules(submissions, func(leaderboardID string) *service.ScoreRules {
+			return scoreRules[leaderboardID]
+		}), service.WithMetricsReporter(metricsReporter))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should validate score rules", func() {
+		for _, rules := range scoreRules {
+			Expect(rules.Validate()).To(Succeed())
+		}
+
+		for _, rules := range []*service.ScoreRules{
+			{MinScore: &maxScore, MaxScore: &minScore},
+			{MaxIncrement: -1},
+			{MaxDelta: 10},
+			{DeltaWindow: time.Hour},
+			{MaxSubmissions: 10},
+		} {
+			Expect(rules.Validate()).To(HaveOccurred())
+		}
+	})
+
+	It("Should have the database check score rules as it writes scores", func() {
+		submittedAt := time.Now()
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("arena"), gomock.Eq([]*database.Member{{Member: "member1", Score: 450}}), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.ScoreRules.Now).To(BeTemporally(">=", submittedAt))
+				options.ScoreRules.Now = time.Time{}
+				Expect(options.ScoreRules).To(Equal(&database.ScoreRules{
+					MinScore:         &minScore,
+					MaxScore:         &maxScore,
+					MaxIncrement:     100,
+					MaxDelta:         300,
+					DeltaWindow:      time.Hour,
+					MaxSubmissions:   5,
+					SubmissionWindow: time.Minute,
+					ScoreUnit:        1,
+				}))
+				return []*database.Member{{Member: "member1", Score: 450, Rank: 0}}, nil
+			})
+
+		member, err := svc.SetMemberScore(context.Background(), "arena", "member1", 450, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Rank).To(Equal(1))
+	})
+
+	It("Should check decimal scores in the units clients send them", func() {
+		svc = service.NewService(mock, service.WithScoreRules(submissions, func(leaderboardID string) *service.ScoreRules {
+			return scoreRules[leaderboardID]
+		}), service.WithScoreTypes(func(string) *service.ScoreType {
+			return &service.ScoreType{Type: service.ScoreTypeDecimal, Digits: 2}
+		}))
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("arena"), gomock.Eq([]*database.Member{{Member: "member1", Score: 1250}}), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.ScoreRules.ScoreUnit).To(Equal(float64(100)))
+				return []*database.Member{{Member: "member1", Score: 1250, Rank: 0}}, nil
+			})
+
+		member, err := svc.SetMemberScore(context.Background(), "arena", "member1", 12.5, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(12.5))
+	})
+
+	It("Should refuse scores that break a rule and count the violation", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("arena"), gomock.Any(), gomock.Any()).
+			Return(nil, database.NewScoreRuleViolationError("arena", "member1", service.ScoreRuleMaxIncrement, 150, 100))
+		metricsReporter.EXPECT().Increment("score_rule_violations", "rule:max_increment", "action:rejected")
+
+		_, err := svc.IncrementMemberScore(context.Background(), "arena", "member1", 150, "")
+		Expect(err).To(Equal(service.NewScoreRuleViolationError("member1", service.ScoreRuleMaxIncrement, 150, 100)))
+	})
+
+	It("Should report quarantined members along with the written ones", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("tournament"), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.ScoreRules.Quarantine).To(BeTrue())
+				return []*database.Member{
+					{Member: "member1", Score: 500, Rank: 0},
+					{Member: "member2", Score: 5000, Rank: -1, PreviousRank: -1, QuarantineRule: service.ScoreRuleMaxScore},
+				}, nil
+			})
+		metricsReporter.EXPECT().Increment("score_rule_violations", "rule:max_score", "action:quarantined")
+
+		members := []*model.Member{
+			{PublicID: "member1", Score: 500},
+			{PublicID: "member2", Score: 5000},
+		}
+		err := svc.SetMembersScore(context.Background(), "tournament", members, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.Member{
+			{PublicID: "member1", Score: 500, Rank: 1},
+			{PublicID: "member2", Score: 5000, Quarantined: true},
+		}))
+	})
+
+	It("Should return quarantined members", func() {
+		submittedAt := time.Unix(1700000000, 0)
+		submissions.EXPECT().GetQuarantinedMembers(gomock.Any(), gomock.Eq("tournament")).
+			Return([]*database.QuarantineEntry{{Member: "member2", Score: 5000, Rule: service.ScoreRuleMaxScore, SubmittedAt: submittedAt}}, nil)
+
+		members, err := svc.GetQuarantinedMembers(context.Background(), "tournament")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(Equal([]*model.QuarantinedMember{
+			{PublicID: "member2", Score: 5000, Rule: service.ScoreRuleMaxScore, SubmittedAt: 1700000000},
+		}))
+	})
+
+	It("Should not check leaderboards without score rules", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("casual"), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.ScoreRules).To(BeNil())
+				return []*database.Member{{Member: "member1", Score: 5000, Rank: 0}}, nil
+			})
+
+		_, err := svc.SetMemberScore(context.Background(), "casual", "member1", 5000, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
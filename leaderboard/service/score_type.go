@@ -0,0 +1,109 @@
+package service
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Score types of a leaderboard
+const (
+	// ScoreTypeInteger leaderboards only accept integer scores
+	ScoreTypeInteger = "integer"
+	// ScoreTypeFloat leaderboards accept any finite double as score
+	ScoreTypeFloat = "float"
+	// ScoreTypeDecimal leaderboards accept scores with up to Digits fraction digits and sum them exactly
+	ScoreTypeDecimal = "decimal"
+)
+
+// MaxExactScore is the highest integer a stored score holds without losing precision, integer scores and
+// decimal scores times 10^Digits must be within -MaxExactScore and MaxExactScore
+const MaxExactScore = 1<<maxStoredScoreBits - 1
+
+// maxScoreDigits is the highest number of fraction digits of a decimal score type
+const maxScoreDigits = 15
+
+// ScoreType is the kind of scores a leaderboard accepts, leaderboards without one accept integer scores
+type ScoreType struct {
+	// Type is integer, float or decimal
+	Type string
+	// Digits is how many fraction digits decimal scores have, they are stored as score*10^Digits
+	Digits int
+}
+
+// IsValidScoreType report if scoreType is one of the score types
+func IsValidScoreType(scoreType string) bool {
+	return scoreType == ScoreTypeInteger || scoreType == ScoreTypeFloat || scoreType == ScoreTypeDecimal
+}
+
+// Validate returns an error if the score type is unknown or its digits don't fit it
+func (t *ScoreType) Validate() error {
+	if !IsValidScoreType(t.Type) {
+		return NewInvalidScoresError("unknown score type " + t.Type)
+	}
+	if t.Type == ScoreTypeDecimal && (t.Digits < 1 || t.Digits > maxScoreDigits) {
+		return NewInvalidScoresError("decimal scores must have between 1 and " + strconv.Itoa(maxScoreDigits) + " digits")
+	}
+	if t.Type != ScoreTypeDecimal && t.Digits != 0 {
+		return NewInvalidScoresError("only decimal scores have digits")
+	}
+	return nil
+}
+
+// scale is the factor scores are multiplied by when stored
+func (t *ScoreType) scale() float64 {
+	if t.Type != ScoreTypeDecimal {
+		return 1
+	}
+	return math.Pow10(t.Digits)
+}
+
+// Encode returns the stored value of a score, an error if the score isn't exactly representable by the score type
+func (t *ScoreType) Encode(score float64) (float64, error) {
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return 0, NewInvalidScoresError("score must be a finite number")
+	}
+
+	switch t.Type {
+	case ScoreTypeFloat:
+		return score, nil
+	case ScoreTypeDecimal:
+		if fractionDigits(score) > t.Digits {
+			return 0, NewInvalidScoresError("score " + formatScore(score) + " has more than " + strconv.Itoa(t.Digits) + " fraction digits")
+		}
+		stored := math.Round(score * t.scale())
+		if math.Abs(stored) > MaxExactScore {
+			return 0, NewScoreOutOfRangeError(score, -MaxExactScore/t.scale(), MaxExactScore/t.scale())
+		}
+		return stored, nil
+	}
+
+	if score != math.Trunc(score) {
+		return 0, NewInvalidScoresError("score " + formatScore(score) + " isn't an integer")
+	}
+	if math.Abs(score) > MaxExactScore {
+		return 0, NewScoreOutOfRangeError(score, -MaxExactScore, MaxExactScore)
+	}
+	return score, nil
+}
+
+// Decode returns the score of a stored value
+func (t *ScoreType) Decode(stored float64) float64 {
+	if t.Type != ScoreTypeDecimal {
+		return stored
+	}
+	return stored / t.scale()
+}
+
+// fractionDigits returns how many fraction digits the shortest representation of score has
+func fractionDigits(score float64) int {
+	formatted := strconv.FormatFloat(score, 'f', -1, 64)
+	if index := strings.IndexByte(formatted, '.'); index >= 0 {
+		return len(formatted) - index - 1
+	}
+	return 0
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
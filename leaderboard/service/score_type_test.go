@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"math"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ScoreType", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	scoreTypes := map[string]*service.ScoreType{
+		"race":             {Type: service.ScoreTypeFloat},
+		"economy":          {Type: service.ScoreTypeDecimal, Digits: 2},
+		"economy-tiebreak": {Type: service.ScoreTypeDecimal, Digits: 2},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = service.NewService(mock, service.WithScoreTypes(func(leaderboardID string) *service.ScoreType {
+			return scoreTypes[leaderboardID]
+		}), service.WithTieBreaks(func(leaderboardID string) *service.TieBreak {
+			if leaderboardID == "economy-tiebreak" {
+				return &service.TieBreak{Bits: 8, Order: "desc"}
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should validate score types", func() {
+		for _, scoreType := range []*service.ScoreType{
+			{Type: service.ScoreTypeInteger},
+			{Type: service.ScoreTypeFloat},
+			{Type: service.ScoreTypeDecimal, Digits: 1},
+			{Type: service.ScoreTypeDecimal, Digits: 15},
+		} {
+			Expect(scoreType.Validate()).To(Succeed())
+		}
+
+		for _, scoreType := range []*service.ScoreType{
+			{Type: "double"},
+			{Type: service.ScoreTypeDecimal},
+			{Type: service.ScoreTypeDecimal, Digits: 16},
+			{Type: service.ScoreTypeFloat, Digits: 2},
+		} {
+			Expect(scoreType.Validate()).To(HaveOccurred())
+		}
+	})
+
+	It("Should store float scores as submitted", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("race"), gomock.Eq([]*database.Member{{Member: "member1", Score: 61.0375}}), gomock.Any()).
+			Return([]*database.Member{{Member: "member1", Score: 61.0375, Rank: 0, PreviousRank: -1}}, nil)
+
+		member, err := svc.SetMemberScore(context.Background(), "race", "member1", 61.0375, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(61.0375))
+	})
+
+	It("Should store decimal scores as integer units and return submitted ones", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("economy"), gomock.Eq([]*database.Member{{Member: "member1", Score: 1999}}), gomock.Any()).
+			Return([]*database.Member{{Member: "member1", Score: 3009, Rank: 0, PreviousRank: -1}}, nil)
+
+		member, err := svc.IncrementMemberScore(context.Background(), "economy", "member1", 19.99, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(30.09))
+	})
+
+	It("Should return InvalidScoresError if score isn't exactly representable", func() {
+		_, err := svc.SetMemberScore(context.Background(), "leaderboard", "member1", 10.5, false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("score 10.5 isn't an integer")))
+
+		_, err = svc.SetMemberScore(context.Background(), "economy", "member1", 0.125, false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("score 0.125 has more than 2 fraction digits")))
+
+		_, err = svc.SetMemberScore(context.Background(), "race", "member1", math.Inf(1), false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("score must be a finite number")))
+
+		_, err = svc.SetMemberScore(context.Background(), "economy-tiebreak", "member1", 1, false, "", "")
+		Expect(err).To(MatchError(service.NewInvalidScoresError("decimal scores can't have a composite score or a tie-break")))
+	})
+
+	It("Should return ScoreOutOfRangeError if score is beyond the exactly representable range", func() {
+		_, err := svc.SetMemberScore(context.Background(), "leaderboard", "member1", service.MaxExactScore+1, false, "", "")
+		Expect(err).To(MatchError(service.NewScoreOutOfRangeError(service.MaxExactScore+1, -service.MaxExactScore, service.MaxExactScore)))
+
+		_, err = svc.SetMemberScore(context.Background(), "economy", "member1", -1e14, false, "", "")
+		Expect(err).To(MatchError(service.NewScoreOutOfRangeError(-1e14, -service.MaxExactScore/100.0, service.MaxExactScore/100.0)))
+	})
+
+	It("Should search around the highest stored score of the score type", func() {
+		for leaderboard, max := range map[string]string{"race": "12.75", "economy": "1275", "leaderboard": "12"} {
+			mock.EXPECT().GetMemberIDsWithScoreInsideRange(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("-inf"), gomock.Eq(max), gomock.Eq(0), gomock.Eq(1)).
+				Return([]string{"member1"}, nil)
+			mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("desc")).Return(0, nil)
+			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1, nil)
+			mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).
+				Return([]*database.Member{{Member: "member1", Score: 1300, Rank: 0}}, nil)
+
+			_, err := svc.GetAroundScore(context.Background(), leaderboard, 1, 12.75, "desc")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+})
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+)
+
+// Season selectors pick a season of a family, like weekly@previous or weekly@-2
+const (
+	// SeasonSelectorSeparator splits a family ID from its season selector
+	SeasonSelectorSeparator = "@"
+	// SeasonSelectorCurrent selects the season happening now, it's used when no selector is sent
+	SeasonSelectorCurrent = "current"
+	// SeasonSelectorPrevious selects the season before the current one
+	SeasonSelectorPrevious = "previous"
+)
+
+// SeasonFamily is a leaderboard ID resolved to the leaderboard of its current season, so clients don't
+// compute seasonal leaderboard names themselves
+type SeasonFamily struct {
+	// Prefix is prepended to season suffixes to name the seasonal leaderboards, like cario-sisters-
+	Prefix string
+	// Cadence is how often seasons start
+	Cadence expiration.Cadence
+	// Location is the time zone seasons start in, defaults to UTC
+	Location *time.Location
+}
+
+// Leaderboard return the name of the leaderboard offset seasons away from the one happening at t
+func (sf *SeasonFamily) Leaderboard(t time.Time, offset int) string {
+	location := sf.Location
+	if location == nil {
+		location = time.UTC
+	}
+	return sf.Prefix + sf.Cadence.SeasonSuffix(t.In(location), offset)
+}
+
+// parseSeasonSelector return how many seasons away from the current one selector is
+func parseSeasonSelector(selector string) (int, bool) {
+	switch selector {
+	case "", SeasonSelectorCurrent:
+		return 0, true
+	case SeasonSelectorPrevious:
+		return -1, true
+	}
+
+	offset, err := strconv.Atoi(selector)
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
+
+// resolveLeaderboard return the seasonal leaderboard selected if leaderboard is a season family,
+// otherwise leaderboard itself
+func (s *Service) resolveLeaderboard(leaderboard string) (string, error) {
+	if s.seasonFamilies == nil {
+		return leaderboard, nil
+	}
+
+	family, selector, _ := strings.Cut(leaderboard, SeasonSelectorSeparator)
+	seasonFamily := s.seasonFamilies(family)
+	if seasonFamily == nil {
+		return leaderboard, nil
+	}
+
+	offset, ok := parseSeasonSelector(selector)
+	if !ok {
+		return "", NewInvalidSeasonSelectorError(leaderboard)
+	}
+
+	return seasonFamily.Leaderboard(time.Now(), offset), nil
+}
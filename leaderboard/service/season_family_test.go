@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service season families", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var family string = "cario-sisters-weekly"
+	var member string = "member1"
+	var seasonFamily = &service.SeasonFamily{Prefix: "cario-sisters-", Cadence: expiration.CadenceWeekly}
+
+	var membersDatabaseReturn = []*database.Member{
+		{Member: member, Score: float64(1), Rank: int64(0)},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = service.NewService(mock, service.WithSeasonFamilies(func(leaderboard string) *service.SeasonFamily {
+			if leaderboard == family {
+				return seasonFamily
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should resolve family leaderboard", func() {
+		Expect(seasonFamily.Leaderboard(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), 0)).To(Equal("cario-sisters-year2026week42"))
+		Expect(seasonFamily.Leaderboard(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), -1)).To(Equal("cario-sisters-year2026week41"))
+	})
+
+	It("Should resolve family leaderboard in its location", func() {
+		saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+		Expect(err).NotTo(HaveOccurred())
+
+		localFamily := &service.SeasonFamily{Prefix: "cario-sisters-", Cadence: expiration.CadenceDaily, Location: saoPaulo}
+		Expect(localFamily.Leaderboard(time.Date(2026, time.October, 18, 1, 0, 0, 0, time.UTC), 0)).To(Equal("cario-sisters-year2026month10day17"))
+	})
+
+	It("Should write to the current season leaderboard", func() {
+		current := seasonFamily.Leaderboard(time.Now(), 0)
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(current), gomock.Any(), gomock.Any()).Return(membersDatabaseReturn, nil)
+
+		_, err := svc.SetMemberScore(context.Background(), family, member, 1, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should read the season selected", func() {
+		for selector, offset := range map[string]int{"": 0, "@current": 0, "@previous": -1, "@-2": -2} {
+			leaderboard := seasonFamily.Leaderboard(time.Now(), offset)
+			mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return(membersDatabaseReturn, nil)
+
+			_, err := svc.GetMember(context.Background(), family+selector, member, "", false, "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("Should return error InvalidSeasonSelectorError if selector is unknown", func() {
+		_, err := svc.GetMember(context.Background(), family+"@next", member, "", false, "")
+		Expect(err).To(MatchError(service.NewInvalidSeasonSelectorError(family + "@next")))
+	})
+
+	It("Should use leaderboards that aren't a family as they are", func() {
+		leaderboard := "cario-sisters@previous"
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return(membersDatabaseReturn, nil)
+
+		_, err := svc.GetMember(context.Background(), leaderboard, member, "", false, "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
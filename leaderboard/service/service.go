@@ -1,15 +1,183 @@
 package service
 
 import (
+	"time"
+
+	extensions "github.com/topfreegames/extensions/middleware"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
 )
 
 // Service holds all dependencies to leaderboard execute your operations
 type Service struct {
 	database.Database
+	tieBreaks       func(leaderboard string) *TieBreak
+	compositeScores func(leaderboard string) *CompositeScore
+	updatePolicies  func(leaderboard string) string
+	registry        database.Registry
+	definitions     *definitionCache
+	seasonPolicies  func(leaderboard string) expiration.Policy
+	seasonFamilies  func(family string) *SeasonFamily
+	archive         database.Archive
+	archived        func(leaderboard string) bool
+	leagues         database.Leagues
+	leagueConfigs   func(leaderboard string) *League
+	tiers           database.Tiers
+	tieredLeagues   func(competition string) *TieredLeague
+	ratings         database.Ratings
+	ratingSettings  func(leaderboard string) *rating.Settings
+	ladders         database.Ladders
+	ladderConfigs   func(leaderboard string) *Ladder
+	scoreTypes      func(leaderboard string) *ScoreType
+	submissions     database.Submissions
+	scoreRules      func(leaderboard string) *ScoreRules
+	metricsReporter extensions.MetricsReporter
+
+	nonces            database.Nonces
+	submissionSigning func(tenant string) *SubmissionSigning
+	signingWindow     time.Duration
+
+	idempotencyWindow time.Duration
+}
+
+// Option configures an optional Service behaviour
+type Option func(*Service)
+
+// WithTieBreaks sets the function used to find a leaderboard tie-break, leaderboards without one
+// order members with equal scores by public ID
+func WithTieBreaks(tieBreaks func(leaderboard string) *TieBreak) Option {
+	return func(s *Service) {
+		s.tieBreaks = tieBreaks
+	}
+}
+
+// WithCompositeScores sets the function used to find a leaderboard composite score, leaderboards without one
+// rank members by a single score
+func WithCompositeScores(compositeScores func(leaderboard string) *CompositeScore) Option {
+	return func(s *Service) {
+		s.compositeScores = compositeScores
+	}
+}
+
+// WithScoreTypes sets the function used to find the kind of scores a leaderboard accepts, leaderboards without
+// one accept integer scores
+func WithScoreTypes(scoreTypes func(leaderboard string) *ScoreType) Option {
+	return func(s *Service) {
+		s.scoreTypes = scoreTypes
+	}
+}
+
+// WithUpdatePolicies sets the function used to find a leaderboard default update policy, it's used when
+// neither the request nor the leaderboard definition set one
+func WithUpdatePolicies(updatePolicies func(leaderboard string) string) Option {
+	return func(s *Service) {
+		s.updatePolicies = updatePolicies
+	}
+}
+
+// WithRegistry sets where leaderboard definitions are stored, without a registry every leaderboard
+// behaves as an unregistered one
+func WithRegistry(registry database.Registry) Option {
+	return func(s *Service) {
+		s.registry = registry
+	}
+}
+
+// WithSeasonPolicies sets the function used to find how a leaderboard season is read from its name and how long
+// it's kept, leaderboards without one read seasons in UTC and are kept for twice their season length
+func WithSeasonPolicies(seasonPolicies func(leaderboard string) expiration.Policy) Option {
+	return func(s *Service) {
+		s.seasonPolicies = seasonPolicies
+	}
+}
+
+// WithSeasonFamilies sets the function used to find a season family, leaderboards that aren't a family
+// are used as they are
+func WithSeasonFamilies(seasonFamilies func(family string) *SeasonFamily) Option {
+	return func(s *Service) {
+		s.seasonFamilies = seasonFamilies
+	}
+}
+
+// WithArchive sets where final standings of seasonal leaderboards are archived and the function used to find
+// if a leaderboard is archived when its season ends, without an archive no leaderboard is archived
+func WithArchive(archive database.Archive, archived func(leaderboard string) bool) Option {
+	return func(s *Service) {
+		s.archive = archive
+		s.archived = archived
+	}
+}
+
+// WithLeagues sets where league members buckets are stored and the function used to find a league,
+// leaderboards that aren't a league rank all their members together
+func WithLeagues(leagues database.Leagues, leagueConfigs func(leaderboard string) *League) Option {
+	return func(s *Service) {
+		s.leagues = leagues
+		s.leagueConfigs = leagueConfigs
+	}
+}
+
+// WithTieredLeagues sets where the tier history of tiered league members is stored and the function used to
+// find a tiered league, without tier histories every member plays in the lowest tier
+func WithTieredLeagues(tiers database.Tiers, tieredLeagues func(competition string) *TieredLeague) Option {
+	return func(s *Service) {
+		s.tiers = tiers
+		s.tieredLeagues = tieredLeagues
+	}
+}
+
+// WithRatings sets where member ratings are stored and the function used to find a leaderboard rating
+// settings, match results can only be submitted to leaderboards with rating settings
+func WithRatings(ratings database.Ratings, ratingSettings func(leaderboard string) *rating.Settings) Option {
+	return func(s *Service) {
+		s.ratings = ratings
+		s.ratingSettings = ratingSettings
+	}
+}
+
+// WithLadders sets where ladder positions are reordered and the function used to find a ladder, challenge
+// results can only be reported to ladder leaderboards
+func WithLadders(ladders database.Ladders, ladderConfigs func(leaderboard string) *Ladder) Option {
+	return func(s *Service) {
+		s.ladders = ladders
+		s.ladderConfigs = ladderConfigs
+	}
+}
+
+// WithScoreRules sets where quarantined writes are read from and the function used to find a leaderboard score
+// rules, which the database checks as it writes scores. Scores written to leaderboards without them are only
+// checked against their score type
+func WithScoreRules(submissions database.Submissions, scoreRules func(leaderboard string) *ScoreRules) Option {
+	return func(s *Service) {
+		s.submissions = submissions
+		s.scoreRules = scoreRules
+	}
+}
+
+// WithMetricsReporter sets where score rule violations are counted
+func WithMetricsReporter(metricsReporter extensions.MetricsReporter) Option {
+	return func(s *Service) {
+		s.metricsReporter = metricsReporter
+	}
+}
+
+// WithSubmissionSigning sets where the nonces of signed submissions are claimed, the function used to find how
+// the submissions of a tenant are signed and how far a signed submission timestamp can be from now, submissions
+// of tenants without signing aren't verified
+func WithSubmissionSigning(nonces database.Nonces, submissionSigning func(tenant string) *SubmissionSigning, signingWindow time.Duration) Option {
+	return func(s *Service) {
+		s.nonces = nonces
+		s.submissionSigning = submissionSigning
+		s.signingWindow = signingWindow
+	}
 }
 
 // NewService instantiate a new Service
-func NewService(database database.Database) *Service {
-	return &Service{database}
+func NewService(database database.Database, options ...Option) *Service {
+	service := &Service{Database: database}
+	for _, option := range options {
+		option(service)
+	}
+	return service
 }
@@ -21,7 +21,7 @@ var _ = Describe("Service SetMemberScore", func() {
 
 	var leaderboard string = "leaderboard"
 	var member string = "member1"
-	var score int64 = 1.0
+	var score float64 = 1.0
 	var previousRank bool = false
 	var scoreTTL string = ""
 
@@ -32,23 +32,12 @@ var _ = Describe("Service SetMemberScore", func() {
 		},
 	}
 
-	databaseMembersToGetRank := []string{
-		"member1",
-	}
-
-	databaseMembersPreviousRankReturned := []*database.Member{
-		{
-			Member: "member1",
-			Score:  2.0,
-			Rank:   int64(0),
-		},
-	}
-
 	databaseMembersReturned := []*database.Member{
 		{
-			Member: "member1",
-			Score:  1.0,
-			Rank:   int64(1),
+			Member:       "member1",
+			Score:        1.0,
+			Rank:         int64(1),
+			PreviousRank: int64(-1),
 		},
 	}
 
@@ -56,7 +45,7 @@ var _ = Describe("Service SetMemberScore", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -72,121 +61,98 @@ var _ = Describe("Service SetMemberScore", func() {
 				Rank:         2,
 			}
 
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc"}),
+			).Times(1).Return(databaseMembersReturned, nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
 		})
 	})
 
-	Describe("When previousRank is true", func() {
-		previousRank := true
-
-		It("Should set Members with previousRank", func() {
+	Describe("When updatePolicy is set", func() {
+		It("Should upsert with update policy and report if score changed", func() {
 			expectedMember := &model.Member{
 				PublicID:     "member1",
-				Score:        1,
-				PreviousRank: 1,
-				Rank:         2,
+				Score:        5,
+				Rank:         1,
+				ScoreChanged: false,
 			}
 
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Times(1).Return(databaseMembersPreviousRankReturned, nil)
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
-
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyBest}),
+			).Times(1).Return([]*database.Member{
+				{
+					Member:       "member1",
+					Score:        5.0,
+					Rank:         int64(0),
+					PreviousRank: int64(-1),
+					ScoreChanged: false,
+				},
+			}, nil)
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, database.UpdatePolicyBest)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
 		})
+	})
 
-		It("Should set a non existent member as rank equals to -1", func() {
-			databaseMembersPreviousRankReturned := []*database.Member{nil}
+	Describe("When previousRank is true", func() {
+		previousRank := true
+
+		It("Should set Members with previousRank", func() {
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        1,
-				PreviousRank: -1,
+				PreviousRank: 1,
 				Rank:         2,
 			}
 
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Times(1).Return(databaseMembersPreviousRankReturned, nil)
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
-
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", PreviousRank: true}),
+			).Times(1).Return([]*database.Member{
+				{
+					Member:       "member1",
+					Score:        1.0,
+					Rank:         int64(1),
+					PreviousRank: int64(0),
+				},
+			}, nil)
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
 		})
 
-		It("Should return error if GetMembers return in error", func() {
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Times(1).Return(nil, fmt.Errorf("New database error"))
-
-			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
-			Expect(err).To(Equal(service.NewGeneralError("set member score", "New database error")))
-		})
-	})
-
-	Describe("When scoreTTL is empty", func() {
-		It("Should SetMembers without filling expire ordered set", func() {
+		It("Should set a non existent member as rank equals to -1", func() {
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        1,
-				PreviousRank: 0,
+				PreviousRank: -1,
 				Rank:         2,
 			}
 
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", PreviousRank: true}),
+			).Times(1).Return(databaseMembersReturned, nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
@@ -196,167 +162,206 @@ var _ = Describe("Service SetMemberScore", func() {
 	Describe("When scoreTTL is set", func() {
 		scoreTTL := "100"
 
-		It("Should SetMembers filling expire ordered set", func() {
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
+		It("Should upsert members with TTL", func() {
+			memberTTL := time.Now().Add(100 * time.Second)
+			databaseMembersWithTTLReturned := []*database.Member{
+				{
+					Member:       "member1",
+					Score:        1.0,
+					Rank:         int64(1),
+					PreviousRank: int64(-1),
+					TTL:          memberTTL,
+				},
+			}
 
-			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Times(1).Return(nil)
+			mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+					Expect(options.TTL.Unix()).To(BeNumerically("~", memberTTL.Unix(), 100))
+					return databaseMembersWithTTLReturned, nil
+				})
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
+			Expect(member.ExpireAt).To(Equal(int(memberTTL.Unix())))
 		})
 	})
 
 	Describe("When scoreTTL is invalid", func() {
 		scoreTTL := "invalid"
 
-		It("Should return error", func() {
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-			).Return(databaseMembersReturned, nil)
-
-			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+		It("Should return error without writing to database", func() {
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 			Expect(err).To(MatchError(service.NewGeneralError("set member score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
-
 		})
 	})
 
-	It("Should return error if database SetMembers return in error", func() {
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(fmt.Errorf("New database error"))
+	It("Should return error if database UpsertMembers return in error", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).Return(nil, fmt.Errorf("New database error"))
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
 		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
 	})
 
-	It("Should return error if database GetMembers return in error", func() {
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboard),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-		).Return(nil, fmt.Errorf("New database error"))
-
-		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
-
-	})
-
-	It("Should not call database GetLeaderboardExpiration and SetLeaderboardExpiration if leaderboard isn't formatted to have an expiration", func() {
-		expectedMember := &model.Member{
-			PublicID:     "member1",
-			Score:        1,
-			PreviousRank: 0,
-			Rank:         2,
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboard),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-		).Return(databaseMembersReturned, nil)
-
-		member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
-		Expect(err).NotTo(HaveOccurred())
-
-		Expect(member).To(Equal(expectedMember))
-	})
-
-	It("Should set leaderboard expiration if GetLeaderboardExpiration return TTLNotFoundError", func() {
+	It("Should upsert with leaderboard expiration if leaderboard is formatted to have an expiration", func() {
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
+		mock.EXPECT().UpsertMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
+			gomock.Eq(databaseMembersToInsert),
+			gomock.Eq(&database.UpsertOptions{Order: "desc", ExpireAt: time.Unix(expireAt, 0)}),
 		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
-
-		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(nil)
 
-		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
-	It("Should return error LeaderboardExpiredError if leaderboard was already expired", func() {
+	It("Should return error LeaderboardExpiredError without writing if leaderboard was already expired", func() {
 		leaderboardExpiration := fmt.Sprintf(
 			"testkey-from%dto%d",
 			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
 			time.Now().UTC().Add(time.Duration(-1)*time.Second).Unix(),
 		)
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-		).Return(databaseMembersReturned, nil)
-
-		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, "")
 		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboardExpiration)))
+	})
 
+	It("Should return error SeasonNotStartedError without writing if leaderboard season hasn't started", func() {
+		start := time.Now().UTC().Add(time.Hour)
+		leaderboardSeason := fmt.Sprintf("testkey-from%dto%d", start.Unix(), start.Add(time.Hour).Unix())
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboardSeason, member, score, previousRank, scoreTTL, "")
+		Expect(err).To(MatchError(service.NewSeasonNotStartedError(leaderboardSeason, time.Unix(start.Unix(), 0).UTC())))
 	})
 
-	It("Should return error if database GetLeaderboardExpiration return in error", func() {
-		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
+	It("Should return error SeasonClosedError without writing if leaderboard season has ended", func() {
+		end := time.Now().UTC().Add(-time.Hour)
+		leaderboardSeason := fmt.Sprintf("testkey-from%dto%d", end.Add(-2*time.Hour).Unix(), end.Unix())
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboardSeason, member, score, previousRank, scoreTTL, "")
+		Expect(err).To(MatchError(service.NewSeasonClosedError(leaderboardSeason, time.Unix(end.Unix(), 0).UTC())))
+	})
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
+	It("Should upsert with leaderboard season policy expiration", func() {
+		leaderboardExpiration := fmt.Sprintf("testkey-year%d", time.Now().UTC().Year())
+		policy := expiration.Policy{Retention: expiration.Retention{Grace: time.Hour}}
+		svc = service.NewService(mock, service.WithSeasonPolicies(func(string) expiration.Policy {
+			return policy
+		}))
+		expireAt, err := policy.GetExpireAt(leaderboardExpiration)
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().UpsertMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
+			gomock.Eq(databaseMembersToInsert),
+			gomock.Eq(&database.UpsertOptions{Order: "desc", ExpireAt: time.Unix(expireAt, 0)}),
 		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), fmt.Errorf("New database error"))
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
+		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, "")
+		Expect(err).NotTo(HaveOccurred())
 	})
 
-	It("Should return error if database SetLeaderboardExpiration return in error", func() {
-		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
-		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
+	It("Should upsert with leaderboard season end if leaderboard is archived", func() {
+		leaderboardSeason := fmt.Sprintf("testkey-year%d", time.Now().UTC().Year())
+		svc = service.NewService(mock, service.WithArchive(database.NewMockArchive(ctrl), func(string) bool {
+			return true
+		}))
+		expireAt, err := expiration.GetExpireAt(leaderboardSeason)
+		Expect(err).NotTo(HaveOccurred())
+		season, err := expiration.GetSeason(leaderboardSeason)
 		Expect(err).NotTo(HaveOccurred())
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
+		mock.EXPECT().UpsertMembers(
 			gomock.Any(),
-			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
+			gomock.Eq(leaderboardSeason),
+			gomock.Eq(databaseMembersToInsert),
+			gomock.Eq(&database.UpsertOptions{Order: "desc", ExpireAt: time.Unix(expireAt, 0), SeasonEnd: season.End}),
 		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
-
-		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(fmt.Errorf("New database error"))
 
-		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
+		_, err = svc.SetMemberScore(context.Background(), leaderboardSeason, member, score, previousRank, scoreTTL, "")
+		Expect(err).NotTo(HaveOccurred())
 	})
 
+	Describe("When leaderboard is registered", func() {
+		var registry *database.MockRegistry
+
+		BeforeEach(func() {
+			registry = database.NewMockRegistry(ctrl)
+			svc = service.NewService(mock, service.WithRegistry(registry), service.WithUpdatePolicies(func(string) string {
+				return database.UpdatePolicySum
+			}))
+		})
+
+		It("Should return error SeasonClosedError without writing if leaderboard end time has passed", func() {
+			endTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+			registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardDefinition{
+				ID:        leaderboard,
+				EndTime:   endTime,
+				Retention: time.Hour,
+			}, nil)
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
+			Expect(err).To(MatchError(service.NewSeasonClosedError(leaderboard, endTime)))
+		})
+
+		It("Should upsert with leaderboard order, update policy and end time plus retention", func() {
+			endTime := time.Now().Add(time.Hour).Truncate(time.Second)
+			registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardDefinition{
+				ID:           leaderboard,
+				Order:        "asc",
+				UpdatePolicy: database.UpdatePolicyBest,
+				EndTime:      endTime,
+				Retention:    time.Hour,
+			}, nil)
+
+			mock.EXPECT().UpsertMembers(
+				gomock.Any(),
+				gomock.Eq(leaderboard),
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "asc", UpdatePolicy: database.UpdatePolicyBest, ExpireAt: endTime.Add(time.Hour)}),
+			).Return(databaseMembersReturned, nil)
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should prefer request update policy and use configured one for leaderboards without it", func() {
+			registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+				Return(nil, database.NewLeaderboardDefinitionNotFoundError(leaderboard)).Times(2)
+
+			mock.EXPECT().UpsertMembers(
+				gomock.Any(),
+				gomock.Eq(leaderboard),
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicyWorst}),
+			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().UpsertMembers(
+				gomock.Any(),
+				gomock.Eq(leaderboard),
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", UpdatePolicy: database.UpdatePolicySum, SumRange: &database.ScoreRange{Min: -service.MaxExactScore, Max: service.MaxExactScore}}),
+			).Return(databaseMembersReturned, nil)
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, database.UpdatePolicyWorst)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return error LeaderboardExpiredError if leaderboard retention is over", func() {
+			registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.LeaderboardDefinition{
+				ID:        leaderboard,
+				EndTime:   time.Now().Add(-2 * time.Hour),
+				Retention: time.Hour,
+			}, nil)
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, "")
+			Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboard)))
+		})
+	})
 })
@@ -3,46 +3,21 @@ package service
 import (
 	"context"
 
-	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
 
 const setMembersScoreServiceLabel = "set members score"
 
-const setMembersOrder = "desc"
-
-// SetMembersScore return member informations that is
-func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string) error {
-	if prevRank {
-		err := s.setMembersPreviousRank(ctx, leaderboard, members, setMembersOrder)
-		if err != nil {
-			return NewGeneralError(setMembersScoreServiceLabel, err.Error())
-		}
-	}
-
-	err := s.persistMembers(ctx, leaderboard, members)
-	if err != nil {
-		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
-	}
-
-	err = s.setMembersValues(ctx, leaderboard, members, setMembersOrder)
+// SetMembersScore write members score following update policy and fill members informations
+func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL, updatePolicy string) error {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
 	if err != nil {
-		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+		return err
 	}
 
-	err = s.persistLeaderboardExpirationTime(ctx, leaderboard)
+	err = s.upsertMembers(ctx, leaderboard, members, updatePolicy, prevRank, scoreTTL)
 	if err != nil {
-		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
-			return NewLeaderboardExpiredError(leaderboard)
-		}
-		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
-	}
-
-	if scoreTTL != "" {
-		err = s.persistMembersTTL(ctx, leaderboard, members, scoreTTL)
-		if err != nil {
-			return NewGeneralError(setMembersScoreServiceLabel, err.Error())
-		}
+		return writeError(setMembersScoreServiceLabel, leaderboard, err)
 	}
 
 	return nil
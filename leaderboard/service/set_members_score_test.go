@@ -34,42 +34,39 @@ var _ = Describe("Service SetMembersScore", func() {
 		},
 	}
 
-	databaseMembersToGetRank := []string{
-		"member1",
-		"member2",
-	}
-
-	databaseMembersPreviousRankReturned := []*database.Member{
+	databaseMembersReturned := []*database.Member{
 		{
-			Member: "member1",
-			Score:  2.0,
-			Rank:   int64(0),
+			Member:       "member1",
+			Score:        1.0,
+			Rank:         int64(1),
+			PreviousRank: int64(0),
 		},
 		{
-			Member: "member2",
-			Score:  1.0,
-			Rank:   int64(1),
+			Member:       "member2",
+			Score:        2.0,
+			Rank:         int64(0),
+			PreviousRank: int64(-1),
 		},
 	}
 
-	databaseMembersReturned := []*database.Member{
-		{
-			Member: "member1",
-			Score:  1.0,
-			Rank:   int64(1),
-		},
-		{
-			Member: "member2",
-			Score:  2.0,
-			Rank:   int64(0),
-		},
+	newMembers := func() []*model.Member {
+		return []*model.Member{
+			{
+				PublicID: "member1",
+				Score:    1,
+			},
+			{
+				PublicID: "member2",
+				Score:    2,
+			},
+		}
 	}
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -78,17 +75,6 @@ var _ = Describe("Service SetMembersScore", func() {
 
 	Describe("When previousRank is false", func() {
 		It("Should set Members with previousRank equals zero", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
-
 			expectedMembers := []*model.Member{
 				{
 					PublicID:     "member1",
@@ -104,17 +90,15 @@ var _ = Describe("Service SetMembersScore", func() {
 				},
 			}
 
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc"}),
+			).Times(1).Return(databaseMembersReturned, nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			members := newMembers()
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
@@ -124,18 +108,7 @@ var _ = Describe("Service SetMembersScore", func() {
 	Describe("When previousRank is true", func() {
 		previousRank := true
 
-		It("Should set Members with previousRank", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
-
+		It("Should set Members with previousRank and -1 for non existent members", func() {
 			expectedMembers := []*model.Member{
 				{
 					PublicID:     "member1",
@@ -146,445 +119,165 @@ var _ = Describe("Service SetMembersScore", func() {
 				{
 					PublicID:     "member2",
 					Score:        2,
-					PreviousRank: 2,
+					PreviousRank: -1,
 					Rank:         1,
 				},
 			}
 
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Times(1).Return(databaseMembersPreviousRankReturned, nil)
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", PreviousRank: true}),
+			).Times(1).Return(databaseMembersReturned, nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			members := newMembers()
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
 		})
+	})
 
-		It("Should set a non existent member as rank equals to -1", func() {
-			databaseMembersPreviousRankReturned := []*database.Member{
-				nil,
-				{
-					Member: "member2",
-					Score:  1.0,
-					Rank:   int64(1),
-				},
-			}
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
-
-			expectedMembers := []*model.Member{
-				{
-					PublicID:     "member1",
-					Score:        1,
-					PreviousRank: -1,
-					Rank:         2,
-				},
-				{
-					PublicID:     "member2",
-					Score:        2,
-					PreviousRank: 2,
-					Rank:         1,
-				},
-			}
-
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Times(1).Return(databaseMembersPreviousRankReturned, nil)
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
+	Describe("When scoreTTL is set", func() {
+		scoreTTL := "100"
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+		It("Should upsert members with TTL", func() {
+			mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+					Expect(options.TTL.Unix()).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
+					membersWithTTL := make([]*database.Member, 0, len(databaseMembersReturned))
+					for _, member := range databaseMembersReturned {
+						memberWithTTL := *member
+						memberWithTTL.TTL = options.TTL
+						membersWithTTL = append(membersWithTTL, &memberWithTTL)
+					}
+					return membersWithTTL, nil
+				})
+
+			members := newMembers()
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(members).To(Equal(expectedMembers))
-		})
-
-		It("Should return error if GetMembers return in error", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
+			for _, member := range members {
+				Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
 			}
-
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Times(1).Return(nil, fmt.Errorf("New database error"))
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
-			Expect(err).To(Equal(service.NewGeneralError("set members score", "New database error")))
 		})
 	})
 
-	Describe("When scoreTTL is empty", func() {
-		It("Should SetMembers without filling expire ordered set", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
-
-			expectedMembers := []*model.Member{
-				{
-					PublicID:     "member1",
-					Score:        1,
-					PreviousRank: 0,
-					Rank:         2,
-				},
-				{
-					PublicID:     "member2",
-					Score:        2,
-					PreviousRank: 0,
-					Rank:         1,
-				},
-			}
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
-
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
-			Expect(err).NotTo(HaveOccurred())
+	Describe("When scoreTTL is invalid", func() {
+		scoreTTL := "invalid"
 
-			Expect(members).To(Equal(expectedMembers))
+		It("Should return error without writing to database", func() {
+			err := svc.SetMembersScore(context.Background(), leaderboard, newMembers(), previousRank, scoreTTL, "")
+			Expect(err).To(MatchError(service.NewGeneralError("set members score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
 		})
 	})
 
-	Describe("When scoreTTL is set", func() {
-		scoreTTL := "100"
+	Describe("When members have preconditions", func() {
+		expectedScore := 1.0
 
-		It("Should SetMembers filling expire ordered set", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
+		newMembersWithPreconditions := func() []*model.Member {
+			members := newMembers()
+			members[0].Precondition = &model.Precondition{ExpectedScore: &expectedScore}
+			members[1].Precondition = &model.Precondition{IfAbsent: true}
+			return members
+		}
 
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
+		It("Should upsert members with their preconditions", func() {
+			mock.EXPECT().UpsertMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
+				gomock.Eq(databaseMembersToInsert),
+				gomock.Eq(&database.UpsertOptions{Order: "desc", Preconditions: map[string]*database.Precondition{
+					"member1": {ExpectedScore: &expectedScore},
+					"member2": {Absent: true},
+				}}),
 			).Return(databaseMembersReturned, nil)
 
-			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Times(1).Return(nil)
-
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, newMembersWithPreconditions(), previousRank, scoreTTL, "")
 			Expect(err).NotTo(HaveOccurred())
-
-			Expect(members[0].ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
-			Expect(members[1].ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
 		})
-	})
 
-	Describe("When scoreTTL is invalid", func() {
-		scoreTTL := "invalid"
+		It("Should return PreconditionFailedError with the current score if a precondition fails", func() {
+			currentScore := 5.0
+			mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				Return(nil, database.NewPreconditionFailedError(leaderboard, "member1", &currentScore))
 
-		It("Should return error", func() {
-			members := []*model.Member{
-				{
-					PublicID: "member1",
-					Score:    1,
-				},
-				{
-					PublicID: "member2",
-					Score:    2,
-				},
-			}
-
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
+			err := svc.SetMembersScore(context.Background(), leaderboard, newMembersWithPreconditions(), previousRank, scoreTTL, "")
+			Expect(err).To(MatchError(service.NewPreconditionFailedError("member1", &currentScore)))
+			Expect(err.Error()).To(ContainSubstring("current score is 5"))
+		})
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
-			Expect(err).To(MatchError(service.NewGeneralError("set members score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
+		It("Should return PreconditionFailedError if a member is absent", func() {
+			mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				Return(nil, database.NewPreconditionFailedError(leaderboard, "member1", nil))
 
+			err := svc.SetMembersScore(context.Background(), leaderboard, newMembersWithPreconditions(), previousRank, scoreTTL, "")
+			Expect(err).To(MatchError(service.NewPreconditionFailedError("member1", nil)))
 		})
-	})
 
-	It("Should return error if database SetMembers return in error", func() {
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
+		It("Should return InvalidPreconditionError without writing if a precondition has more than one condition", func() {
+			members := newMembers()
+			members[0].Precondition = &model.Precondition{IfAbsent: true, IfPresent: true}
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(fmt.Errorf("New database error"))
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, "")
+			Expect(err).To(BeAssignableToTypeOf(&service.InvalidPreconditionError{}))
+		})
 
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
+		It("Should encode the expected score with the leaderboard score type", func() {
+			svc = service.NewService(mock, service.WithScoreTypes(func(string) *service.ScoreType {
+				return &service.ScoreType{Type: service.ScoreTypeDecimal, Digits: 2}
+			}))
+			expectedScore := 1.25
+			storedExpectedScore := 125.0
+			storedCurrentScore := 250.0
+
+			mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+					Expect(options.Preconditions).To(Equal(map[string]*database.Precondition{
+						"member1": {ExpectedScore: &storedExpectedScore},
+					}))
+					return nil, database.NewPreconditionFailedError(leaderboard, "member1", &storedCurrentScore)
+				})
+
+			members := []*model.Member{{PublicID: "member1", Score: 2, Precondition: &model.Precondition{ExpectedScore: &expectedScore}}}
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, "")
+			Expect(err).To(MatchError("precondition of member member1 failed: current score is 2.5"))
+		})
 	})
 
-	It("Should return error if database GetMembers return in error", func() {
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
+	It("Should return error if database UpsertMembers return in error", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).Return(nil, fmt.Errorf("New database error"))
 
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboard),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
-		).Return(nil, fmt.Errorf("New database error"))
-
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboard, newMembers(), previousRank, scoreTTL, "")
 		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
-
 	})
 
-	It("Should not call database GetLeaderboardExpiration and SetLeaderboardExpiration if leaderboard isn't formatted to have an expiration", func() {
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
-
-		expectedMembers := []*model.Member{
-			{
-				PublicID:     "member1",
-				Score:        1,
-				PreviousRank: 0,
-				Rank:         2,
-			},
-			{
-				PublicID:     "member2",
-				Score:        2,
-				PreviousRank: 0,
-				Rank:         1,
-			},
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboard),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
-		).Return(databaseMembersReturned, nil)
-
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
-		Expect(err).NotTo(HaveOccurred())
-
-		Expect(members).To(Equal(expectedMembers))
-	})
-
-	It("Should set leaderboard expiration if GetLeaderboardExpiration return TTLNotFoundError", func() {
+	It("Should upsert with leaderboard expiration if leaderboard is formatted to have an expiration", func() {
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
 
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
+		mock.EXPECT().UpsertMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
+			gomock.Eq(databaseMembersToInsert),
+			gomock.Eq(&database.UpsertOptions{Order: "desc", ExpireAt: time.Unix(expireAt, 0)}),
 		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
-
-		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(nil)
 
-		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, newMembers(), previousRank, scoreTTL, "")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
-	It("Should return error LeaderboardExpiredError if leaderboard is expired", func() {
+	It("Should return error LeaderboardExpiredError without writing if leaderboard is expired", func() {
 		leaderboardExpiration := fmt.Sprintf(
 			"testkey-from%dto%d",
 			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
 			time.Now().UTC().Add(time.Duration(-1)*time.Second).Unix(),
 		)
 
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
-		).Return(databaseMembersReturned, nil)
-
-		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, newMembers(), previousRank, scoreTTL, "")
 		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboardExpiration)))
 	})
-
-	It("Should return error if database GetLeaderboardExpiration return in error", func() {
-		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
-
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
-		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), fmt.Errorf("New database error"))
-
-		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
-	})
-
-	It("Should return error if database SetLeaderboardExpiration return in error", func() {
-		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
-		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
-		Expect(err).NotTo(HaveOccurred())
-
-		members := []*model.Member{
-			{
-				PublicID: "member1",
-				Score:    1,
-			},
-			{
-				PublicID: "member2",
-				Score:    2,
-			},
-		}
-
-		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
-		mock.EXPECT().GetMembers(
-			gomock.Any(),
-			gomock.Eq(leaderboardExpiration),
-			gomock.Eq("desc"),
-			gomock.Eq(true),
-			gomock.Eq(databaseMembersToGetRank[0]),
-			gomock.Eq(databaseMembersToGetRank[1]),
-		).Return(databaseMembersReturned, nil)
-		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
-
-		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(fmt.Errorf("New database error"))
-
-		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
-		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
-	})
-
 })
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Operations a signed submission can be made for, so a signed increment can't be replayed as a set
+const (
+	// SubmissionOperationSet signs scores written as they are
+	SubmissionOperationSet = "set"
+	// SubmissionOperationIncrement signs increments of member scores
+	SubmissionOperationIncrement = "increment"
+)
+
+// SubmissionSigning is how the score submissions of a tenant are signed, Required refuses unsigned submissions
+// otherwise only signed ones are verified, a tenant without Key can't sign its submissions
+type SubmissionSigning struct {
+	Key      string
+	Required bool
+}
+
+// CanonicalSubmission return the payload signed by a submission, one field per line: operation, leaderboard,
+// timestamp, nonce, update policy, score TTL, previous rank, idempotency key and then the public ID, score,
+// comma separated composite scores and precondition of each member in submission order, numbers are written
+// in decimal notation without exponent or trailing zeros
+func CanonicalSubmission(submission *model.SignedSubmission) string {
+	lines := []string{
+		submission.Operation,
+		submission.Leaderboard,
+		strconv.FormatInt(submission.Timestamp, 10),
+		submission.Nonce,
+		submission.UpdatePolicy,
+		strconv.FormatInt(submission.ScoreTTL, 10),
+		strconv.FormatBool(submission.PrevRank),
+		submission.IdempotencyKey,
+	}
+	for _, member := range submission.Members {
+		scores := make([]string, 0, len(member.Scores))
+		for _, score := range member.Scores {
+			scores = append(scores, strconv.FormatInt(score, 10))
+		}
+		lines = append(lines, member.PublicID, strconv.FormatFloat(member.Score, 'f', -1, 64), strings.Join(scores, ","),
+			canonicalPrecondition(member.Precondition))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// canonicalPrecondition return how a member precondition is signed: "absent", "present", the expected score
+// or an empty line if it has none
+func canonicalPrecondition(precondition *model.Precondition) string {
+	switch {
+	case precondition == nil:
+		return ""
+	case precondition.IfAbsent:
+		return "absent"
+	case precondition.IfPresent:
+		return "present"
+	case precondition.ExpectedScore != nil:
+		return strconv.FormatFloat(*precondition.ExpectedScore, 'f', -1, 64)
+	}
+	return ""
+}
+
+// SignSubmission return the hex encoded HMAC-SHA256 of submission canonical payload with key
+func SignSubmission(key string, submission *model.SignedSubmission) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(CanonicalSubmission(submission)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getSubmissionSigning return how submissions of tenant are signed, nil if they aren't
+func (s *Service) getSubmissionSigning(tenant string) *SubmissionSigning {
+	if s.nonces == nil || s.submissionSigning == nil {
+		return nil
+	}
+	return s.submissionSigning(tenant)
+}
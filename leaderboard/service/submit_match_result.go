@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+)
+
+const submitMatchResultServiceLabel = "submit match result"
+
+// SubmitMatchResult rate the members of a match by how they finished and write their rounded rating as
+// their score, ratings and scores of all members are written at once
+func (s *Service) SubmitMatchResult(ctx context.Context, leaderboard string, results []*model.MatchResult) ([]*model.MemberRating, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := s.getRatingSettings(leaderboard)
+	if settings == nil || s.getLeague(leaderboard) != nil {
+		return nil, NewLeaderboardNotRatedError(leaderboard)
+	}
+
+	placements, err := matchPlacements(results)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.ratingOptions(ctx, leaderboard)
+	if err != nil {
+		return nil, writeError(submitMatchResultServiceLabel, leaderboard, err)
+	}
+
+	members := make([]string, 0, len(results))
+	for _, result := range results {
+		members = append(members, result.PublicID)
+	}
+
+	for attempt := 0; attempt < submitMatchResultMaxAttempts; attempt++ {
+		memberRatings, err := s.rateMatch(ctx, leaderboard, *settings, members, placements, options)
+		if err == nil {
+			return memberRatings, nil
+		}
+		if _, ok := err.(*database.RatingConflictError); ok {
+			continue
+		}
+		if _, ok := err.(*rating.InvalidMatchError); ok {
+			return nil, NewInvalidMatchResultError(err.Error())
+		}
+		return nil, NewGeneralError(submitMatchResultServiceLabel, err.Error())
+	}
+
+	return nil, NewRatingConflictError(leaderboard)
+}
+
+// ratingOptions return the options ratings of leaderboard are written with, it fails if leaderboard season
+// isn't open
+func (s *Service) ratingOptions(ctx context.Context, leaderboard string) (*database.RatingOptions, error) {
+	definition, expireAt, seasonEnd, err := s.openSeasonWrite(ctx, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database.RatingOptions{
+		Order:             definitionOrder(definition, ""),
+		ExpireAt:          expireAt,
+		SeasonEnd:         seasonEnd,
+		IdempotencyKey:    s.idempotencyKey(ctx),
+		IdempotencyWindow: s.idempotencyWindow,
+	}, nil
+}
+
+// rateMatch read members current rating, rate the match and write the new ratings, it returns
+// database.RatingConflictError if any member rating changed since it was read
+func (s *Service) rateMatch(ctx context.Context, leaderboard string, settings rating.Settings, members []string, placements []int, options *database.RatingOptions) ([]*model.MemberRating, error) {
+	storedRatings, err := s.ratings.GetRatings(ctx, leaderboard, members...)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]rating.Rating, 0, len(members))
+	matches := make([]int, 0, len(members))
+	for _, member := range members {
+		stored, ok := storedRatings[member]
+		if !ok {
+			ratings = append(ratings, rating.Initial(settings))
+			matches = append(matches, 0)
+			continue
+		}
+		ratings = append(ratings, rating.Rating{
+			Rating:     stored.Rating,
+			Deviation:  stored.Deviation,
+			Volatility: stored.Volatility,
+		})
+		matches = append(matches, stored.Matches)
+	}
+
+	updatedRatings, err := rating.Update(settings, ratings, placements)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*database.RatingUpdate, 0, len(members))
+	for i, member := range members {
+		updates = append(updates, &database.RatingUpdate{
+			Member: member,
+			Rating: &database.Rating{
+				Rating:     updatedRatings[i].Rating,
+				Deviation:  updatedRatings[i].Deviation,
+				Volatility: updatedRatings[i].Volatility,
+				Matches:    matches[i] + 1,
+			},
+			Score: math.Round(updatedRatings[i].Rating),
+		})
+	}
+
+	writtenMembers, err := s.ratings.WriteRatings(ctx, leaderboard, updates, options)
+	if err != nil {
+		return nil, err
+	}
+
+	memberRatings := make([]*model.MemberRating, 0, len(writtenMembers))
+	for _, member := range writtenMembers {
+		memberRating := &model.MemberRating{
+			PublicID:       member.Member.Member,
+			Score:          int64(member.Member.Score),
+			Rank:           int(member.Member.Rank + 1),
+			PreviousRank:   -1,
+			Rating:         member.Rating.Rating,
+			PreviousRating: rating.Initial(settings).Rating,
+			Deviation:      member.Rating.Deviation,
+			Volatility:     member.Rating.Volatility,
+			Matches:        member.Rating.Matches,
+		}
+		if member.PreviousRating != nil {
+			memberRating.PreviousRating = member.PreviousRating.Rating
+		}
+		if member.Member.PreviousRank >= 0 {
+			memberRating.PreviousRank = int(member.Member.PreviousRank + 1)
+		}
+		memberRatings = append(memberRatings, memberRating)
+	}
+
+	return memberRatings, nil
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/rating"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service SubmitMatchResult", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var ratingsMock *database.MockRatings
+	var svc *service.Service
+
+	var leaderboard string = "ranked"
+	var settings = rating.WithDefaults(rating.Settings{Algorithm: rating.AlgorithmElo})
+	var options = &database.RatingOptions{Order: "desc"}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		ratingsMock = database.NewMockRatings(ctrl)
+
+		svc = service.NewService(mock, service.WithRatings(ratingsMock, func(id string) *rating.Settings {
+			if id == leaderboard {
+				return &settings
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should rate members and write their rounded rating as score", func() {
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(map[string]*database.Rating{
+			"member2": {Rating: 1500, Matches: 3},
+		}, nil)
+		ratingsMock.EXPECT().WriteRatings(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq([]*database.RatingUpdate{
+			{Member: "member1", Rating: &database.Rating{Rating: 1516, Matches: 1}, Score: 1516},
+			{Member: "member2", Rating: &database.Rating{Rating: 1484, Matches: 4}, Score: 1484},
+		}), gomock.Eq(options)).Return([]*database.RatedMember{
+			{
+				Member: &database.Member{Member: "member1", Score: 1516, Rank: 0, PreviousRank: -1},
+				Rating: &database.Rating{Rating: 1516, Matches: 1},
+			},
+			{
+				Member:         &database.Member{Member: "member2", Score: 1484, Rank: 1, PreviousRank: 0},
+				PreviousRating: &database.Rating{Rating: 1500, Matches: 3},
+				Rating:         &database.Rating{Rating: 1484, Matches: 4},
+			},
+		}, nil)
+
+		memberRatings, err := svc.SubmitMatchResult(context.Background(), leaderboard, []*model.MatchResult{
+			{PublicID: "member1", Outcome: service.MatchOutcomeWin},
+			{PublicID: "member2", Outcome: service.MatchOutcomeLoss},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(memberRatings).To(Equal([]*model.MemberRating{
+			{PublicID: "member1", Score: 1516, Rank: 1, PreviousRank: -1, Rating: 1516, PreviousRating: 1500, Matches: 1},
+			{PublicID: "member2", Score: 1484, Rank: 2, PreviousRank: 1, Rating: 1484, PreviousRating: 1500, Matches: 4},
+		}))
+	})
+
+	It("Should return error InvalidScoresError if scores are written to a rating leaderboard", func() {
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 1, false, "", "")
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidScoresError{}))
+
+		_, err = svc.IncrementMemberScore(context.Background(), leaderboard, "member1", 1, "")
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidScoresError{}))
+	})
+
+	It("Should read ratings again if they changed before being written", func() {
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(map[string]*database.Rating{}, nil)
+		ratingsMock.EXPECT().WriteRatings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(nil, database.NewRatingConflictError(leaderboard))
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(map[string]*database.Rating{
+			"member1": {Rating: 1516, Matches: 1},
+			"member2": {Rating: 1484, Matches: 1},
+		}, nil)
+		ratingsMock.EXPECT().WriteRatings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return([]*database.RatedMember{
+			{
+				Member:         &database.Member{Member: "member1", Score: 1516, Rank: 0, PreviousRank: 0},
+				PreviousRating: &database.Rating{Rating: 1516, Matches: 1},
+				Rating:         &database.Rating{Rating: 1516, Matches: 2},
+			},
+			{
+				Member:         &database.Member{Member: "member2", Score: 1484, Rank: 1, PreviousRank: 1},
+				PreviousRating: &database.Rating{Rating: 1484, Matches: 1},
+				Rating:         &database.Rating{Rating: 1484, Matches: 2},
+			},
+		}, nil)
+
+		memberRatings, err := svc.SubmitMatchResult(context.Background(), leaderboard, []*model.MatchResult{
+			{PublicID: "member1", Placement: 1},
+			{PublicID: "member2", Placement: 1},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(memberRatings[0].Matches).To(Equal(2))
+		Expect(memberRatings[0].PreviousRating).To(Equal(1516.0))
+	})
+
+	It("Should write ratings with the idempotency key of the context", func() {
+		svc = service.NewService(mock, service.WithRatings(ratingsMock, func(id string) *rating.Settings {
+			return &settings
+		}), service.WithIdempotencyWindow(time.Hour))
+
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(map[string]*database.Rating{}, nil)
+		ratingsMock.EXPECT().WriteRatings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(&database.RatingOptions{
+			Order:             "desc",
+			IdempotencyKey:    "match-1",
+			IdempotencyWindow: time.Hour,
+		})).Return([]*database.RatedMember{
+			{
+				Member: &database.Member{Member: "member1", Score: 1516, Rank: 0, PreviousRank: -1},
+				Rating: &database.Rating{Rating: 1516, Matches: 1},
+			},
+			{
+				Member: &database.Member{Member: "member2", Score: 1484, Rank: 1, PreviousRank: -1},
+				Rating: &database.Rating{Rating: 1484, Matches: 1},
+			},
+		}, nil)
+
+		ctx := service.ContextWithIdempotencyKey(context.Background(), "match-1")
+		memberRatings, err := svc.SubmitMatchResult(ctx, leaderboard, []*model.MatchResult{
+			{PublicID: "member1", Outcome: service.MatchOutcomeWin},
+			{PublicID: "member2", Outcome: service.MatchOutcomeLoss},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(memberRatings[1].Rating).To(Equal(1484.0))
+		Expect(memberRatings[1].PreviousRating).To(Equal(1500.0))
+	})
+
+	It("Should return error RatingConflictError if ratings keep changing", func() {
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(map[string]*database.Rating{}, nil).Times(5)
+		ratingsMock.EXPECT().WriteRatings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(nil, database.NewRatingConflictError(leaderboard)).Times(5)
+
+		_, err := svc.SubmitMatchResult(context.Background(), leaderboard, []*model.MatchResult{
+			{PublicID: "member1", Outcome: service.MatchOutcomeDraw},
+			{PublicID: "member2", Outcome: service.MatchOutcomeDraw},
+		})
+		Expect(err).To(MatchError(service.NewRatingConflictError(leaderboard)))
+	})
+
+	It("Should return error InvalidMatchResultError if match results are invalid", func() {
+		for _, results := range [][]*model.MatchResult{
+			{{PublicID: "member1", Placement: 1}},
+			{{PublicID: "member1", Placement: 1}, {PublicID: "member1", Placement: 2}},
+			{{PublicID: "member1", Placement: 1}, {PublicID: "member2", Placement: 0}},
+			{{PublicID: "member1", Placement: 1, Outcome: service.MatchOutcomeWin}, {PublicID: "member2", Placement: 2}},
+			{{PublicID: "member1", Outcome: "forfeit"}, {PublicID: "member2", Outcome: service.MatchOutcomeWin}},
+		} {
+			_, err := svc.SubmitMatchResult(context.Background(), leaderboard, results)
+			Expect(err).To(BeAssignableToTypeOf(&service.InvalidMatchResultError{}))
+		}
+	})
+
+	It("Should return error LeaderboardNotRatedError if leaderboard has no rating settings", func() {
+		_, err := svc.SubmitMatchResult(context.Background(), "casual", []*model.MatchResult{
+			{PublicID: "member1", Outcome: service.MatchOutcomeWin},
+			{PublicID: "member2", Outcome: service.MatchOutcomeLoss},
+		})
+		Expect(err).To(MatchError(service.NewLeaderboardNotRatedError("casual")))
+	})
+
+	It("Should return error if database return in error", func() {
+		ratingsMock.EXPECT().GetRatings(gomock.Any(), gomock.Eq(leaderboard), "member1", "member2").Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.SubmitMatchResult(context.Background(), leaderboard, []*model.MatchResult{
+			{PublicID: "member1", Outcome: service.MatchOutcomeWin},
+			{PublicID: "member2", Outcome: service.MatchOutcomeLoss},
+		})
+		Expect(err).To(MatchError(service.NewGeneralError("submit match result", "database error: unknown error")))
+	})
+})
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"math"
+	"math/bits"
+	"time"
+)
+
+// Tie-break defaults used by NewTieBreak
+var (
+	// DefaultTieBreakEpoch is when submission ticks start to be counted
+	DefaultTieBreakEpoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// DefaultTieBreakResolution is the duration of a submission tick
+	DefaultTieBreakResolution = time.Second
+	// DefaultTieBreakBits is how many bits of the stored score hold the submission tick when the score range
+	// isn't known, with one second ticks it covers until 2156 and leaves scores between -2^21 and 2^21-1
+	DefaultTieBreakBits uint = 32
+)
+
+// maxStoredScoreBits is how many bits a float64 holds without losing integer precision
+const maxStoredScoreBits = 53
+
+// TieBreak orders members with equal scores by submission time, whoever reached the score first ranks higher.
+// Scores are stored as score*2^Bits plus the submission tick, so database ranges and ranks honour it
+type TieBreak struct {
+	// Epoch is when submission ticks start to be counted, earlier submissions are tied at tick 0
+	Epoch time.Time
+	// Resolution is the duration of a submission tick, submissions within the same tick stay tied
+	Resolution time.Duration
+	// Bits is how many low bits of the stored score hold the submission tick, between 1 and 52
+	Bits uint
+	// Order is the leaderboard order, asc or desc, earlier submissions rank higher in it
+	Order string
+}
+
+// NewTieBreak create a new TieBreak with default epoch, resolution and bits
+func NewTieBreak(order string) *TieBreak {
+	return &TieBreak{
+		Epoch:      DefaultTieBreakEpoch,
+		Resolution: DefaultTieBreakResolution,
+		Bits:       DefaultTieBreakBits,
+		Order:      order,
+	}
+}
+
+// TieBreakBitsFor returns the most bits a tie-break can take leaving room for scores from -maxScore to maxScore,
+// zero if scores that large can't have a tie-break
+func TieBreakBitsFor(maxScore int64) uint {
+	scoreBits := uint(bits.Len64(uint64(maxScore)))
+	if scoreBits >= maxStoredScoreBits {
+		return 0
+	}
+	if scoreBits == 0 {
+		scoreBits = 1
+	}
+	return maxStoredScoreBits - scoreBits
+}
+
+// Scale is the factor scores are multiplied by when stored
+func (t *TieBreak) Scale() int64 {
+	return int64(1) << t.Bits
+}
+
+// ScoreRange returns the minimum and maximum scores that can be stored without losing precision
+func (t *TieBreak) ScoreRange() (int64, int64) {
+	limit := int64(1) << (maxStoredScoreBits - t.Bits)
+	return -limit, limit - 1
+}
+
+// EncodeScore returns the stored score of a score submitted at submittedAt
+func (t *TieBreak) EncodeScore(score int64, submittedAt time.Time) (float64, error) {
+	min, max := t.ScoreRange()
+	if score < min || score > max {
+		return 0, NewScoreOutOfRangeError(float64(score), float64(min), float64(max))
+	}
+
+	scale := t.Scale()
+	ticks := int64(submittedAt.Sub(t.Epoch) / t.Resolution)
+	if ticks < 0 {
+		ticks = 0
+	}
+	if ticks >= scale {
+		ticks = scale - 1
+	}
+
+	tie := ticks
+	if t.Order != "asc" {
+		tie = scale - 1 - ticks
+	}
+
+	return float64(score*scale + tie), nil
+}
+
+// DecodeScore returns the submitted score of a stored score
+func (t *TieBreak) DecodeScore(stored float64) int64 {
+	return int64(math.Floor(stored / float64(t.Scale())))
+}
+
+// MaxStoredScore returns the highest stored score a submitted score can have
+func (t *TieBreak) MaxStoredScore(score int64) float64 {
+	scale := float64(t.Scale())
+	return float64(score)*scale + scale - 1
+}
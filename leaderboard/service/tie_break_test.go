@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service TieBreak", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	tieBreak := &service.TieBreak{
+		Epoch:      time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Resolution: time.Second,
+		Bits:       32,
+		Order:      "desc",
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = service.NewService(mock, service.WithTieBreaks(func(leaderboardID string) *service.TieBreak {
+			if leaderboardID == leaderboard {
+				return tieBreak
+			}
+			return nil
+		}))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should rank earlier submissions higher in leaderboard order", func() {
+		first, err := tieBreak.EncodeScore(100, tieBreak.Epoch.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		second, err := tieBreak.EncodeScore(100, tieBreak.Epoch.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		higher, err := tieBreak.EncodeScore(101, tieBreak.Epoch.Add(24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(first).To(BeNumerically(">", second))
+		Expect(higher).To(BeNumerically(">", first))
+
+		ascTieBreak := *tieBreak
+		ascTieBreak.Order = "asc"
+		first, err = ascTieBreak.EncodeScore(-5, tieBreak.Epoch.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		second, err = ascTieBreak.EncodeScore(-5, tieBreak.Epoch.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(BeNumerically("<", second))
+	})
+
+	It("Should decode submitted scores", func() {
+		for _, score := range []int64{0, 1, -1, 2097151, -2097152} {
+			stored, err := tieBreak.EncodeScore(score, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tieBreak.DecodeScore(stored)).To(Equal(score))
+			Expect(stored).To(BeNumerically("<=", tieBreak.MaxStoredScore(score)))
+		}
+	})
+
+	It("Should return ScoreOutOfRangeError if score can't be stored with submission time", func() {
+		_, err := tieBreak.EncodeScore(2097152, time.Now())
+		Expect(err).To(MatchError(service.NewScoreOutOfRangeError(2097152, -2097152, 2097151)))
+
+		_, err = svc.SetMemberScore(context.Background(), leaderboard, "member1", -2097153, false, "", "")
+		Expect(err).To(MatchError(service.NewScoreOutOfRangeError(-2097153, -2097152, 2097151)))
+	})
+
+	It("Should take every bit scores up to a maximum leave", func() {
+		for _, test := range []struct {
+			maxScore int64
+			bits     uint
+		}{
+			{0, 52},
+			{1000, 43},
+			{2097151, 32},
+			{2097152, 31},
+			{15584657100002, 9},
+			{1 << 52, 0},
+		} {
+			bits := service.TieBreakBitsFor(test.maxScore)
+			Expect(bits).To(Equal(test.bits), "max score %d", test.maxScore)
+			if bits == 0 {
+				continue
+			}
+
+			min, max := (&service.TieBreak{Bits: bits}).ScoreRange()
+			Expect(min).To(BeNumerically("<=", -test.maxScore))
+			Expect(max).To(BeNumerically(">=", test.maxScore))
+		}
+	})
+
+	It("Should return ScoreOutOfRangeError if an increment results in a score that can't be stored", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.SumRange).To(Equal(&database.ScoreRange{Min: -2097152, Max: 2097151}))
+				return nil, database.NewSumOutOfRangeError(leaderboard, "member1", 2097160)
+			})
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member1", 10, "")
+		Expect(err).To(MatchError(service.NewScoreOutOfRangeError(2097160, -2097152, 2097151)))
+	})
+
+	It("Should store encoded scores and return submitted ones", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, members []*database.Member, options *database.UpsertOptions) ([]*database.Member, error) {
+				Expect(options.ScoreScale).To(Equal(float64(tieBreak.Scale())))
+				Expect(tieBreak.DecodeScore(members[0].Score)).To(Equal(int64(100)))
+				Expect(members[0].Score).NotTo(Equal(float64(100 * tieBreak.Scale())))
+				return []*database.Member{{Member: "member1", Score: members[0].Score, Rank: 0, PreviousRank: -1}}, nil
+			})
+
+		member, err := svc.SetMemberScore(context.Background(), leaderboard, "member1", 100, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(float64(100)))
+		Expect(member.Rank).To(Equal(1))
+	})
+
+	It("Should not encode scores of leaderboards without tie-break", func() {
+		mock.EXPECT().UpsertMembers(gomock.Any(), gomock.Eq("another"), gomock.Eq([]*database.Member{{Member: "member1", Score: 100}}), gomock.Eq(&database.UpsertOptions{Order: "desc"})).
+			Return([]*database.Member{{Member: "member1", Score: 100, Rank: 0, PreviousRank: -1}}, nil)
+
+		member, err := svc.SetMemberScore(context.Background(), "another", "member1", 100, false, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(float64(100)))
+	})
+
+	It("Should refuse rank modes that give tied members the same rank", func() {
+		_, err := svc.GetRank(context.Background(), leaderboard, "member1", "desc", service.RankModeDense)
+		Expect(err).To(MatchError(service.NewTieBreakRankModeError(leaderboard, service.RankModeDense)))
+
+		_, err = svc.GetLeaders(context.Background(), leaderboard, 10, 1, "desc", service.RankModeCompetition)
+		Expect(err).To(MatchError(service.NewTieBreakRankModeError(leaderboard, service.RankModeCompetition)))
+
+		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("desc")).Return(0, nil)
+		rank, err := svc.GetRank(context.Background(), leaderboard, "member1", "desc", service.RankModeOrdinal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(1))
+	})
+
+	It("Should decode scores on reads and search around the highest stored score", func() {
+		stored, err := tieBreak.EncodeScore(100, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().GetMemberIDsWithScoreInsideRange(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("-inf"), gomock.Eq("429496729599"), gomock.Eq(0), gomock.Eq(1)).
+			Return([]string{"member1"}, nil)
+		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("desc")).Return(0, nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).
+			Return([]*database.Member{{Member: "member1", Score: stored, Rank: 0}}, nil)
+
+		members, err := svc.GetAroundScore(context.Background(), leaderboard, 1, 99, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0].Score).To(Equal(float64(100)))
+
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member1")).
+			Return([]*database.Member{{Member: "member1", Score: stored, Rank: 0}}, nil)
+
+		member, err := svc.GetMember(context.Background(), leaderboard, "member1", "desc", false, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(float64(100)))
+	})
+})
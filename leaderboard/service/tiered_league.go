@@ -0,0 +1,92 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+)
+
+// Tier movements of a member when a tiered league season ends
+const (
+	// TierPromoted members play the next season in the tier above
+	TierPromoted = "promoted"
+	// TierRelegated members play the next season in the tier below
+	TierRelegated = "relegated"
+	// TierStayed members play the next season in the same tier
+	TierStayed = "stayed"
+)
+
+// TieredLeague is a competition whose members play each season in the league of their tier, like
+// bronze, silver and gold, and move between tiers by their final rank in their bucket when the season ends
+type TieredLeague struct {
+	// Prefix is prepended to tiers and season suffixes to name the tier leaderboards, like ranked-
+	Prefix string
+	// Cadence is how often seasons start
+	Cadence expiration.Cadence
+	// Location is the time zone seasons start in, defaults to UTC
+	Location *time.Location
+	// Tiers are ordered from the lowest to the highest, new members play in the lowest one
+	Tiers []string
+	// BucketSize is how many members of a tier play against each other
+	BucketSize int
+	// Promote is how many of the best members of each bucket move up a tier
+	Promote int
+	// Relegate is how many of the worst members of each bucket move down a tier
+	Relegate int
+}
+
+// TierPrefix return the prefix of the leaderboards of a tier, like ranked-gold-
+func (tl *TieredLeague) TierPrefix(tier string) string {
+	return tl.Prefix + tier + "-"
+}
+
+// Leaderboard return the name of the tier leaderboard of the season happening at t
+func (tl *TieredLeague) Leaderboard(tier string, t time.Time) string {
+	family := &SeasonFamily{Prefix: tl.TierPrefix(tier), Cadence: tl.Cadence, Location: tl.Location}
+	return family.Leaderboard(t, 0)
+}
+
+// ParseTier return the tier of a tier leaderboard, or of one of its buckets, false if leaderboard isn't one
+// of the tiered league leaderboards
+func (tl *TieredLeague) ParseTier(leaderboard string) (string, bool) {
+	if index := strings.LastIndex(leaderboard, LeagueBucketSeparator); index >= 0 {
+		if _, err := strconv.Atoi(leaderboard[index+len(LeagueBucketSeparator):]); err == nil {
+			leaderboard = leaderboard[:index]
+		}
+	}
+
+	var tier string
+	for _, candidate := range tl.Tiers {
+		prefix := tl.TierPrefix(candidate)
+		if len(leaderboard) > len(prefix) && strings.HasPrefix(leaderboard, prefix) && len(candidate) >= len(tier) {
+			tier = candidate
+		}
+	}
+	return tier, tier != ""
+}
+
+// Move return how a member finishing at rank of the total members of its bucket moves and the tier it plays
+// the next season in, promotions win when a bucket is too small to promote and relegate everyone asked
+func (tl *TieredLeague) Move(tier string, rank, total int) (string, string) {
+	index := tl.tierIndex(tier)
+
+	if index < len(tl.Tiers)-1 && rank <= tl.Promote {
+		return TierPromoted, tl.Tiers[index+1]
+	}
+	if index > 0 && rank > total-tl.Relegate {
+		return TierRelegated, tl.Tiers[index-1]
+	}
+	return TierStayed, tl.Tiers[index]
+}
+
+// tierIndex return the position of tier, unknown tiers are handled as the lowest one
+func (tl *TieredLeague) tierIndex(tier string) int {
+	for i, candidate := range tl.Tiers {
+		if candidate == tier {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Tiered league", func() {
+	var tieredLeague = &service.TieredLeague{
+		Prefix:   "ranked-",
+		Cadence:  expiration.CadenceWeekly,
+		Tiers:    []string{"bronze", "silver", "gold"},
+		Promote:  2,
+		Relegate: 1,
+	}
+
+	It("Should name tier leaderboards", func() {
+		Expect(tieredLeague.Leaderboard("silver", time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC))).To(Equal("ranked-silver-year2026week42"))
+	})
+
+	It("Should parse the tier of tier leaderboards and their buckets", func() {
+		for leaderboard, tier := range map[string]string{
+			"ranked-silver-year2026week42":   "silver",
+			"ranked-gold-year2026week42~3":   "gold",
+			"ranked-bronze-year2026week42~a": "bronze",
+		} {
+			parsed, ok := tieredLeague.ParseTier(leaderboard)
+			Expect(ok).To(BeTrue(), leaderboard)
+			Expect(parsed).To(Equal(tier))
+		}
+
+		for _, leaderboard := range []string{"ranked-silver-", "ranked-platinum-year2026week42", "casual-gold-year2026week42"} {
+			_, ok := tieredLeague.ParseTier(leaderboard)
+			Expect(ok).To(BeFalse(), leaderboard)
+		}
+	})
+
+	It("Should promote the best members and relegate the worst ones", func() {
+		for rank, expected := range map[int][]string{
+			1: {service.TierPromoted, "gold"},
+			2: {service.TierPromoted, "gold"},
+			3: {service.TierStayed, "silver"},
+			4: {service.TierStayed, "silver"},
+			5: {service.TierRelegated, "bronze"},
+		} {
+			movement, nextTier := tieredLeague.Move("silver", rank, 5)
+			Expect([]string{movement, nextTier}).To(Equal(expected))
+		}
+	})
+
+	It("Should not promote from the highest tier nor relegate from the lowest one", func() {
+		movement, nextTier := tieredLeague.Move("gold", 1, 5)
+		Expect(movement).To(Equal(service.TierStayed))
+		Expect(nextTier).To(Equal("gold"))
+
+		movement, nextTier = tieredLeague.Move("bronze", 5, 5)
+		Expect(movement).To(Equal(service.TierStayed))
+		Expect(nextTier).To(Equal("bronze"))
+	})
+
+	It("Should promote before relegating in small buckets", func() {
+		movement, _ := tieredLeague.Move("silver", 2, 2)
+		Expect(movement).To(Equal(service.TierPromoted))
+	})
+})
@@ -8,6 +8,11 @@ const totalMembersServiceLabel = "total members"
 
 // TotalMembers reurn how many members have in a leaderboard
 func (s *Service) TotalMembers(ctx context.Context, leaderboard string) (int, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return -1, err
+	}
+
 	count, err := s.Database.GetTotalMembers(ctx, leaderboard)
 	if err != nil {
 		return -1, NewGeneralError(totalMembersServiceLabel, err.Error())
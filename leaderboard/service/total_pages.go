@@ -9,6 +9,11 @@ const totalPagesServiceLabel = "total pages"
 
 // TotalPages return how many pages members have in a leaderboard according to pageSize
 func (s *Service) TotalPages(ctx context.Context, leaderboard string, pageSize int) (int, error) {
+	leaderboard, err := s.resolveLeaderboard(leaderboard)
+	if err != nil {
+		return -1, err
+	}
+
 	totalMembers, err := s.Database.GetTotalMembers(ctx, leaderboard)
 	if err != nil {
 		return -1, NewGeneralError(totalPagesServiceLabel, err.Error())
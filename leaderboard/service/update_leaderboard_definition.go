@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const updateLeaderboardDefinitionServiceLabel = "update leaderboard definition"
+
+// UpdateLeaderboardDefinition replace a registered leaderboard definition and return the stored one,
+// creation time is kept
+func (s *Service) UpdateLeaderboardDefinition(ctx context.Context, definition *model.LeaderboardDefinition) (*model.LeaderboardDefinition, error) {
+	if s.registry == nil {
+		return nil, NewGeneralError(updateLeaderboardDefinitionServiceLabel, registryNotConfiguredMessage)
+	}
+
+	if err := validateLeaderboardDefinition(definition); err != nil {
+		return nil, err
+	}
+
+	current, err := s.registry.GetLeaderboardDefinition(ctx, definition.ID)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardDefinitionNotFoundError); ok {
+			return nil, NewLeaderboardDefinitionNotFoundError(definition.ID)
+		}
+		return nil, NewGeneralError(updateLeaderboardDefinitionServiceLabel, err.Error())
+	}
+
+	databaseDefinition := convertModelLeaderboardDefinitionIntoDatabaseDefinition(definition)
+	databaseDefinition.CreatedAt = current.CreatedAt
+	databaseDefinition.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+
+	err = s.registry.UpdateLeaderboardDefinition(ctx, databaseDefinition)
+	if err != nil {
+		if _, ok := err.(*database.LeaderboardDefinitionNotFoundError); ok {
+			return nil, NewLeaderboardDefinitionNotFoundError(definition.ID)
+		}
+		return nil, NewGeneralError(updateLeaderboardDefinitionServiceLabel, err.Error())
+	}
+	s.forgetDefinition(definition.ID)
+
+	return convertDatabaseLeaderboardDefinitionIntoModelDefinition(databaseDefinition), nil
+}
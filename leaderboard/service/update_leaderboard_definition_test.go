@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service UpdateLeaderboardDefinition", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var registry *database.MockRegistry
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		registry = database.NewMockRegistry(ctrl)
+
+		svc = service.NewService(mock, service.WithRegistry(registry))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should replace definition keeping creation time", func() {
+		createdAt := time.Unix(1690000000, 0)
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(&database.LeaderboardDefinition{ID: leaderboard, Order: "desc", CreatedAt: createdAt}, nil)
+
+		var stored *database.LeaderboardDefinition
+		registry.EXPECT().UpdateLeaderboardDefinition(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, definition *database.LeaderboardDefinition) error {
+				stored = definition
+				return nil
+			},
+		)
+
+		definition, err := svc.UpdateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{
+			ID:        leaderboard,
+			Order:     "asc",
+			CreatedAt: 1,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stored.Order).To(Equal("asc"))
+		Expect(stored.CreatedAt).To(Equal(createdAt))
+		Expect(definition.CreatedAt).To(Equal(1690000000))
+		Expect(definition.UpdatedAt).To(BeNumerically("~", time.Now().Unix(), 1))
+	})
+
+	It("Should return error LeaderboardDefinitionNotFoundError if leaderboard isn't registered", func() {
+		registry.EXPECT().GetLeaderboardDefinition(gomock.Any(), gomock.Eq(leaderboard)).
+			Return(nil, database.NewLeaderboardDefinitionNotFoundError(leaderboard))
+
+		_, err := svc.UpdateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{ID: leaderboard})
+		Expect(err).To(Equal(service.NewLeaderboardDefinitionNotFoundError(leaderboard)))
+	})
+
+	It("Should return error InvalidLeaderboardDefinitionError if definition is invalid", func() {
+		_, err := svc.UpdateLeaderboardDefinition(context.Background(), &model.LeaderboardDefinition{ID: leaderboard, Order: "invalid"})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardDefinitionError("invalid order: invalid")))
+	})
+})
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const verifySubmissionServiceLabel = "verify submission"
+
+// VerifySubmission check submission was signed with tenant signing key within the signing window and claim its
+// nonce so it's accepted once, unsigned submissions are accepted unless tenant requires signatures. The nonce
+// should be released with ReleaseSubmission if the write fails
+func (s *Service) VerifySubmission(ctx context.Context, tenant string, submission *model.SignedSubmission) error {
+	signing := s.getSubmissionSigning(tenant)
+	if submission.Signature == "" {
+		if signing != nil && signing.Required {
+			return NewInvalidSubmissionSignatureError("signature is required")
+		}
+		return nil
+	}
+
+	if signing == nil || signing.Key == "" {
+		return NewInvalidSubmissionSignatureError("tenant " + tenant + " has no signing key")
+	}
+	if submission.Nonce == "" {
+		return NewInvalidSubmissionSignatureError("nonce is required")
+	}
+	age := time.Since(time.Unix(submission.Timestamp, 0))
+	if age > s.signingWindow || age < -s.signingWindow {
+		return NewInvalidSubmissionSignatureError("timestamp is outside the signing window")
+	}
+	if !hmac.Equal([]byte(submission.Signature), []byte(SignSubmission(signing.Key, submission))) {
+		return NewInvalidSubmissionSignatureError("signature doesn't match")
+	}
+
+	claimed, err := s.nonces.ClaimNonce(ctx, tenant, submission.Nonce, 2*s.signingWindow)
+	if err != nil {
+		return NewGeneralError(verifySubmissionServiceLabel, err.Error())
+	}
+	submission.NonceClaimed = claimed
+	if !claimed {
+		retry, err := s.isIdempotentRetry(ctx, submission)
+		if err != nil {
+			return NewGeneralError(verifySubmissionServiceLabel, err.Error())
+		}
+		if !retry {
+			return NewInvalidSubmissionSignatureError("nonce was already used")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service VerifySubmission", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var nonces *database.MockNonces
+	var svc *service.Service
+	var submission *model.SignedSubmission
+
+	signing := map[string]*service.SubmissionSigning{
+		"game-signed":   {Key: "secret"},
+		"game-required": {Key: "secret", Required: true},
+		"game-keyless":  {Required: true},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		nonces = database.NewMockNonces(ctrl)
+
+		svc = service.NewService(mock, service.WithSubmissionSigning(nonces, func(tenant string) *service.SubmissionSigning {
+			return signing[tenant]
+		}, 5*time.Minute))
+
+		submission = &model.SignedSubmission{
+			Operation:   service.SubmissionOperationSet,
+			Leaderboard: "leaderboard",
+			Members:     []*model.Member{{PublicID: "member1", Score: 61.5}, {PublicID: "member2", Score: 100, Scores: []int64{3, 20}}},
+			Timestamp:   time.Now().Unix(),
+			Nonce:       "nonce1",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should build the canonical payload of a submission", func() {
+		submission.Timestamp = 1700000000
+		Expect(service.CanonicalSubmission(submission)).To(Equal(
+			"set\nleaderboard\n1700000000\nnonce1\n\n0\nfalse\n\nmember1\n61.5\n\n\nmember2\n100\n3,20\n"))
+
+		expectedScore := 50.25
+		submission.Members[0].Precondition = &model.Precondition{ExpectedScore: &expectedScore}
+		submission.Members[1].Precondition = &model.Precondition{IfAbsent: true}
+		submission.UpdatePolicy = "best"
+		submission.ScoreTTL = 3600
+		submission.PrevRank = true
+		submission.IdempotencyKey = "retry-1"
+		Expect(service.CanonicalSubmission(submission)).To(Equal(
+			"set\nleaderboard\n1700000000\nnonce1\nbest\n3600\ntrue\nretry-1\nmember1\n61.5\n\n50.25\nmember2\n100\n3,20\nabsent"))
+	})
+
+	It("Should accept a signed submission once", func() {
+		submission.Signature = service.SignSubmission("secret", submission)
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(true, nil)
+		Expect(svc.VerifySubmission(context.Background(), "game-signed", submission)).To(Succeed())
+
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(false, nil)
+		err := svc.VerifySubmission(context.Background(), "game-signed", submission)
+		Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError("nonce was already used")))
+	})
+
+	It("Should accept a reused nonce only to retry a write whose result is kept", func() {
+		svc = service.NewService(mock, service.WithSubmissionSigning(nonces, func(tenant string) *service.SubmissionSigning {
+			return signing[tenant]
+		}, 5*time.Minute), service.WithIdempotencyWindow(time.Hour))
+		submission.IdempotencyKey = "retry-1"
+		submission.Signature = service.SignSubmission("secret", submission)
+
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(false, nil)
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), "leaderboard", "retry-1").
+			Return([]*database.Member{{Member: "member1", Score: 61.5}}, nil)
+		Expect(svc.VerifySubmission(context.Background(), "game-signed", submission)).To(Succeed())
+
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(false, nil)
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), "leaderboard", "retry-1").Return(nil, nil)
+		err := svc.VerifySubmission(context.Background(), "game-signed", submission)
+		Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError("nonce was already used")))
+	})
+
+	It("Should look retries of league writes up in the bucket of their members", func() {
+		leagues := database.NewMockLeagues(ctrl)
+		svc = service.NewService(mock, service.WithSubmissionSigning(nonces, func(tenant string) *service.SubmissionSigning {
+			return signing[tenant]
+		}, 5*time.Minute), service.WithIdempotencyWindow(time.Hour), service.WithLeagues(leagues, func(leaderboard string) *service.League {
+			return &service.League{BucketSize: 10}
+		}))
+		submission.IdempotencyKey = "retry-1"
+		submission.Signature = service.SignSubmission("secret", submission)
+
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(false, nil)
+		leagues.EXPECT().GetLeagueBuckets(gomock.Any(), "leaderboard", "member1", "member2").
+			Return(map[string]int{"member1": 0, "member2": 3}, nil)
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), service.LeagueBucket("leaderboard", 0), "retry-1").Return(nil, nil)
+		mock.EXPECT().GetIdempotentMembers(gomock.Any(), service.LeagueBucket("leaderboard", 3), "retry-1").
+			Return([]*database.Member{{Member: "member2", Score: 100}}, nil)
+		Expect(svc.VerifySubmission(context.Background(), "game-signed", submission)).To(Succeed())
+		Expect(submission.NonceClaimed).To(BeFalse())
+	})
+
+	It("Should release the claimed nonce of a submission whose write failed", func() {
+		submission.Signature = service.SignSubmission("secret", submission)
+		Expect(svc.ReleaseSubmission(context.Background(), "game-signed", submission)).To(Succeed())
+
+		nonces.EXPECT().ClaimNonce(gomock.Any(), "game-signed", "nonce1", 10*time.Minute).Return(true, nil)
+		Expect(svc.VerifySubmission(context.Background(), "game-signed", submission)).To(Succeed())
+		Expect(submission.NonceClaimed).To(BeTrue())
+
+		nonces.EXPECT().ReleaseNonce(gomock.Any(), "game-signed", "nonce1").Return(nil)
+		Expect(svc.ReleaseSubmission(context.Background(), "game-signed", submission)).To(Succeed())
+		Expect(svc.ReleaseSubmission(context.Background(), "game-signed", submission)).To(Succeed())
+	})
+
+	It("Should accept unsigned submissions unless tenant requires signatures", func() {
+		Expect(svc.VerifySubmission(context.Background(), "game-signed", submission)).To(Succeed())
+		Expect(svc.VerifySubmission(context.Background(), "game-unsigned", submission)).To(Succeed())
+
+		err := svc.VerifySubmission(context.Background(), "game-required", submission)
+		Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError("signature is required")))
+
+		err = svc.VerifySubmission(context.Background(), "game-keyless", submission)
+		Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError("signature is required")))
+	})
+
+	It("Should refuse signed submissions of tenants without signing key", func() {
+		submission.Signature = service.SignSubmission("", submission)
+		err := svc.VerifySubmission(context.Background(), "game-keyless", submission)
+		Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError("tenant game-keyless has no signing key")))
+	})
+
+	It("Should refuse submissions that can't be verified without claiming their nonce", func() {
+		signature := service.SignSubmission("secret", submission)
+
+		for _, test := range []struct {
+			tenant string
+			change func(*model.SignedSubmission)
+			reason string
+		}{
+			{"game-unsigned", func(s *model.SignedSubmission) {}, "tenant game-unsigned has no signing key"},
+			{"game-signed", func(s *model.SignedSubmission) { s.Signature = service.SignSubmission("other", s) }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.Members[0].Score = 1000 }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.Operation = service.SubmissionOperationIncrement }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.UpdatePolicy = "sum" }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.ScoreTTL = 60 }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.PrevRank = true }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.IdempotencyKey = "retry-2" }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.Members[1].Precondition = &model.Precondition{IfPresent: true} }, "signature doesn't match"},
+			{"game-signed", func(s *model.SignedSubmission) { s.Nonce = "" }, "nonce is required"},
+			{"game-signed", func(s *model.SignedSubmission) {
+				s.Timestamp = time.Now().Add(-6 * time.Minute).Unix()
+				s.Signature = service.SignSubmission("secret", s)
+			}, "timestamp is outside the signing window"},
+			{"game-signed", func(s *model.SignedSubmission) {
+				s.Timestamp = time.Now().Add(6 * time.Minute).Unix()
+				s.Signature = service.SignSubmission("secret", s)
+			}, "timestamp is outside the signing window"},
+		} {
+			tampered := *submission
+			tampered.Members = []*model.Member{{PublicID: "member1", Score: 61.5}, {PublicID: "member2", Score: 100, Scores: []int64{3, 20}}}
+			tampered.Signature = signature
+			test.change(&tampered)
+
+			err := svc.VerifySubmission(context.Background(), test.tenant, &tampered)
+			Expect(err).To(Equal(service.NewInvalidSubmissionSignatureError(test.reason)))
+		}
+	})
+})
@@ -216,6 +216,12 @@ type BulkUpsertScoresRequest struct {
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
 	ScoreTTL     int32                                 `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
 	MemberScores *BulkUpsertScoresRequest_MemberScores `protobuf:"bytes,4,opt,name=member_scores,json=memberScores,proto3" json:"member_scores,omitempty"`
+	// How the submitted scores are combined with the stored ones: last, best, worst or sum.
+	// If empty, the leaderboard default update policy is used.
+	UpdatePolicy string `protobuf:"bytes,5,opt,name=update_policy,json=updatePolicy,proto3" json:"update_policy,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *BulkUpsertScoresRequest) Reset() {
@@ -278,6 +284,20 @@ func (x *BulkUpsertScoresRequest) GetMemberScores() *BulkUpsertScoresRequest_Mem
 	return nil
 }
 
+func (x *BulkUpsertScoresRequest) GetUpdatePolicy() string {
+	if x != nil {
+		return x.UpdatePolicy
+	}
+	return ""
+}
+
+func (x *BulkUpsertScoresRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // TODO: Create a single Member structure and make all requests use the same structure (document parts of the requests that are not returned)
 // Member is a basic payload for a leaderboard member used by some responses.
 type Member struct {
@@ -289,6 +309,8 @@ type Member struct {
 	Score    float64           `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
 	Rank     int32             `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
 	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,5,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 }
 
 func (x *Member) Reset() {
@@ -351,6 +373,13 @@ func (x *Member) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *Member) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
 type UpsertScoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -365,6 +394,12 @@ type UpsertScoreRequest struct {
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
 	ScoreTTL    int32                           `protobuf:"varint,4,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
 	ScoreChange *UpsertScoreRequest_ScoreChange `protobuf:"bytes,5,opt,name=score_change,json=scoreChange,proto3" json:"score_change,omitempty"`
+	// How the submitted score is combined with the stored one: last, best, worst or sum.
+	// If empty, the leaderboard default update policy is used.
+	UpdatePolicy string `protobuf:"bytes,6,opt,name=update_policy,json=updatePolicy,proto3" json:"update_policy,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *UpsertScoreRequest) Reset() {
@@ -434,6 +469,20 @@ func (x *UpsertScoreRequest) GetScoreChange() *UpsertScoreRequest_ScoreChange {
 	return nil
 }
 
+func (x *UpsertScoreRequest) GetUpdatePolicy() string {
+	if x != nil {
+		return x.UpdatePolicy
+	}
+	return ""
+}
+
+func (x *UpsertScoreRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type TotalMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -548,6 +597,9 @@ type IncrementScoreRequest struct {
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
 	ScoreTTL int32                       `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
 	Body     *IncrementScoreRequest_Body `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *IncrementScoreRequest) Reset() {
@@ -610,6 +662,13 @@ func (x *IncrementScoreRequest) GetBody() *IncrementScoreRequest_Body {
 	return nil
 }
 
+func (x *IncrementScoreRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type GetMemberRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -619,6 +678,9 @@ type GetMemberRequest struct {
 	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
 	Order          string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
 	ScoreTTL       bool   `protobuf:"varint,4,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,5,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
 }
 
 func (x *GetMemberRequest) Reset() {
@@ -681,6 +743,13 @@ func (x *GetMemberRequest) GetScoreTTL() bool {
 	return false
 }
 
+func (x *GetMemberRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
 type UpsertScoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -694,6 +763,12 @@ type UpsertScoreResponse struct {
 	PreviousRank int32 `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
 	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested)
 	ExpireAt int32 `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// If the stored score was changed by the update policy.
+	ScoreChanged bool `protobuf:"varint,7,opt,name=score_changed,json=scoreChanged,proto3" json:"score_changed,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,8,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// If the score broke a leaderboard score rule and was held for review instead of being ranked.
+	Quarantined bool `protobuf:"varint,9,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
 }
 
 func (x *UpsertScoreResponse) Reset() {
@@ -770,6 +845,27 @@ func (x *UpsertScoreResponse) GetExpireAt() int32 {
 	return 0
 }
 
+func (x *UpsertScoreResponse) GetScoreChanged() bool {
+	if x != nil {
+		return x.ScoreChanged
+	}
+	return false
+}
+
+func (x *UpsertScoreResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *UpsertScoreResponse) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
 type IncrementScoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -872,6 +968,8 @@ type GetMemberResponse struct {
 	PreviousRank int32 `protobuf:"varint,6,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
 	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested)
 	ExpireAt int32 `protobuf:"varint,7,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,8,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 }
 
 func (x *GetMemberResponse) Reset() {
@@ -948,6 +1046,13 @@ func (x *GetMemberResponse) GetExpireAt() int32 {
 	return 0
 }
 
+func (x *GetMemberResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
 type GetMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -957,6 +1062,9 @@ type GetMembersRequest struct {
 	Order         string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
 	ScoreTTL      bool   `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
 	Ids           string `protobuf:"bytes,4,opt,name=ids,proto3" json:"ids,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,5,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
 }
 
 func (x *GetMembersRequest) Reset() {
@@ -1019,6 +1127,13 @@ func (x *GetMembersRequest) GetIds() string {
 	return ""
 }
 
+func (x *GetMembersRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
 type GetMembersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1377,6 +1492,9 @@ type GetRankRequest struct {
 	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
 	// If set to asc, will treat the ranking with ascending scores (less is best).
 	Order string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,4,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
 }
 
 func (x *GetRankRequest) Reset() {
@@ -1432,6 +1550,13 @@ func (x *GetRankRequest) GetOrder() string {
 	return ""
 }
 
+func (x *GetRankRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
 type GetRankResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1505,6 +1630,9 @@ type GetAroundMemberRequest struct {
 	Order             string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
 	GetLastIfNotFound bool   `protobuf:"varint,4,opt,name=get_last_if_not_found,json=getLastIfNotFound,proto3" json:"get_last_if_not_found,omitempty"`
 	PageSize          int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,6,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
 }
 
 func (x *GetAroundMemberRequest) Reset() {
@@ -1574,6 +1702,13 @@ func (x *GetAroundMemberRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *GetAroundMemberRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
 type GetTopMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1583,6 +1718,11 @@ type GetTopMembersRequest struct {
 	PageNumber    int32  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
 	Order         string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
 	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,6,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
+	// If the leaderboard is a league, the top members of the bucket this member was assigned to are returned.
+	MemberPublicId string `protobuf:"bytes,7,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
 }
 
 func (x *GetTopMembersRequest) Reset() {
@@ -1645,6 +1785,20 @@ func (x *GetTopMembersRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *GetTopMembersRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
+func (x *GetTopMembersRequest) GetMemberPublicId() string {
+	if x != nil {
+		return x.MemberPublicId
+	}
+	return ""
+}
+
 type GetTopPercentageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1653,6 +1807,9 @@ type GetTopPercentageRequest struct {
 	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
 	Percentage    int32  `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	Order         string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	// How members with equal scores are ranked: ordinal, competition or dense.
+	// If empty, the leaderboard default rank mode is used.
+	RankMode string `protobuf:"bytes,4,opt,name=rank_mode,json=rankMode,proto3" json:"rank_mode,omitempty"`
 }
 
 func (x *GetTopPercentageRequest) Reset() {
@@ -1708,6 +1865,13 @@ func (x *GetTopPercentageRequest) GetOrder() string {
 	return ""
 }
 
+func (x *GetTopPercentageRequest) GetRankMode() string {
+	if x != nil {
+		return x.RankMode
+	}
+	return ""
+}
+
 type UpsertScoreMultiLeaderboardsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1717,6 +1881,12 @@ type UpsertScoreMultiLeaderboardsRequest struct {
 	ScoreTTL         int32                                                 `protobuf:"varint,2,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
 	PrevRank         bool                                                  `protobuf:"varint,3,opt,name=prev_rank,json=prevRank,proto3" json:"prev_rank,omitempty"`
 	ScoreMultiChange *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange `protobuf:"bytes,4,opt,name=score_multi_change,json=scoreMultiChange,proto3" json:"score_multi_change,omitempty"`
+	// How the submitted score is combined with the stored ones: last, best, worst or sum.
+	// If empty, each leaderboard default update policy is used.
+	UpdatePolicy string `protobuf:"bytes,5,opt,name=update_policy,json=updatePolicy,proto3" json:"update_policy,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *UpsertScoreMultiLeaderboardsRequest) Reset() {
@@ -1779,6 +1949,20 @@ func (x *UpsertScoreMultiLeaderboardsRequest) GetScoreMultiChange() *UpsertScore
 	return nil
 }
 
+func (x *UpsertScoreMultiLeaderboardsRequest) GetUpdatePolicy() string {
+	if x != nil {
+		return x.UpdatePolicy
+	}
+	return ""
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type UpsertScoreMultiLeaderboardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2306,21 +2490,39 @@ func (x *GetTopPercentageResponse) GetMembers() []*Member {
 	return nil
 }
 
-// MemberScore allow to provide score information about a single member.
-type BulkUpsertScoresRequest_MemberScore struct {
+// LeaderboardDefinition holds the settings used when a request doesn't set them.
+// Zero values keep the behaviour of unregistered leaderboards.
+type LeaderboardDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// TODO: use json_name on variables like this to respect .proto naming format.
-	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
-	// Score can store integer values from -9007199254740992 and 9007199254740992.
-	// Although the score type is double, internally the service converts this number to a int64 format.
-	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-}
-
-func (x *BulkUpsertScoresRequest_MemberScore) Reset() {
-	*x = BulkUpsertScoresRequest_MemberScore{}
+	// The leaderboard identification.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human readable leaderboard name.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Default order of reads and write ranks: asc or desc.
+	Order string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	// Default update policy of writes: last, best, worst or sum.
+	UpdatePolicy string `protobuf:"bytes,4,opt,name=update_policy,json=updatePolicy,proto3" json:"update_policy,omitempty"`
+	// Page size used when a request doesn't set one.
+	DefaultPageSize int32 `protobuf:"varint,5,opt,name=default_page_size,json=defaultPageSize,proto3" json:"default_page_size,omitempty"`
+	// Largest page size a request can ask for, zero means no leaderboard limit.
+	MaxPageSize int32 `protobuf:"varint,6,opt,name=max_page_size,json=maxPageSize,proto3" json:"max_page_size,omitempty"`
+	// Season start as unix timestamp in seconds.
+	StartTime int32 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Season end as unix timestamp in seconds. If set, it replaces the expiration inferred from the leaderboard name.
+	EndTime int32 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How many seconds the leaderboard is kept after end_time.
+	Retention int32 `protobuf:"varint,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Read only, unix timestamp in seconds of when the leaderboard was registered.
+	CreatedAt int32 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Read only, unix timestamp in seconds of the last definition change.
+	UpdatedAt int32 `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *LeaderboardDefinition) Reset() {
+	*x = LeaderboardDefinition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2328,13 +2530,13 @@ func (x *BulkUpsertScoresRequest_MemberScore) Reset() {
 	}
 }
 
-func (x *BulkUpsertScoresRequest_MemberScore) String() string {
+func (x *LeaderboardDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertScoresRequest_MemberScore) ProtoMessage() {}
+func (*LeaderboardDefinition) ProtoMessage() {}
 
-func (x *BulkUpsertScoresRequest_MemberScore) ProtoReflect() protoreflect.Message {
+func (x *LeaderboardDefinition) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2346,36 +2548,98 @@ func (x *BulkUpsertScoresRequest_MemberScore) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertScoresRequest_MemberScore.ProtoReflect.Descriptor instead.
-func (*BulkUpsertScoresRequest_MemberScore) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use LeaderboardDefinition.ProtoReflect.Descriptor instead.
+func (*LeaderboardDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *BulkUpsertScoresRequest_MemberScore) GetPublicID() string {
+func (x *LeaderboardDefinition) GetId() string {
 	if x != nil {
-		return x.PublicID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BulkUpsertScoresRequest_MemberScore) GetScore() float64 {
+func (x *LeaderboardDefinition) GetDisplayName() string {
 	if x != nil {
-		return x.Score
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LeaderboardDefinition) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *LeaderboardDefinition) GetUpdatePolicy() string {
+	if x != nil {
+		return x.UpdatePolicy
+	}
+	return ""
+}
+
+func (x *LeaderboardDefinition) GetDefaultPageSize() int32 {
+	if x != nil {
+		return x.DefaultPageSize
 	}
 	return 0
 }
 
-// ScoreUpserts represent multiple score submissions.
-type BulkUpsertScoresRequest_MemberScores struct {
+func (x *LeaderboardDefinition) GetMaxPageSize() int32 {
+	if x != nil {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+func (x *LeaderboardDefinition) GetStartTime() int32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *LeaderboardDefinition) GetEndTime() int32 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *LeaderboardDefinition) GetRetention() int32 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *LeaderboardDefinition) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LeaderboardDefinition) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateLeaderboardDefinitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Members []*BulkUpsertScoresRequest_MemberScore `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Definition *LeaderboardDefinition `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
 }
 
-func (x *BulkUpsertScoresRequest_MemberScores) Reset() {
-	*x = BulkUpsertScoresRequest_MemberScores{}
+func (x *CreateLeaderboardDefinitionRequest) Reset() {
+	*x = CreateLeaderboardDefinitionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2383,13 +2647,13 @@ func (x *BulkUpsertScoresRequest_MemberScores) Reset() {
 	}
 }
 
-func (x *BulkUpsertScoresRequest_MemberScores) String() string {
+func (x *CreateLeaderboardDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertScoresRequest_MemberScores) ProtoMessage() {}
+func (*CreateLeaderboardDefinitionRequest) ProtoMessage() {}
 
-func (x *BulkUpsertScoresRequest_MemberScores) ProtoReflect() protoreflect.Message {
+func (x *CreateLeaderboardDefinitionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2401,44 +2665,44 @@ func (x *BulkUpsertScoresRequest_MemberScores) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertScoresRequest_MemberScores.ProtoReflect.Descriptor instead.
-func (*BulkUpsertScoresRequest_MemberScores) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use CreateLeaderboardDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeaderboardDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BulkUpsertScoresRequest_MemberScores) GetMembers() []*BulkUpsertScoresRequest_MemberScore {
+func (x *CreateLeaderboardDefinitionRequest) GetDefinition() *LeaderboardDefinition {
 	if x != nil {
-		return x.Members
+		return x.Definition
 	}
 	return nil
 }
 
-// ScoreChange is the score payload when upserting a score.
-type UpsertScoreRequest_ScoreChange struct {
+type GetLeaderboardDefinitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	// The leaderboard identification.
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
 }
 
-func (x *UpsertScoreRequest_ScoreChange) Reset() {
-	*x = UpsertScoreRequest_ScoreChange{}
+func (x *GetLeaderboardDefinitionRequest) Reset() {
+	*x = GetLeaderboardDefinitionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[39]
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpsertScoreRequest_ScoreChange) String() string {
+func (x *GetLeaderboardDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertScoreRequest_ScoreChange) ProtoMessage() {}
+func (*GetLeaderboardDefinitionRequest) ProtoMessage() {}
 
-func (x *UpsertScoreRequest_ScoreChange) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[39]
+func (x *GetLeaderboardDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,44 +2713,41 @@ func (x *UpsertScoreRequest_ScoreChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertScoreRequest_ScoreChange.ProtoReflect.Descriptor instead.
-func (*UpsertScoreRequest_ScoreChange) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use GetLeaderboardDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpsertScoreRequest_ScoreChange) GetScore() float64 {
+func (x *GetLeaderboardDefinitionRequest) GetLeaderboardId() string {
 	if x != nil {
-		return x.Score
+		return x.LeaderboardId
 	}
-	return 0
+	return ""
 }
 
-// Body represents the increment payload.
-type IncrementScoreRequest_Body struct {
+type ListLeaderboardDefinitionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Increment float64 `protobuf:"fixed64,1,opt,name=increment,proto3" json:"increment,omitempty"`
 }
 
-func (x *IncrementScoreRequest_Body) Reset() {
-	*x = IncrementScoreRequest_Body{}
+func (x *ListLeaderboardDefinitionsRequest) Reset() {
+	*x = ListLeaderboardDefinitionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[40]
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncrementScoreRequest_Body) String() string {
+func (x *ListLeaderboardDefinitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementScoreRequest_Body) ProtoMessage() {}
+func (*ListLeaderboardDefinitionsRequest) ProtoMessage() {}
 
-func (x *IncrementScoreRequest_Body) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[40]
+func (x *ListLeaderboardDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2497,51 +2758,38 @@ func (x *IncrementScoreRequest_Body) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementScoreRequest_Body.ProtoReflect.Descriptor instead.
-func (*IncrementScoreRequest_Body) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *IncrementScoreRequest_Body) GetIncrement() float64 {
-	if x != nil {
-		return x.Increment
-	}
-	return 0
+// Deprecated: Use ListLeaderboardDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{39}
 }
 
-// Member information returned for GetMembers request.
-type GetMembersResponse_Member struct {
+type UpdateLeaderboardDefinitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
-	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-	Rank     int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
-	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
-	ExpireAt int32 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
-	// Member rank for all members returned in this request.
-	Position int32             `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
-	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The leaderboard identification.
+	LeaderboardId string                 `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Definition    *LeaderboardDefinition `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
 }
 
-func (x *GetMembersResponse_Member) Reset() {
-	*x = GetMembersResponse_Member{}
+func (x *UpdateLeaderboardDefinitionRequest) Reset() {
+	*x = UpdateLeaderboardDefinitionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[41]
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMembersResponse_Member) String() string {
+func (x *UpdateLeaderboardDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMembersResponse_Member) ProtoMessage() {}
+func (*UpdateLeaderboardDefinitionRequest) ProtoMessage() {}
 
-func (x *GetMembersResponse_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[41]
+func (x *UpdateLeaderboardDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,65 +2800,139 @@ func (x *GetMembersResponse_Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMembersResponse_Member.ProtoReflect.Descriptor instead.
-func (*GetMembersResponse_Member) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use UpdateLeaderboardDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeaderboardDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetMembersResponse_Member) GetPublicID() string {
+func (x *UpdateLeaderboardDefinitionRequest) GetLeaderboardId() string {
 	if x != nil {
-		return x.PublicID
+		return x.LeaderboardId
 	}
 	return ""
 }
 
-func (x *GetMembersResponse_Member) GetScore() float64 {
+func (x *UpdateLeaderboardDefinitionRequest) GetDefinition() *LeaderboardDefinition {
 	if x != nil {
-		return x.Score
+		return x.Definition
 	}
-	return 0
+	return nil
 }
 
-func (x *GetMembersResponse_Member) GetRank() int32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
+type RemoveLeaderboardDefinitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaderboard identification.
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
 }
 
-func (x *GetMembersResponse_Member) GetExpireAt() int32 {
+func (x *RemoveLeaderboardDefinitionRequest) Reset() {
+	*x = RemoveLeaderboardDefinitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveLeaderboardDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLeaderboardDefinitionRequest) ProtoMessage() {}
+
+func (x *RemoveLeaderboardDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLeaderboardDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLeaderboardDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveLeaderboardDefinitionRequest) GetLeaderboardId() string {
 	if x != nil {
-		return x.ExpireAt
+		return x.LeaderboardId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMembersResponse_Member) GetPosition() int32 {
+type LeaderboardDefinitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Definition *LeaderboardDefinition `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+}
+
+func (x *LeaderboardDefinitionResponse) Reset() {
+	*x = LeaderboardDefinitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardDefinitionResponse) ProtoMessage() {}
+
+func (x *LeaderboardDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LeaderboardDefinitionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Position
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GetMembersResponse_Member) GetMetadata() map[string]string {
+func (x *LeaderboardDefinitionResponse) GetDefinition() *LeaderboardDefinition {
 	if x != nil {
-		return x.Metadata
+		return x.Definition
 	}
 	return nil
 }
 
-// ScoreMultiChange is the payload to update the score of a member on multiple leaderboards.
-type UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange struct {
+type ListLeaderboardDefinitionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Score        float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
-	Leaderboards []string `protobuf:"bytes,2,rep,name=leaderboards,proto3" json:"leaderboards,omitempty"`
+	Success     bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Definitions []*LeaderboardDefinition `protobuf:"bytes,2,rep,name=definitions,proto3" json:"definitions,omitempty"`
 }
 
-func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) Reset() {
-	*x = UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange{}
+func (x *ListLeaderboardDefinitionsResponse) Reset() {
+	*x = ListLeaderboardDefinitionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2618,13 +2940,13 @@ func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) Reset() {
 	}
 }
 
-func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) String() string {
+func (x *ListLeaderboardDefinitionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) ProtoMessage() {}
+func (*ListLeaderboardDefinitionsResponse) ProtoMessage() {}
 
-func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) ProtoReflect() protoreflect.Message {
+func (x *ListLeaderboardDefinitionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2636,43 +2958,35 @@ func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange.ProtoReflect.Descriptor instead.
-func (*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{26, 0}
+// Deprecated: Use ListLeaderboardDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) GetScore() float64 {
+func (x *ListLeaderboardDefinitionsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Score
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) GetLeaderboards() []string {
+func (x *ListLeaderboardDefinitionsResponse) GetDefinitions() []*LeaderboardDefinition {
 	if x != nil {
-		return x.Leaderboards
+		return x.Definitions
 	}
 	return nil
 }
 
-// Member represents the information regarding a single member in response to a multi upsert score.
-type UpsertScoreMultiLeaderboardsResponse_Member struct {
+type RemoveLeaderboardDefinitionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
-	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-	Rank     int32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
-	// The previous rank of the player in the leaderboard, if requested.
-	PreviousRank int32 `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
-	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
-	ExpireAt      int32  `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
-	LeaderboardID string `protobuf:"bytes,8,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) Reset() {
-	*x = UpsertScoreMultiLeaderboardsResponse_Member{}
+func (x *RemoveLeaderboardDefinitionResponse) Reset() {
+	*x = RemoveLeaderboardDefinitionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2680,13 +2994,13 @@ func (x *UpsertScoreMultiLeaderboardsResponse_Member) Reset() {
 	}
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) String() string {
+func (x *RemoveLeaderboardDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertScoreMultiLeaderboardsResponse_Member) ProtoMessage() {}
+func (*RemoveLeaderboardDefinitionResponse) ProtoMessage() {}
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) ProtoReflect() protoreflect.Message {
+func (x *RemoveLeaderboardDefinitionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2698,82 +3012,143 @@ func (x *UpsertScoreMultiLeaderboardsResponse_Member) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertScoreMultiLeaderboardsResponse_Member.ProtoReflect.Descriptor instead.
-func (*UpsertScoreMultiLeaderboardsResponse_Member) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use RemoveLeaderboardDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLeaderboardDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetPublicID() string {
+func (x *RemoveLeaderboardDefinitionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PublicID
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetScore() float64 {
+// LeaderboardArchive describes the final standings of a leaderboard season, they are kept after the leaderboard expires.
+type LeaderboardArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaderboard identification.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Season end as unix timestamp in seconds.
+	SeasonEnd int32 `protobuf:"varint,2,opt,name=season_end,json=seasonEnd,proto3" json:"season_end,omitempty"`
+	// Unix timestamp in seconds of when the final standings were archived.
+	ArchivedAt int32 `protobuf:"varint,3,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// How many members the final standings have.
+	TotalMembers int32 `protobuf:"varint,4,opt,name=total_members,json=totalMembers,proto3" json:"total_members,omitempty"`
+	// Order members are ranked in by the reward brackets.
+	RewardOrder string `protobuf:"bytes,5,opt,name=reward_order,json=rewardOrder,proto3" json:"reward_order,omitempty"`
+	// Reward brackets assigned to the final standings, empty if the leaderboard has no reward tiers.
+	RewardBrackets []*RewardBracket `protobuf:"bytes,6,rep,name=reward_brackets,json=rewardBrackets,proto3" json:"reward_brackets,omitempty"`
+}
+
+func (x *LeaderboardArchive) Reset() {
+	*x = LeaderboardArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardArchive) ProtoMessage() {}
+
+func (x *LeaderboardArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardArchive.ProtoReflect.Descriptor instead.
+func (*LeaderboardArchive) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LeaderboardArchive) GetId() string {
 	if x != nil {
-		return x.Score
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetRank() int32 {
+func (x *LeaderboardArchive) GetSeasonEnd() int32 {
 	if x != nil {
-		return x.Rank
+		return x.SeasonEnd
 	}
 	return 0
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetPreviousRank() int32 {
+func (x *LeaderboardArchive) GetArchivedAt() int32 {
 	if x != nil {
-		return x.PreviousRank
+		return x.ArchivedAt
 	}
 	return 0
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetExpireAt() int32 {
+func (x *LeaderboardArchive) GetTotalMembers() int32 {
 	if x != nil {
-		return x.ExpireAt
+		return x.TotalMembers
 	}
 	return 0
 }
 
-func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetLeaderboardID() string {
+func (x *LeaderboardArchive) GetRewardOrder() string {
 	if x != nil {
-		return x.LeaderboardID
+		return x.RewardOrder
 	}
 	return ""
 }
 
-// Member represents member information retrieved from one the leaderboards during MultiGetRankResponse operation.
-type GetRankMultiLeaderboardsResponse_Member struct {
+func (x *LeaderboardArchive) GetRewardBrackets() []*RewardBracket {
+	if x != nil {
+		return x.RewardBrackets
+	}
+	return nil
+}
+
+// RewardBracket is the range of final ranks rewarded with a reward tier.
+type RewardBracket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeaderboardID string  `protobuf:"bytes,1,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
-	Rank          int32   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
-	Score         float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
-	ExpireAt      int32   `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// The reward tier name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// First rank rewarded, starting at 1.
+	MinRank int32 `protobuf:"varint,2,opt,name=min_rank,json=minRank,proto3" json:"min_rank,omitempty"`
+	// Last rank rewarded.
+	MaxRank int32 `protobuf:"varint,3,opt,name=max_rank,json=maxRank,proto3" json:"max_rank,omitempty"`
 }
 
-func (x *GetRankMultiLeaderboardsResponse_Member) Reset() {
-	*x = GetRankMultiLeaderboardsResponse_Member{}
+func (x *RewardBracket) Reset() {
+	*x = RewardBracket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[45]
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRankMultiLeaderboardsResponse_Member) String() string {
+func (x *RewardBracket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRankMultiLeaderboardsResponse_Member) ProtoMessage() {}
+func (*RewardBracket) ProtoMessage() {}
 
-func (x *GetRankMultiLeaderboardsResponse_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[45]
+func (x *RewardBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2784,71 +3159,58 @@ func (x *GetRankMultiLeaderboardsResponse_Member) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRankMultiLeaderboardsResponse_Member.ProtoReflect.Descriptor instead.
-func (*GetRankMultiLeaderboardsResponse_Member) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use RewardBracket.ProtoReflect.Descriptor instead.
+func (*RewardBracket) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetRankMultiLeaderboardsResponse_Member) GetLeaderboardID() string {
+func (x *RewardBracket) GetName() string {
 	if x != nil {
-		return x.LeaderboardID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetRankMultiLeaderboardsResponse_Member) GetRank() int32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
-}
-
-func (x *GetRankMultiLeaderboardsResponse_Member) GetScore() float64 {
+func (x *RewardBracket) GetMinRank() int32 {
 	if x != nil {
-		return x.Score
+		return x.MinRank
 	}
 	return 0
 }
 
-func (x *GetRankMultiLeaderboardsResponse_Member) GetExpireAt() int32 {
+func (x *RewardBracket) GetMaxRank() int32 {
 	if x != nil {
-		return x.ExpireAt
+		return x.MaxRank
 	}
 	return 0
 }
 
-// Member information returned for BulkUpsertScores request.
-type BulkUpsertScoresResponse_Member struct {
+type GetLeaderboardArchiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
-	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-	Rank     int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
-	// The previous rank of the player in the leaderboard, if requested.
-	PreviousRank int32 `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
-	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
-	ExpireAt int32 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// The leaderboard identification.
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
 }
 
-func (x *BulkUpsertScoresResponse_Member) Reset() {
-	*x = BulkUpsertScoresResponse_Member{}
+func (x *GetLeaderboardArchiveRequest) Reset() {
+	*x = GetLeaderboardArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[46]
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BulkUpsertScoresResponse_Member) String() string {
+func (x *GetLeaderboardArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertScoresResponse_Member) ProtoMessage() {}
+func (*GetLeaderboardArchiveRequest) ProtoMessage() {}
 
-func (x *BulkUpsertScoresResponse_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[46]
+func (x *GetLeaderboardArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2859,711 +3221,3969 @@ func (x *BulkUpsertScoresResponse_Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertScoresResponse_Member.ProtoReflect.Descriptor instead.
-func (*BulkUpsertScoresResponse_Member) Descriptor() ([]byte, []int) {
-	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use GetLeaderboardArchiveRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *BulkUpsertScoresResponse_Member) GetPublicID() string {
+func (x *GetLeaderboardArchiveRequest) GetLeaderboardId() string {
 	if x != nil {
-		return x.PublicID
+		return x.LeaderboardId
 	}
 	return ""
 }
 
-func (x *BulkUpsertScoresResponse_Member) GetScore() float64 {
-	if x != nil {
-		return x.Score
+type GetLeaderboardArchiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Archive *LeaderboardArchive `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *GetLeaderboardArchiveResponse) Reset() {
+	*x = GetLeaderboardArchiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *BulkUpsertScoresResponse_Member) GetRank() int32 {
-	if x != nil {
-		return x.Rank
+func (x *GetLeaderboardArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardArchiveResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *BulkUpsertScoresResponse_Member) GetPreviousRank() int32 {
+// Deprecated: Use GetLeaderboardArchiveResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetLeaderboardArchiveResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PreviousRank
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *BulkUpsertScoresResponse_Member) GetExpireAt() int32 {
+func (x *GetLeaderboardArchiveResponse) GetArchive() *LeaderboardArchive {
 	if x != nil {
-		return x.ExpireAt
+		return x.Archive
 	}
-	return 0
+	return nil
 }
 
-var File_proto_podium_api_v1_podium_proto protoreflect.FileDescriptor
-
-var file_proto_podium_api_v1_podium_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0d, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74,
-	0x65, 0x22, 0x41, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x49, 0x64, 0x22, 0xf2, 0x02, 0x0a, 0x17, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76,
-	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c,
-	0x12, 0x58, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65,
-	0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x0c, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x3f, 0x0a, 0x0b, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x5c, 0x0a, 0x0c, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c,
-	0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x06, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x50, 0x0a, 0x0c, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x23, 0x0a, 0x0b, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x22, 0x3c, 0x0a, 0x13, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x46,
-	0x0a, 0x14, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x3d, 0x0a,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f,
-	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x24, 0x0a, 0x04,
-	0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x55,
-	0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72,
-	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x41, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41,
-	0x74, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xaa, 0x03, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f,
-	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x98, 0x02, 0x0a, 0x06,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22, 0x4f,
-	0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
-	0x4d, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48,
-	0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xce, 0x01, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x15, 0x67,
-	0x65, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x65, 0x74, 0x4c,
-	0x61, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x76,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xc9, 0x02, 0x0a, 0x23, 0x55, 0x70, 0x73, 0x65, 0x72,
-	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
-	0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x54, 0x54, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x54, 0x54, 0x4c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x72, 0x61, 0x6e,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x52, 0x61, 0x6e,
-	0x6b, 0x12, 0x71, 0x0a, 0x12, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e,
-	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x1a, 0x4c, 0x0a, 0x10, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x24, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0xb6, 0x01, 0x0a, 0x06, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12,
-	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x22, 0xa6, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
-	0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x22, 0x83, 0x02, 0x0a, 0x20,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6f, 0x64,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x75, 0x0a, 0x06, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41,
-	0x74, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x18,
-	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x90, 0x01, 0x0a,
-	0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61,
-	0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22,
-	0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
-	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x65,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x32, 0xeb, 0x13, 0x0a, 0x06, 0x50, 0x6f, 0x64, 0x69, 0x75, 0x6d,
-	0x12, 0x54, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
-	0x21, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x27, 0x2e,
-	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01,
-	0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x64,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55,
-	0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x0d, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x1a, 0x2f, 0x6c, 0x2f, 0x7b,
-	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x73, 0x65, 0x72,
-	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x1a, 0x34, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0c, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x64,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x6c, 0x2f,
-	0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xa1,
-	0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x32, 0x34, 0x2f, 0x6c,
-	0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x6c, 0x2f,
-	0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x64, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f,
-	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x2a, 0x2e, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f,
-	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x6e, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x9f,
-	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x90, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x64, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x7d, 0x2f, 0x61, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x64,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f,
-	0x70, 0x2f, 0x7b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12,
-	0x99, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
-	0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x70, 0x2d, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x2f, 0x7b,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x1c,
-	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x32, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x33, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x12, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x1a, 0x1c, 0x2f, 0x6d, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
-	0xa1, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70,
-	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6d, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x42, 0x54, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x6f, 0x70, 0x66, 0x72,
-	0x65, 0x65, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x0d, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x50, 0x6f, 0x64, 0x69,
-	0x75, 0x6d, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+type GetArchivedTopMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	PageNumber    int32  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	Order         string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-var (
-	file_proto_podium_api_v1_podium_proto_rawDescOnce sync.Once
-	file_proto_podium_api_v1_podium_proto_rawDescData = file_proto_podium_api_v1_podium_proto_rawDesc
-)
+func (x *GetArchivedTopMembersRequest) Reset() {
+	*x = GetArchivedTopMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_podium_api_v1_podium_proto_rawDescGZIP() []byte {
-	file_proto_podium_api_v1_podium_proto_rawDescOnce.Do(func() {
-		file_proto_podium_api_v1_podium_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_podium_api_v1_podium_proto_rawDescData)
-	})
-	return file_proto_podium_api_v1_podium_proto_rawDescData
+func (x *GetArchivedTopMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_podium_api_v1_podium_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
-var file_proto_podium_api_v1_podium_proto_goTypes = []interface{}{
-	(*HealthCheckRequest)(nil),                   // 0: podium.api.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),                  // 1: podium.api.v1.HealthCheckResponse
-	(*StatusResponse)(nil),                       // 2: podium.api.v1.StatusResponse
-	(*RemoveLeaderboardRequest)(nil),             // 3: podium.api.v1.RemoveLeaderboardRequest
-	(*BulkUpsertScoresRequest)(nil),              // 4: podium.api.v1.BulkUpsertScoresRequest
-	(*Member)(nil),                               // 5: podium.api.v1.Member
-	(*UpsertScoreRequest)(nil),                   // 6: podium.api.v1.UpsertScoreRequest
-	(*TotalMembersRequest)(nil),                  // 7: podium.api.v1.TotalMembersRequest
-	(*TotalMembersResponse)(nil),                 // 8: podium.api.v1.TotalMembersResponse
-	(*IncrementScoreRequest)(nil),                // 9: podium.api.v1.IncrementScoreRequest
-	(*GetMemberRequest)(nil),                     // 10: podium.api.v1.GetMemberRequest
-	(*UpsertScoreResponse)(nil),                  // 11: podium.api.v1.UpsertScoreResponse
-	(*IncrementScoreResponse)(nil),               // 12: podium.api.v1.IncrementScoreResponse
-	(*GetMemberResponse)(nil),                    // 13: podium.api.v1.GetMemberResponse
-	(*GetMembersRequest)(nil),                    // 14: podium.api.v1.GetMembersRequest
-	(*GetMembersResponse)(nil),                   // 15: podium.api.v1.GetMembersResponse
-	(*RemoveMemberRequest)(nil),                  // 16: podium.api.v1.RemoveMemberRequest
-	(*RemoveMembersRequest)(nil),                 // 17: podium.api.v1.RemoveMembersRequest
-	(*RemoveLeaderboardResponse)(nil),            // 18: podium.api.v1.RemoveLeaderboardResponse
-	(*RemoveMemberResponse)(nil),                 // 19: podium.api.v1.RemoveMemberResponse
-	(*RemoveMembersResponse)(nil),                // 20: podium.api.v1.RemoveMembersResponse
-	(*GetRankRequest)(nil),                       // 21: podium.api.v1.GetRankRequest
-	(*GetRankResponse)(nil),                      // 22: podium.api.v1.GetRankResponse
-	(*GetAroundMemberRequest)(nil),               // 23: podium.api.v1.GetAroundMemberRequest
-	(*GetTopMembersRequest)(nil),                 // 24: podium.api.v1.GetTopMembersRequest
-	(*GetTopPercentageRequest)(nil),              // 25: podium.api.v1.GetTopPercentageRequest
-	(*UpsertScoreMultiLeaderboardsRequest)(nil),  // 26: podium.api.v1.UpsertScoreMultiLeaderboardsRequest
-	(*UpsertScoreMultiLeaderboardsResponse)(nil), // 27: podium.api.v1.UpsertScoreMultiLeaderboardsResponse
-	(*GetRankMultiLeaderboardsRequest)(nil),      // 28: podium.api.v1.GetRankMultiLeaderboardsRequest
-	(*GetRankMultiLeaderboardsResponse)(nil),     // 29: podium.api.v1.GetRankMultiLeaderboardsResponse
-	(*GetAroundScoreRequest)(nil),                // 30: podium.api.v1.GetAroundScoreRequest
-	(*BulkUpsertScoresResponse)(nil),             // 31: podium.api.v1.BulkUpsertScoresResponse
-	(*GetAroundMemberResponse)(nil),              // 32: podium.api.v1.GetAroundMemberResponse
-	(*GetAroundScoreResponse)(nil),               // 33: podium.api.v1.GetAroundScoreResponse
-	(*GetTopMembersResponse)(nil),                // 34: podium.api.v1.GetTopMembersResponse
-	(*GetTopPercentageResponse)(nil),             // 35: podium.api.v1.GetTopPercentageResponse
-	(*BulkUpsertScoresRequest_MemberScore)(nil),  // 36: podium.api.v1.BulkUpsertScoresRequest.MemberScore
-	(*BulkUpsertScoresRequest_MemberScores)(nil), // 37: podium.api.v1.BulkUpsertScoresRequest.MemberScores
-	nil,                                    // 38: podium.api.v1.Member.MetadataEntry
-	(*UpsertScoreRequest_ScoreChange)(nil), // 39: podium.api.v1.UpsertScoreRequest.ScoreChange
-	(*IncrementScoreRequest_Body)(nil),     // 40: podium.api.v1.IncrementScoreRequest.Body
-	(*GetMembersResponse_Member)(nil),      // 41: podium.api.v1.GetMembersResponse.Member
-	nil,                                    // 42: podium.api.v1.GetMembersResponse.Member.MetadataEntry
-	(*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange)(nil), // 43: podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange
-	(*UpsertScoreMultiLeaderboardsResponse_Member)(nil),          // 44: podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member
-	(*GetRankMultiLeaderboardsResponse_Member)(nil),              // 45: podium.api.v1.GetRankMultiLeaderboardsResponse.Member
-	(*BulkUpsertScoresResponse_Member)(nil),                      // 46: podium.api.v1.BulkUpsertScoresResponse.Member
-	(*emptypb.Empty)(nil),                                        // 47: google.protobuf.Empty
+func (*GetArchivedTopMembersRequest) ProtoMessage() {}
+
+func (x *GetArchivedTopMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_podium_api_v1_podium_proto_depIdxs = []int32{
-	37, // 0: podium.api.v1.BulkUpsertScoresRequest.member_scores:type_name -> podium.api.v1.BulkUpsertScoresRequest.MemberScores
-	38, // 1: podium.api.v1.Member.metadata:type_name -> podium.api.v1.Member.MetadataEntry
-	39, // 2: podium.api.v1.UpsertScoreRequest.score_change:type_name -> podium.api.v1.UpsertScoreRequest.ScoreChange
-	40, // 3: podium.api.v1.IncrementScoreRequest.body:type_name -> podium.api.v1.IncrementScoreRequest.Body
-	41, // 4: podium.api.v1.GetMembersResponse.members:type_name -> podium.api.v1.GetMembersResponse.Member
-	43, // 5: podium.api.v1.UpsertScoreMultiLeaderboardsRequest.score_multi_change:type_name -> podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange
-	44, // 6: podium.api.v1.UpsertScoreMultiLeaderboardsResponse.scores:type_name -> podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member
-	45, // 7: podium.api.v1.GetRankMultiLeaderboardsResponse.scores:type_name -> podium.api.v1.GetRankMultiLeaderboardsResponse.Member
-	46, // 8: podium.api.v1.BulkUpsertScoresResponse.members:type_name -> podium.api.v1.BulkUpsertScoresResponse.Member
-	5,  // 9: podium.api.v1.GetAroundMemberResponse.members:type_name -> podium.api.v1.Member
-	5,  // 10: podium.api.v1.GetAroundScoreResponse.members:type_name -> podium.api.v1.Member
-	5,  // 11: podium.api.v1.GetTopMembersResponse.members:type_name -> podium.api.v1.Member
-	5,  // 12: podium.api.v1.GetTopPercentageResponse.members:type_name -> podium.api.v1.Member
-	36, // 13: podium.api.v1.BulkUpsertScoresRequest.MemberScores.members:type_name -> podium.api.v1.BulkUpsertScoresRequest.MemberScore
-	42, // 14: podium.api.v1.GetMembersResponse.Member.metadata:type_name -> podium.api.v1.GetMembersResponse.Member.MetadataEntry
-	0,  // 15: podium.api.v1.Podium.HealthCheck:input_type -> podium.api.v1.HealthCheckRequest
-	47, // 16: podium.api.v1.Podium.Status:input_type -> google.protobuf.Empty
-	3,  // 17: podium.api.v1.Podium.RemoveLeaderboard:input_type -> podium.api.v1.RemoveLeaderboardRequest
-	4,  // 18: podium.api.v1.Podium.BulkUpsertScores:input_type -> podium.api.v1.BulkUpsertScoresRequest
-	6,  // 19: podium.api.v1.Podium.UpsertScore:input_type -> podium.api.v1.UpsertScoreRequest
-	7,  // 20: podium.api.v1.Podium.TotalMembers:input_type -> podium.api.v1.TotalMembersRequest
-	9,  // 21: podium.api.v1.Podium.IncrementScore:input_type -> podium.api.v1.IncrementScoreRequest
-	10, // 22: podium.api.v1.Podium.GetMember:input_type -> podium.api.v1.GetMemberRequest
-	14, // 23: podium.api.v1.Podium.GetMembers:input_type -> podium.api.v1.GetMembersRequest
-	16, // 24: podium.api.v1.Podium.RemoveMember:input_type -> podium.api.v1.RemoveMemberRequest
-	17, // 25: podium.api.v1.Podium.RemoveMembers:input_type -> podium.api.v1.RemoveMembersRequest
-	21, // 26: podium.api.v1.Podium.GetRank:input_type -> podium.api.v1.GetRankRequest
-	23, // 27: podium.api.v1.Podium.GetAroundMember:input_type -> podium.api.v1.GetAroundMemberRequest
-	30, // 28: podium.api.v1.Podium.GetAroundScore:input_type -> podium.api.v1.GetAroundScoreRequest
-	24, // 29: podium.api.v1.Podium.GetTopMembers:input_type -> podium.api.v1.GetTopMembersRequest
-	25, // 30: podium.api.v1.Podium.GetTopPercentage:input_type -> podium.api.v1.GetTopPercentageRequest
-	26, // 31: podium.api.v1.Podium.UpsertScoreMultiLeaderboards:input_type -> podium.api.v1.UpsertScoreMultiLeaderboardsRequest
-	28, // 32: podium.api.v1.Podium.GetRankMultiLeaderboards:input_type -> podium.api.v1.GetRankMultiLeaderboardsRequest
-	1,  // 33: podium.api.v1.Podium.HealthCheck:output_type -> podium.api.v1.HealthCheckResponse
-	2,  // 34: podium.api.v1.Podium.Status:output_type -> podium.api.v1.StatusResponse
-	18, // 35: podium.api.v1.Podium.RemoveLeaderboard:output_type -> podium.api.v1.RemoveLeaderboardResponse
-	31, // 36: podium.api.v1.Podium.BulkUpsertScores:output_type -> podium.api.v1.BulkUpsertScoresResponse
-	11, // 37: podium.api.v1.Podium.UpsertScore:output_type -> podium.api.v1.UpsertScoreResponse
-	8,  // 38: podium.api.v1.Podium.TotalMembers:output_type -> podium.api.v1.TotalMembersResponse
-	12, // 39: podium.api.v1.Podium.IncrementScore:output_type -> podium.api.v1.IncrementScoreResponse
-	13, // 40: podium.api.v1.Podium.GetMember:output_type -> podium.api.v1.GetMemberResponse
-	15, // 41: podium.api.v1.Podium.GetMembers:output_type -> podium.api.v1.GetMembersResponse
-	19, // 42: podium.api.v1.Podium.RemoveMember:output_type -> podium.api.v1.RemoveMemberResponse
-	20, // 43: podium.api.v1.Podium.RemoveMembers:output_type -> podium.api.v1.RemoveMembersResponse
-	22, // 44: podium.api.v1.Podium.GetRank:output_type -> podium.api.v1.GetRankResponse
-	32, // 45: podium.api.v1.Podium.GetAroundMember:output_type -> podium.api.v1.GetAroundMemberResponse
-	33, // 46: podium.api.v1.Podium.GetAroundScore:output_type -> podium.api.v1.GetAroundScoreResponse
-	34, // 47: podium.api.v1.Podium.GetTopMembers:output_type -> podium.api.v1.GetTopMembersResponse
-	35, // 48: podium.api.v1.Podium.GetTopPercentage:output_type -> podium.api.v1.GetTopPercentageResponse
-	27, // 49: podium.api.v1.Podium.UpsertScoreMultiLeaderboards:output_type -> podium.api.v1.UpsertScoreMultiLeaderboardsResponse
-	29, // 50: podium.api.v1.Podium.GetRankMultiLeaderboards:output_type -> podium.api.v1.GetRankMultiLeaderboardsResponse
-	33, // [33:51] is the sub-list for method output_type
-	15, // [15:33] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+
+// Deprecated: Use GetArchivedTopMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedTopMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{49}
 }
 
-func init() { file_proto_podium_api_v1_podium_proto_init() }
-func file_proto_podium_api_v1_podium_proto_init() {
-	if File_proto_podium_api_v1_podium_proto != nil {
-		return
+func (x *GetArchivedTopMembersRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_podium_api_v1_podium_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckRequest); i {
+	return ""
+}
+
+func (x *GetArchivedTopMembersRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetArchivedTopMembersRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetArchivedTopMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetArchivedTopMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Members []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GetArchivedTopMembersResponse) Reset() {
+	*x = GetArchivedTopMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivedTopMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedTopMembersResponse) ProtoMessage() {}
+
+func (x *GetArchivedTopMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedTopMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedTopMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetArchivedTopMembersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetArchivedTopMembersResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetArchivedMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	Order          string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *GetArchivedMemberRequest) Reset() {
+	*x = GetArchivedMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivedMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMemberRequest) ProtoMessage() {}
+
+func (x *GetArchivedMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetArchivedMemberRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *GetArchivedMemberRequest) GetMemberPublicId() string {
+	if x != nil {
+		return x.MemberPublicId
+	}
+	return ""
+}
+
+func (x *GetArchivedMemberRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type GetArchivedMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PublicID string  `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,5,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *GetArchivedMemberResponse) Reset() {
+	*x = GetArchivedMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivedMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMemberResponse) ProtoMessage() {}
+
+func (x *GetArchivedMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMemberResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetArchivedMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetArchivedMemberResponse) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *GetArchivedMemberResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetArchivedMemberResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetArchivedMemberResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type GetArchivedMemberRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+}
+
+func (x *GetArchivedMemberRewardRequest) Reset() {
+	*x = GetArchivedMemberRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivedMemberRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMemberRewardRequest) ProtoMessage() {}
+
+func (x *GetArchivedMemberRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMemberRewardRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedMemberRewardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetArchivedMemberRewardRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *GetArchivedMemberRewardRequest) GetMemberPublicId() string {
+	if x != nil {
+		return x.MemberPublicId
+	}
+	return ""
+}
+
+type GetArchivedMemberRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PublicID string  `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Final rank in the reward order.
+	Rank int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,5,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// Reward bracket assigned to the member, empty if its rank wasn't rewarded.
+	RewardBracket string `protobuf:"bytes,6,opt,name=reward_bracket,json=rewardBracket,proto3" json:"reward_bracket,omitempty"`
+}
+
+func (x *GetArchivedMemberRewardResponse) Reset() {
+	*x = GetArchivedMemberRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivedMemberRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMemberRewardResponse) ProtoMessage() {}
+
+func (x *GetArchivedMemberRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMemberRewardResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedMemberRewardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetArchivedMemberRewardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetArchivedMemberRewardResponse) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *GetArchivedMemberRewardResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetArchivedMemberRewardResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetArchivedMemberRewardResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *GetArchivedMemberRewardResponse) GetRewardBracket() string {
+	if x != nil {
+		return x.RewardBracket
+	}
+	return ""
+}
+
+type GetMemberTierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tiered league identification.
+	TieredLeagueId string `protobuf:"bytes,1,opt,name=tiered_league_id,json=tieredLeagueId,proto3" json:"tiered_league_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+}
+
+func (x *GetMemberTierRequest) Reset() {
+	*x = GetMemberTierRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberTierRequest) ProtoMessage() {}
+
+func (x *GetMemberTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberTierRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetMemberTierRequest) GetTieredLeagueId() string {
+	if x != nil {
+		return x.TieredLeagueId
+	}
+	return ""
+}
+
+func (x *GetMemberTierRequest) GetMemberPublicId() string {
+	if x != nil {
+		return x.MemberPublicId
+	}
+	return ""
+}
+
+type TierMovement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tier leaderboard bucket the member played the season in.
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	// Unix timestamp of the season end.
+	SeasonEnd int32 `protobuf:"varint,2,opt,name=season_end,json=seasonEnd,proto3" json:"season_end,omitempty"`
+	// The tier the member played the season in.
+	Tier string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Final rank in the bucket, starting at 1.
+	Rank int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// How the member moved: promoted, relegated or stayed.
+	Movement string `protobuf:"bytes,5,opt,name=movement,proto3" json:"movement,omitempty"`
+	// The tier the member plays the next season in.
+	NextTier string `protobuf:"bytes,6,opt,name=next_tier,json=nextTier,proto3" json:"next_tier,omitempty"`
+}
+
+func (x *TierMovement) Reset() {
+	*x = TierMovement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TierMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TierMovement) ProtoMessage() {}
+
+func (x *TierMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TierMovement.ProtoReflect.Descriptor instead.
+func (*TierMovement) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TierMovement) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *TierMovement) GetSeasonEnd() int32 {
+	if x != nil {
+		return x.SeasonEnd
+	}
+	return 0
+}
+
+func (x *TierMovement) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *TierMovement) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TierMovement) GetMovement() string {
+	if x != nil {
+		return x.Movement
+	}
+	return ""
+}
+
+func (x *TierMovement) GetNextTier() string {
+	if x != nil {
+		return x.NextTier
+	}
+	return ""
+}
+
+type GetMemberTierResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PublicID string `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// The tier the member plays the current season in, the lowest one for new members.
+	Tier string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// The tier leaderboard of the current season, member scores are written to it.
+	LeaderboardId string `protobuf:"bytes,4,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	// How the member finished previous seasons, from the oldest to the latest.
+	History []*TierMovement `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *GetMemberTierResponse) Reset() {
+	*x = GetMemberTierResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberTierResponse) ProtoMessage() {}
+
+func (x *GetMemberTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberTierResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberTierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetMemberTierResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetMemberTierResponse) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *GetMemberTierResponse) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *GetMemberTierResponse) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *GetMemberTierResponse) GetHistory() []*TierMovement {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type MatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// Where the member finished the match, starting at 1, equal placements are a draw.
+	Placement int32 `protobuf:"varint,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	// How the member finished the match instead of a placement: win, loss or draw.
+	Outcome string `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *MatchResult) Reset() {
+	*x = MatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResult) ProtoMessage() {}
+
+func (x *MatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
+func (*MatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MatchResult) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *MatchResult) GetPlacement() int32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+func (x *MatchResult) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+type SubmitMatchResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaderboard identification.
+	LeaderboardId string                          `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Match         *SubmitMatchResultRequest_Match `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *SubmitMatchResultRequest) Reset() {
+	*x = SubmitMatchResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitMatchResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMatchResultRequest) ProtoMessage() {}
+
+func (x *SubmitMatchResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMatchResultRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMatchResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SubmitMatchResultRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *SubmitMatchResultRequest) GetMatch() *SubmitMatchResultRequest_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *SubmitMatchResultRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type MemberRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// The rating rounded to the closest integer, members are ranked by it.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank  int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The rank before the match, -1 for members that weren't ranked.
+	PreviousRank int32   `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	Rating       float64 `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// The rating before the match.
+	PreviousRating float64 `protobuf:"fixed64,6,opt,name=previous_rating,json=previousRating,proto3" json:"previous_rating,omitempty"`
+	// Glicko-2 rating deviation and volatility, zero for Elo leaderboards.
+	Deviation  float64 `protobuf:"fixed64,7,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility float64 `protobuf:"fixed64,8,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// How many matches of the member were rated.
+	Matches int32 `protobuf:"varint,9,opt,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *MemberRating) Reset() {
+	*x = MemberRating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRating) ProtoMessage() {}
+
+func (x *MemberRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRating.ProtoReflect.Descriptor instead.
+func (*MemberRating) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MemberRating) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *MemberRating) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MemberRating) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MemberRating) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *MemberRating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *MemberRating) GetPreviousRating() float64 {
+	if x != nil {
+		return x.PreviousRating
+	}
+	return 0
+}
+
+func (x *MemberRating) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *MemberRating) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *MemberRating) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type SubmitMatchResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The new rating of each member, in the order of the results.
+	Members []*MemberRating `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SubmitMatchResultResponse) Reset() {
+	*x = SubmitMatchResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitMatchResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMatchResultResponse) ProtoMessage() {}
+
+func (x *SubmitMatchResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMatchResultResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMatchResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubmitMatchResultResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitMatchResultResponse) GetMembers() []*MemberRating {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetMemberRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+}
+
+func (x *GetMemberRatingRequest) Reset() {
+	*x = GetMemberRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRatingRequest) ProtoMessage() {}
+
+func (x *GetMemberRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetMemberRatingRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *GetMemberRatingRequest) GetMemberPublicId() string {
+	if x != nil {
+		return x.MemberPublicId
+	}
+	return ""
+}
+
+type GetMemberRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PublicID string  `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Rating   float64 `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Glicko-2 rating deviation and volatility, zero for Elo leaderboards.
+	Deviation  float64 `protobuf:"fixed64,6,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility float64 `protobuf:"fixed64,7,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// How many matches of the member were rated.
+	Matches int32 `protobuf:"varint,8,opt,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *GetMemberRatingResponse) Reset() {
+	*x = GetMemberRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRatingResponse) ProtoMessage() {}
+
+func (x *GetMemberRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetMemberRatingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetMemberRatingResponse) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *GetMemberRatingResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetMemberRatingResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetMemberRatingResponse) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *GetMemberRatingResponse) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *GetMemberRatingResponse) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *GetMemberRatingResponse) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type ReportChallengeResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaderboard identification.
+	LeaderboardId string                                  `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Challenge     *ReportChallengeResultRequest_Challenge `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Makes the write happen once: repeating it with the same key within the idempotency window returns
+	// the first response instead of writing again. If empty, the write is applied every time.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ReportChallengeResultRequest) Reset() {
+	*x = ReportChallengeResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportChallengeResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChallengeResultRequest) ProtoMessage() {}
+
+func (x *ReportChallengeResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChallengeResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportChallengeResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReportChallengeResultRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+func (x *ReportChallengeResultRequest) GetChallenge() *ReportChallengeResultRequest_Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *ReportChallengeResultRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type LadderPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// The ladder position, starting at 1.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank  int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The rank before the challenge, -1 for members that joined the ladder with it.
+	PreviousRank int32 `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+}
+
+func (x *LadderPosition) Reset() {
+	*x = LadderPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LadderPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LadderPosition) ProtoMessage() {}
+
+func (x *LadderPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LadderPosition.ProtoReflect.Descriptor instead.
+func (*LadderPosition) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LadderPosition) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *LadderPosition) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LadderPosition) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LadderPosition) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+type ReportChallengeResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Challenger *LadderPosition `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Defender   *LadderPosition `protobuf:"bytes,3,opt,name=defender,proto3" json:"defender,omitempty"`
+}
+
+func (x *ReportChallengeResultResponse) Reset() {
+	*x = ReportChallengeResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportChallengeResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChallengeResultResponse) ProtoMessage() {}
+
+func (x *ReportChallengeResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChallengeResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportChallengeResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReportChallengeResultResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportChallengeResultResponse) GetChallenger() *LadderPosition {
+	if x != nil {
+		return x.Challenger
+	}
+	return nil
+}
+
+func (x *ReportChallengeResultResponse) GetDefender() *LadderPosition {
+	if x != nil {
+		return x.Defender
+	}
+	return nil
+}
+
+type GetQuarantinedMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+}
+
+func (x *GetQuarantinedMembersRequest) Reset() {
+	*x = GetQuarantinedMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuarantinedMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuarantinedMembersRequest) ProtoMessage() {}
+
+func (x *GetQuarantinedMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuarantinedMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetQuarantinedMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetQuarantinedMembersRequest) GetLeaderboardId() string {
+	if x != nil {
+		return x.LeaderboardId
+	}
+	return ""
+}
+
+type QuarantinedMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// The score the member would have ended up with.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The score rule the write broke.
+	Rule string `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Unix seconds of the write.
+	SubmittedAt int64 `protobuf:"varint,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (x *QuarantinedMember) Reset() {
+	*x = QuarantinedMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuarantinedMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedMember) ProtoMessage() {}
+
+func (x *QuarantinedMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedMember.ProtoReflect.Descriptor instead.
+func (*QuarantinedMember) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *QuarantinedMember) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *QuarantinedMember) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuarantinedMember) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *QuarantinedMember) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+type GetQuarantinedMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The latest quarantined write of each member, oldest first.
+	Members []*QuarantinedMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GetQuarantinedMembersResponse) Reset() {
+	*x = GetQuarantinedMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuarantinedMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuarantinedMembersResponse) ProtoMessage() {}
+
+func (x *GetQuarantinedMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuarantinedMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetQuarantinedMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetQuarantinedMembersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetQuarantinedMembersResponse) GetMembers() []*QuarantinedMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// MemberScore allow to provide score information about a single member.
+type BulkUpsertScoresRequest_MemberScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TODO: use json_name on variables like this to respect .proto naming format.
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// Score must be exactly representable by the leaderboard score type: integers between -9007199254740991
+	// and 9007199254740991 by default, any finite number for float leaderboards or numbers with up to the
+	// configured fraction digits for decimal leaderboards.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	// Required by leaderboards with a composite score, which ignore score.
+	Scores []float64 `protobuf:"fixed64,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// If set, the score is only written if the member's current score equals it.
+	ExpectedScore *float64 `protobuf:"fixed64,4,opt,name=expected_score,json=expectedScore,proto3,oneof" json:"expected_score,omitempty"`
+	// If set to true, the score is only written if the member has no score yet.
+	IfAbsent bool `protobuf:"varint,5,opt,name=if_absent,json=ifAbsent,proto3" json:"if_absent,omitempty"`
+	// If set to true, the score is only written if the member already has a score.
+	IfPresent bool `protobuf:"varint,6,opt,name=if_present,json=ifPresent,proto3" json:"if_present,omitempty"`
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) Reset() {
+	*x = BulkUpsertScoresRequest_MemberScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertScoresRequest_MemberScore) ProtoMessage() {}
+
+func (x *BulkUpsertScoresRequest_MemberScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertScoresRequest_MemberScore.ProtoReflect.Descriptor instead.
+func (*BulkUpsertScoresRequest_MemberScore) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetExpectedScore() float64 {
+	if x != nil && x.ExpectedScore != nil {
+		return *x.ExpectedScore
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetIfAbsent() bool {
+	if x != nil {
+		return x.IfAbsent
+	}
+	return false
+}
+
+func (x *BulkUpsertScoresRequest_MemberScore) GetIfPresent() bool {
+	if x != nil {
+		return x.IfPresent
+	}
+	return false
+}
+
+// ScoreUpserts represent multiple score submissions.
+type BulkUpsertScoresRequest_MemberScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*BulkUpsertScoresRequest_MemberScore `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *BulkUpsertScoresRequest_MemberScores) Reset() {
+	*x = BulkUpsertScoresRequest_MemberScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpsertScoresRequest_MemberScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertScoresRequest_MemberScores) ProtoMessage() {}
+
+func (x *BulkUpsertScoresRequest_MemberScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertScoresRequest_MemberScores.ProtoReflect.Descriptor instead.
+func (*BulkUpsertScoresRequest_MemberScores) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *BulkUpsertScoresRequest_MemberScores) GetMembers() []*BulkUpsertScoresRequest_MemberScore {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ScoreChange is the score payload when upserting a score.
+type UpsertScoreRequest_ScoreChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	// Required by leaderboards with a composite score, which ignore score.
+	Scores []float64 `protobuf:"fixed64,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// If set, the score is only written if the member's current score equals it.
+	ExpectedScore *float64 `protobuf:"fixed64,3,opt,name=expected_score,json=expectedScore,proto3,oneof" json:"expected_score,omitempty"`
+	// If set to true, the score is only written if the member has no score yet.
+	IfAbsent bool `protobuf:"varint,4,opt,name=if_absent,json=ifAbsent,proto3" json:"if_absent,omitempty"`
+	// If set to true, the score is only written if the member already has a score.
+	IfPresent bool `protobuf:"varint,5,opt,name=if_present,json=ifPresent,proto3" json:"if_present,omitempty"`
+}
+
+func (x *UpsertScoreRequest_ScoreChange) Reset() {
+	*x = UpsertScoreRequest_ScoreChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertScoreRequest_ScoreChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertScoreRequest_ScoreChange) ProtoMessage() {}
+
+func (x *UpsertScoreRequest_ScoreChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertScoreRequest_ScoreChange.ProtoReflect.Descriptor instead.
+func (*UpsertScoreRequest_ScoreChange) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *UpsertScoreRequest_ScoreChange) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpsertScoreRequest_ScoreChange) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *UpsertScoreRequest_ScoreChange) GetExpectedScore() float64 {
+	if x != nil && x.ExpectedScore != nil {
+		return *x.ExpectedScore
+	}
+	return 0
+}
+
+func (x *UpsertScoreRequest_ScoreChange) GetIfAbsent() bool {
+	if x != nil {
+		return x.IfAbsent
+	}
+	return false
+}
+
+func (x *UpsertScoreRequest_ScoreChange) GetIfPresent() bool {
+	if x != nil {
+		return x.IfPresent
+	}
+	return false
+}
+
+// Body represents the increment payload.
+type IncrementScoreRequest_Body struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Increment float64 `protobuf:"fixed64,1,opt,name=increment,proto3" json:"increment,omitempty"`
+}
+
+func (x *IncrementScoreRequest_Body) Reset() {
+	*x = IncrementScoreRequest_Body{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrementScoreRequest_Body) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementScoreRequest_Body) ProtoMessage() {}
+
+func (x *IncrementScoreRequest_Body) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementScoreRequest_Body.ProtoReflect.Descriptor instead.
+func (*IncrementScoreRequest_Body) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *IncrementScoreRequest_Body) GetIncrement() float64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+// Member information returned for GetMembers request.
+type GetMembersResponse_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
+	ExpireAt int32 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Member rank for all members returned in this request.
+	Position int32             `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,8,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *GetMembersResponse_Member) Reset() {
+	*x = GetMembersResponse_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMembersResponse_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMembersResponse_Member) ProtoMessage() {}
+
+func (x *GetMembersResponse_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMembersResponse_Member.ProtoReflect.Descriptor instead.
+func (*GetMembersResponse_Member) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetMembersResponse_Member) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *GetMembersResponse_Member) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetMembersResponse_Member) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetMembersResponse_Member) GetExpireAt() int32 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *GetMembersResponse_Member) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetMembersResponse_Member) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetMembersResponse_Member) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// ScoreMultiChange is the payload to update the score of a member on multiple leaderboards.
+type UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score        float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Leaderboards []string `protobuf:"bytes,2,rep,name=leaderboards,proto3" json:"leaderboards,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	// Required by leaderboards with a composite score, which ignore score.
+	Scores []float64 `protobuf:"fixed64,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) Reset() {
+	*x = UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) ProtoMessage() {}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange.ProtoReflect.Descriptor instead.
+func (*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) GetLeaderboards() []string {
+	if x != nil {
+		return x.Leaderboards
+	}
+	return nil
+}
+
+func (x *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// Member represents the information regarding a single member in response to a multi upsert score.
+type UpsertScoreMultiLeaderboardsResponse_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The previous rank of the player in the leaderboard, if requested.
+	PreviousRank int32 `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
+	ExpireAt      int32  `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	LeaderboardID string `protobuf:"bytes,8,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	// If the stored score was changed by the update policy.
+	ScoreChanged bool `protobuf:"varint,9,opt,name=score_changed,json=scoreChanged,proto3" json:"score_changed,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,10,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// If the score broke a leaderboard score rule and was held for review instead of being ranked.
+	Quarantined bool `protobuf:"varint,11,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) Reset() {
+	*x = UpsertScoreMultiLeaderboardsResponse_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertScoreMultiLeaderboardsResponse_Member) ProtoMessage() {}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertScoreMultiLeaderboardsResponse_Member.ProtoReflect.Descriptor instead.
+func (*UpsertScoreMultiLeaderboardsResponse_Member) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetExpireAt() int32 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetLeaderboardID() string {
+	if x != nil {
+		return x.LeaderboardID
+	}
+	return ""
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetScoreChanged() bool {
+	if x != nil {
+		return x.ScoreChanged
+	}
+	return false
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *UpsertScoreMultiLeaderboardsResponse_Member) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+// Member represents member information retrieved from one the leaderboards during MultiGetRankResponse operation.
+type GetRankMultiLeaderboardsResponse_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderboardID string  `protobuf:"bytes,1,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	Rank          int32   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score         float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	ExpireAt      int32   `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,6,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) Reset() {
+	*x = GetRankMultiLeaderboardsResponse_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankMultiLeaderboardsResponse_Member) ProtoMessage() {}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankMultiLeaderboardsResponse_Member.ProtoReflect.Descriptor instead.
+func (*GetRankMultiLeaderboardsResponse_Member) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) GetLeaderboardID() string {
+	if x != nil {
+		return x.LeaderboardID
+	}
+	return ""
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) GetExpireAt() int32 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *GetRankMultiLeaderboardsResponse_Member) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// Member information returned for BulkUpsertScores request.
+type BulkUpsertScoresResponse_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The previous rank of the player in the leaderboard, if requested.
+	PreviousRank int32 `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested).
+	ExpireAt int32 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// If the stored score was changed by the update policy.
+	ScoreChanged bool `protobuf:"varint,6,opt,name=score_changed,json=scoreChanged,proto3" json:"score_changed,omitempty"`
+	// Values of a composite score, one per leaderboard criterion, in criteria order.
+	Scores []float64 `protobuf:"fixed64,7,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// If the score broke a leaderboard score rule and was held for review instead of being ranked.
+	Quarantined bool `protobuf:"varint,8,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+}
+
+func (x *BulkUpsertScoresResponse_Member) Reset() {
+	*x = BulkUpsertScoresResponse_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpsertScoresResponse_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertScoresResponse_Member) ProtoMessage() {}
+
+func (x *BulkUpsertScoresResponse_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertScoresResponse_Member.ProtoReflect.Descriptor instead.
+func (*BulkUpsertScoresResponse_Member) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetPublicID() string {
+	if x != nil {
+		return x.PublicID
+	}
+	return ""
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetExpireAt() int32 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetScoreChanged() bool {
+	if x != nil {
+		return x.ScoreChanged
+	}
+	return false
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *BulkUpsertScoresResponse_Member) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+// Match represents the match payload.
+type SubmitMatchResultRequest_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results of the members of the match, at least two.
+	Results []*MatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SubmitMatchResultRequest_Match) Reset() {
+	*x = SubmitMatchResultRequest_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitMatchResultRequest_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMatchResultRequest_Match) ProtoMessage() {}
+
+func (x *SubmitMatchResultRequest_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMatchResultRequest_Match.ProtoReflect.Descriptor instead.
+func (*SubmitMatchResultRequest_Match) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *SubmitMatchResultRequest_Match) GetResults() []*MatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Challenge represents the challenge payload.
+type ReportChallengeResultRequest_Challenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The member that challenged a member ranked above it.
+	ChallengerPublicId string `protobuf:"bytes,1,opt,name=challenger_public_id,json=challengerPublicId,proto3" json:"challenger_public_id,omitempty"`
+	// The challenged member.
+	DefenderPublicId string `protobuf:"bytes,2,opt,name=defender_public_id,json=defenderPublicId,proto3" json:"defender_public_id,omitempty"`
+	// If set to true, the challenger takes the defender position.
+	ChallengerWon bool `protobuf:"varint,3,opt,name=challenger_won,json=challengerWon,proto3" json:"challenger_won,omitempty"`
+}
+
+func (x *ReportChallengeResultRequest_Challenge) Reset() {
+	*x = ReportChallengeResultRequest_Challenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_podium_api_v1_podium_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportChallengeResultRequest_Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChallengeResultRequest_Challenge) ProtoMessage() {}
+
+func (x *ReportChallengeResultRequest_Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_podium_api_v1_podium_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChallengeResultRequest_Challenge.ProtoReflect.Descriptor instead.
+func (*ReportChallengeResultRequest_Challenge) Descriptor() ([]byte, []int) {
+	return file_proto_podium_api_v1_podium_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *ReportChallengeResultRequest_Challenge) GetChallengerPublicId() string {
+	if x != nil {
+		return x.ChallengerPublicId
+	}
+	return ""
+}
+
+func (x *ReportChallengeResultRequest_Challenge) GetDefenderPublicId() string {
+	if x != nil {
+		return x.DefenderPublicId
+	}
+	return ""
+}
+
+func (x *ReportChallengeResultRequest_Challenge) GetChallengerWon() bool {
+	if x != nil {
+		return x.ChallengerWon
+	}
+	return false
+}
+
+var File_proto_podium_api_v1_podium_proto protoreflect.FileDescriptor
+
+var file_proto_podium_api_v1_podium_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0x41, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x22, 0xd4, 0x04, 0x0a, 0x17, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c,
+	0x12, 0x58, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x0c, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0xd2, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x66, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x66, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x66, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x66, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x5c, 0x0a,
+	0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x06,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3f, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xf7, 0x03, 0x0a, 0x12, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72,
+	0x65, 0x76, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x54, 0x4c, 0x12, 0x50, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x1a, 0xb6, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x66, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x66, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x66,
+	0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x66, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3c, 0x0a, 0x13,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x3d, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x1a, 0x24, 0x0a, 0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x96, 0x02, 0x0a,
+	0x13, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x41, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54,
+	0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54,
+	0x4c, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65,
+	0x22, 0xc2, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x1a, 0xb0, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22, 0x4f, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x4d,
+	0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x61, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xeb, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x49, 0x66, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e,
+	0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22,
+	0x93, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e,
+	0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x23, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x54, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x54, 0x4c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x71, 0x0a, 0x12, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70,
+	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x1a, 0x64, 0x0a, 0x10, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xac, 0x03, 0x0a, 0x24, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x95,
+	0x02, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x54, 0x4c, 0x22,
+	0x9c, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4e,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x8d,
+	0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x87,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf0, 0x02, 0x0a, 0x18, 0x42, 0x75, 0x6c,
+	0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x48, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0xef, 0x01, 0x0a, 0x06, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x6a, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x22, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
+	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b,
+	0x0a, 0x22, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x1d, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a,
+	0x22, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a,
+	0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3f, 0x0a, 0x23, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x0d,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x45, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x76,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x6a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x81,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x6a,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x69, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x54,
+	0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x69,
+	0x65, 0x72, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x61, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x3d, 0x0a, 0x05, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x6c, 0x0a,
+	0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x76, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x92, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x6e, 0x22, 0x7b, 0x0a,
+	0x0e, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0xb3, 0x01, 0x0a, 0x1d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x64, 0x64, 0x65,
+	0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x22, 0x45, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x11, 0x51, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x32, 0x82, 0x26, 0x0a,
+	0x06, 0x50, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x54, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1d, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a,
+	0x13, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x3a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x1a, 0x1a, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0xa0, 0x01,
+	0x0a, 0x0b, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e,
+	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x0c, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x34, 0x2f, 0x6c, 0x2f, 0x7b,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x23, 0x12, 0x21, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2d,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x3a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x32, 0x34, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x12, 0x2e, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x76, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x20, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x2a, 0x2e, 0x2f, 0x6c,
+	0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x0d,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e,
+	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x2a, 0x1b, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x85, 0x01,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35,
+	0x12, 0x33, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x64, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
+	0x12, 0x35, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x90, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x7d, 0x2f, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x70,
+	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
+	0x25, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x70, 0x2f, 0x7b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x70, 0x2d, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x32, 0x3a, 0x12, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x1c, 0x2f, 0x6d, 0x2f, 0x7b, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6d,
+	0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x3a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x0d, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0xa0,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x98, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x30, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0xb2, 0x01, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x70,
+	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x1e, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xac, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x2a, 0x1e, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x2f,
+	0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x97, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0xa9, 0x01, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x54, 0x6f, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x2f, 0x74, 0x6f, 0x70, 0x2f, 0x7b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12, 0xa6, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x70,
+	0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xbf, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2d, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x64,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54,
+	0x69, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x69, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x74, 0x69, 0x65, 0x72, 0x65, 0x64, 0x2d,
+	0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x69, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x1b, 0x2f,
+	0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0xa5, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x22, 0x1e, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2b,
+	0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f,
+	0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x6c, 0x2f, 0x7b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x42, 0x54, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x6f, 0x70, 0x66, 0x72, 0x65, 0x65,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x0d, 0x70, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x50, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_podium_api_v1_podium_proto_rawDescOnce sync.Once
+	file_proto_podium_api_v1_podium_proto_rawDescData = file_proto_podium_api_v1_podium_proto_rawDesc
+)
+
+func file_proto_podium_api_v1_podium_proto_rawDescGZIP() []byte {
+	file_proto_podium_api_v1_podium_proto_rawDescOnce.Do(func() {
+		file_proto_podium_api_v1_podium_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_podium_api_v1_podium_proto_rawDescData)
+	})
+	return file_proto_podium_api_v1_podium_proto_rawDescData
+}
+
+var file_proto_podium_api_v1_podium_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_proto_podium_api_v1_podium_proto_goTypes = []interface{}{
+	(*HealthCheckRequest)(nil),                   // 0: podium.api.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                  // 1: podium.api.v1.HealthCheckResponse
+	(*StatusResponse)(nil),                       // 2: podium.api.v1.StatusResponse
+	(*RemoveLeaderboardRequest)(nil),             // 3: podium.api.v1.RemoveLeaderboardRequest
+	(*BulkUpsertScoresRequest)(nil),              // 4: podium.api.v1.BulkUpsertScoresRequest
+	(*Member)(nil),                               // 5: podium.api.v1.Member
+	(*UpsertScoreRequest)(nil),                   // 6: podium.api.v1.UpsertScoreRequest
+	(*TotalMembersRequest)(nil),                  // 7: podium.api.v1.TotalMembersRequest
+	(*TotalMembersResponse)(nil),                 // 8: podium.api.v1.TotalMembersResponse
+	(*IncrementScoreRequest)(nil),                // 9: podium.api.v1.IncrementScoreRequest
+	(*GetMemberRequest)(nil),                     // 10: podium.api.v1.GetMemberRequest
+	(*UpsertScoreResponse)(nil),                  // 11: podium.api.v1.UpsertScoreResponse
+	(*IncrementScoreResponse)(nil),               // 12: podium.api.v1.IncrementScoreResponse
+	(*GetMemberResponse)(nil),                    // 13: podium.api.v1.GetMemberResponse
+	(*GetMembersRequest)(nil),                    // 14: podium.api.v1.GetMembersRequest
+	(*GetMembersResponse)(nil),                   // 15: podium.api.v1.GetMembersResponse
+	(*RemoveMemberRequest)(nil),                  // 16: podium.api.v1.RemoveMemberRequest
+	(*RemoveMembersRequest)(nil),                 // 17: podium.api.v1.RemoveMembersRequest
+	(*RemoveLeaderboardResponse)(nil),            // 18: podium.api.v1.RemoveLeaderboardResponse
+	(*RemoveMemberResponse)(nil),                 // 19: podium.api.v1.RemoveMemberResponse
+	(*RemoveMembersResponse)(nil),                // 20: podium.api.v1.RemoveMembersResponse
+	(*GetRankRequest)(nil),                       // 21: podium.api.v1.GetRankRequest
+	(*GetRankResponse)(nil),                      // 22: podium.api.v1.GetRankResponse
+	(*GetAroundMemberRequest)(nil),               // 23: podium.api.v1.GetAroundMemberRequest
+	(*GetTopMembersRequest)(nil),                 // 24: podium.api.v1.GetTopMembersRequest
+	(*GetTopPercentageRequest)(nil),              // 25: podium.api.v1.GetTopPercentageRequest
+	(*UpsertScoreMultiLeaderboardsRequest)(nil),  // 26: podium.api.v1.UpsertScoreMultiLeaderboardsRequest
+	(*UpsertScoreMultiLeaderboardsResponse)(nil), // 27: podium.api.v1.UpsertScoreMultiLeaderboardsResponse
+	(*GetRankMultiLeaderboardsRequest)(nil),      // 28: podium.api.v1.GetRankMultiLeaderboardsRequest
+	(*GetRankMultiLeaderboardsResponse)(nil),     // 29: podium.api.v1.GetRankMultiLeaderboardsResponse
+	(*GetAroundScoreRequest)(nil),                // 30: podium.api.v1.GetAroundScoreRequest
+	(*BulkUpsertScoresResponse)(nil),             // 31: podium.api.v1.BulkUpsertScoresResponse
+	(*GetAroundMemberResponse)(nil),              // 32: podium.api.v1.GetAroundMemberResponse
+	(*GetAroundScoreResponse)(nil),               // 33: podium.api.v1.GetAroundScoreResponse
+	(*GetTopMembersResponse)(nil),                // 34: podium.api.v1.GetTopMembersResponse
+	(*GetTopPercentageResponse)(nil),             // 35: podium.api.v1.GetTopPercentageResponse
+	(*LeaderboardDefinition)(nil),                // 36: podium.api.v1.LeaderboardDefinition
+	(*CreateLeaderboardDefinitionRequest)(nil),   // 37: podium.api.v1.CreateLeaderboardDefinitionRequest
+	(*GetLeaderboardDefinitionRequest)(nil),      // 38: podium.api.v1.GetLeaderboardDefinitionRequest
+	(*ListLeaderboardDefinitionsRequest)(nil),    // 39: podium.api.v1.ListLeaderboardDefinitionsRequest
+	(*UpdateLeaderboardDefinitionRequest)(nil),   // 40: podium.api.v1.UpdateLeaderboardDefinitionRequest
+	(*RemoveLeaderboardDefinitionRequest)(nil),   // 41: podium.api.v1.RemoveLeaderboardDefinitionRequest
+	(*LeaderboardDefinitionResponse)(nil),        // 42: podium.api.v1.LeaderboardDefinitionResponse
+	(*ListLeaderboardDefinitionsResponse)(nil),   // 43: podium.api.v1.ListLeaderboardDefinitionsResponse
+	(*RemoveLeaderboardDefinitionResponse)(nil),  // 44: podium.api.v1.RemoveLeaderboardDefinitionResponse
+	(*LeaderboardArchive)(nil),                   // 45: podium.api.v1.LeaderboardArchive
+	(*RewardBracket)(nil),                        // 46: podium.api.v1.RewardBracket
+	(*GetLeaderboardArchiveRequest)(nil),         // 47: podium.api.v1.GetLeaderboardArchiveRequest
+	(*GetLeaderboardArchiveResponse)(nil),        // 48: podium.api.v1.GetLeaderboardArchiveResponse
+	(*GetArchivedTopMembersRequest)(nil),         // 49: podium.api.v1.GetArchivedTopMembersRequest
+	(*GetArchivedTopMembersResponse)(nil),        // 50: podium.api.v1.GetArchivedTopMembersResponse
+	(*GetArchivedMemberRequest)(nil),             // 51: podium.api.v1.GetArchivedMemberRequest
+	(*GetArchivedMemberResponse)(nil),            // 52: podium.api.v1.GetArchivedMemberResponse
+	(*GetArchivedMemberRewardRequest)(nil),       // 53: podium.api.v1.GetArchivedMemberRewardRequest
+	(*GetArchivedMemberRewardResponse)(nil),      // 54: podium.api.v1.GetArchivedMemberRewardResponse
+	(*GetMemberTierRequest)(nil),                 // 55: podium.api.v1.GetMemberTierRequest
+	(*TierMovement)(nil),                         // 56: podium.api.v1.TierMovement
+	(*GetMemberTierResponse)(nil),                // 57: podium.api.v1.GetMemberTierResponse
+	(*MatchResult)(nil),                          // 58: podium.api.v1.MatchResult
+	(*SubmitMatchResultRequest)(nil),             // 59: podium.api.v1.SubmitMatchResultRequest
+	(*MemberRating)(nil),                         // 60: podium.api.v1.MemberRating
+	(*SubmitMatchResultResponse)(nil),            // 61: podium.api.v1.SubmitMatchResultResponse
+	(*GetMemberRatingRequest)(nil),               // 62: podium.api.v1.GetMemberRatingRequest
+	(*GetMemberRatingResponse)(nil),              // 63: podium.api.v1.GetMemberRatingResponse
+	(*ReportChallengeResultRequest)(nil),         // 64: podium.api.v1.ReportChallengeResultRequest
+	(*LadderPosition)(nil),                       // 65: podium.api.v1.LadderPosition
+	(*ReportChallengeResultResponse)(nil),        // 66: podium.api.v1.ReportChallengeResultResponse
+	(*GetQuarantinedMembersRequest)(nil),         // 67: podium.api.v1.GetQuarantinedMembersRequest
+	(*QuarantinedMember)(nil),                    // 68: podium.api.v1.QuarantinedMember
+	(*GetQuarantinedMembersResponse)(nil),        // 69: podium.api.v1.GetQuarantinedMembersResponse
+	(*BulkUpsertScoresRequest_MemberScore)(nil),  // 70: podium.api.v1.BulkUpsertScoresRequest.MemberScore
+	(*BulkUpsertScoresRequest_MemberScores)(nil), // 71: podium.api.v1.BulkUpsertScoresRequest.MemberScores
+	nil,                                    // 72: podium.api.v1.Member.MetadataEntry
+	(*UpsertScoreRequest_ScoreChange)(nil), // 73: podium.api.v1.UpsertScoreRequest.ScoreChange
+	(*IncrementScoreRequest_Body)(nil),     // 74: podium.api.v1.IncrementScoreRequest.Body
+	(*GetMembersResponse_Member)(nil),      // 75: podium.api.v1.GetMembersResponse.Member
+	nil,                                    // 76: podium.api.v1.GetMembersResponse.Member.MetadataEntry
+	(*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange)(nil), // 77: podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange
+	(*UpsertScoreMultiLeaderboardsResponse_Member)(nil),          // 78: podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member
+	(*GetRankMultiLeaderboardsResponse_Member)(nil),              // 79: podium.api.v1.GetRankMultiLeaderboardsResponse.Member
+	(*BulkUpsertScoresResponse_Member)(nil),                      // 80: podium.api.v1.BulkUpsertScoresResponse.Member
+	(*SubmitMatchResultRequest_Match)(nil),                       // 81: podium.api.v1.SubmitMatchResultRequest.Match
+	(*ReportChallengeResultRequest_Challenge)(nil),               // 82: podium.api.v1.ReportChallengeResultRequest.Challenge
+	(*emptypb.Empty)(nil),                                        // 83: google.protobuf.Empty
+}
+var file_proto_podium_api_v1_podium_proto_depIdxs = []int32{
+	71, // 0: podium.api.v1.BulkUpsertScoresRequest.member_scores:type_name -> podium.api.v1.BulkUpsertScoresRequest.MemberScores
+	72, // 1: podium.api.v1.Member.metadata:type_name -> podium.api.v1.Member.MetadataEntry
+	73, // 2: podium.api.v1.UpsertScoreRequest.score_change:type_name -> podium.api.v1.UpsertScoreRequest.ScoreChange
+	74, // 3: podium.api.v1.IncrementScoreRequest.body:type_name -> podium.api.v1.IncrementScoreRequest.Body
+	75, // 4: podium.api.v1.GetMembersResponse.members:type_name -> podium.api.v1.GetMembersResponse.Member
+	77, // 5: podium.api.v1.UpsertScoreMultiLeaderboardsRequest.score_multi_change:type_name -> podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange
+	78, // 6: podium.api.v1.UpsertScoreMultiLeaderboardsResponse.scores:type_name -> podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member
+	79, // 7: podium.api.v1.GetRankMultiLeaderboardsResponse.scores:type_name -> podium.api.v1.GetRankMultiLeaderboardsResponse.Member
+	80, // 8: podium.api.v1.BulkUpsertScoresResponse.members:type_name -> podium.api.v1.BulkUpsertScoresResponse.Member
+	5,  // 9: podium.api.v1.GetAroundMemberResponse.members:type_name -> podium.api.v1.Member
+	5,  // 10: podium.api.v1.GetAroundScoreResponse.members:type_name -> podium.api.v1.Member
+	5,  // 11: podium.api.v1.GetTopMembersResponse.members:type_name -> podium.api.v1.Member
+	5,  // 12: podium.api.v1.GetTopPercentageResponse.members:type_name -> podium.api.v1.Member
+	36, // 13: podium.api.v1.CreateLeaderboardDefinitionRequest.definition:type_name -> podium.api.v1.LeaderboardDefinition
+	36, // 14: podium.api.v1.UpdateLeaderboardDefinitionRequest.definition:type_name -> podium.api.v1.LeaderboardDefinition
+	36, // 15: podium.api.v1.LeaderboardDefinitionResponse.definition:type_name -> podium.api.v1.LeaderboardDefinition
+	36, // 16: podium.api.v1.ListLeaderboardDefinitionsResponse.definitions:type_name -> podium.api.v1.LeaderboardDefinition
+	46, // 17: podium.api.v1.LeaderboardArchive.reward_brackets:type_name -> podium.api.v1.RewardBracket
+	45, // 18: podium.api.v1.GetLeaderboardArchiveResponse.archive:type_name -> podium.api.v1.LeaderboardArchive
+	5,  // 19: podium.api.v1.GetArchivedTopMembersResponse.members:type_name -> podium.api.v1.Member
+	56, // 20: podium.api.v1.GetMemberTierResponse.history:type_name -> podium.api.v1.TierMovement
+	81, // 21: podium.api.v1.SubmitMatchResultRequest.match:type_name -> podium.api.v1.SubmitMatchResultRequest.Match
+	60, // 22: podium.api.v1.SubmitMatchResultResponse.members:type_name -> podium.api.v1.MemberRating
+	82, // 23: podium.api.v1.ReportChallengeResultRequest.challenge:type_name -> podium.api.v1.ReportChallengeResultRequest.Challenge
+	65, // 24: podium.api.v1.ReportChallengeResultResponse.challenger:type_name -> podium.api.v1.LadderPosition
+	65, // 25: podium.api.v1.ReportChallengeResultResponse.defender:type_name -> podium.api.v1.LadderPosition
+	68, // 26: podium.api.v1.GetQuarantinedMembersResponse.members:type_name -> podium.api.v1.QuarantinedMember
+	70, // 27: podium.api.v1.BulkUpsertScoresRequest.MemberScores.members:type_name -> podium.api.v1.BulkUpsertScoresRequest.MemberScore
+	76, // 28: podium.api.v1.GetMembersResponse.Member.metadata:type_name -> podium.api.v1.GetMembersResponse.Member.MetadataEntry
+	58, // 29: podium.api.v1.SubmitMatchResultRequest.Match.results:type_name -> podium.api.v1.MatchResult
+	0,  // 30: podium.api.v1.Podium.HealthCheck:input_type -> podium.api.v1.HealthCheckRequest
+	83, // 31: podium.api.v1.Podium.Status:input_type -> google.protobuf.Empty
+	3,  // 32: podium.api.v1.Podium.RemoveLeaderboard:input_type -> podium.api.v1.RemoveLeaderboardRequest
+	4,  // 33: podium.api.v1.Podium.BulkUpsertScores:input_type -> podium.api.v1.BulkUpsertScoresRequest
+	6,  // 34: podium.api.v1.Podium.UpsertScore:input_type -> podium.api.v1.UpsertScoreRequest
+	7,  // 35: podium.api.v1.Podium.TotalMembers:input_type -> podium.api.v1.TotalMembersRequest
+	9,  // 36: podium.api.v1.Podium.IncrementScore:input_type -> podium.api.v1.IncrementScoreRequest
+	10, // 37: podium.api.v1.Podium.GetMember:input_type -> podium.api.v1.GetMemberRequest
+	14, // 38: podium.api.v1.Podium.GetMembers:input_type -> podium.api.v1.GetMembersRequest
+	16, // 39: podium.api.v1.Podium.RemoveMember:input_type -> podium.api.v1.RemoveMemberRequest
+	17, // 40: podium.api.v1.Podium.RemoveMembers:input_type -> podium.api.v1.RemoveMembersRequest
+	21, // 41: podium.api.v1.Podium.GetRank:input_type -> podium.api.v1.GetRankRequest
+	23, // 42: podium.api.v1.Podium.GetAroundMember:input_type -> podium.api.v1.GetAroundMemberRequest
+	30, // 43: podium.api.v1.Podium.GetAroundScore:input_type -> podium.api.v1.GetAroundScoreRequest
+	24, // 44: podium.api.v1.Podium.GetTopMembers:input_type -> podium.api.v1.GetTopMembersRequest
+	25, // 45: podium.api.v1.Podium.GetTopPercentage:input_type -> podium.api.v1.GetTopPercentageRequest
+	26, // 46: podium.api.v1.Podium.UpsertScoreMultiLeaderboards:input_type -> podium.api.v1.UpsertScoreMultiLeaderboardsRequest
+	28, // 47: podium.api.v1.Podium.GetRankMultiLeaderboards:input_type -> podium.api.v1.GetRankMultiLeaderboardsRequest
+	37, // 48: podium.api.v1.Podium.CreateLeaderboardDefinition:input_type -> podium.api.v1.CreateLeaderboardDefinitionRequest
+	38, // 49: podium.api.v1.Podium.GetLeaderboardDefinition:input_type -> podium.api.v1.GetLeaderboardDefinitionRequest
+	39, // 50: podium.api.v1.Podium.ListLeaderboardDefinitions:input_type -> podium.api.v1.ListLeaderboardDefinitionsRequest
+	40, // 51: podium.api.v1.Podium.UpdateLeaderboardDefinition:input_type -> podium.api.v1.UpdateLeaderboardDefinitionRequest
+	41, // 52: podium.api.v1.Podium.RemoveLeaderboardDefinition:input_type -> podium.api.v1.RemoveLeaderboardDefinitionRequest
+	47, // 53: podium.api.v1.Podium.GetLeaderboardArchive:input_type -> podium.api.v1.GetLeaderboardArchiveRequest
+	49, // 54: podium.api.v1.Podium.GetArchivedTopMembers:input_type -> podium.api.v1.GetArchivedTopMembersRequest
+	51, // 55: podium.api.v1.Podium.GetArchivedMember:input_type -> podium.api.v1.GetArchivedMemberRequest
+	53, // 56: podium.api.v1.Podium.GetArchivedMemberReward:input_type -> podium.api.v1.GetArchivedMemberRewardRequest
+	55, // 57: podium.api.v1.Podium.GetMemberTier:input_type -> podium.api.v1.GetMemberTierRequest
+	59, // 58: podium.api.v1.Podium.SubmitMatchResult:input_type -> podium.api.v1.SubmitMatchResultRequest
+	62, // 59: podium.api.v1.Podium.GetMemberRating:input_type -> podium.api.v1.GetMemberRatingRequest
+	64, // 60: podium.api.v1.Podium.ReportChallengeResult:input_type -> podium.api.v1.ReportChallengeResultRequest
+	67, // 61: podium.api.v1.Podium.GetQuarantinedMembers:input_type -> podium.api.v1.GetQuarantinedMembersRequest
+	1,  // 62: podium.api.v1.Podium.HealthCheck:output_type -> podium.api.v1.HealthCheckResponse
+	2,  // 63: podium.api.v1.Podium.Status:output_type -> podium.api.v1.StatusResponse
+	18, // 64: podium.api.v1.Podium.RemoveLeaderboard:output_type -> podium.api.v1.RemoveLeaderboardResponse
+	31, // 65: podium.api.v1.Podium.BulkUpsertScores:output_type -> podium.api.v1.BulkUpsertScoresResponse
+	11, // 66: podium.api.v1.Podium.UpsertScore:output_type -> podium.api.v1.UpsertScoreResponse
+	8,  // 67: podium.api.v1.Podium.TotalMembers:output_type -> podium.api.v1.TotalMembersResponse
+	12, // 68: podium.api.v1.Podium.IncrementScore:output_type -> podium.api.v1.IncrementScoreResponse
+	13, // 69: podium.api.v1.Podium.GetMember:output_type -> podium.api.v1.GetMemberResponse
+	15, // 70: podium.api.v1.Podium.GetMembers:output_type -> podium.api.v1.GetMembersResponse
+	19, // 71: podium.api.v1.Podium.RemoveMember:output_type -> podium.api.v1.RemoveMemberResponse
+	20, // 72: podium.api.v1.Podium.RemoveMembers:output_type -> podium.api.v1.RemoveMembersResponse
+	22, // 73: podium.api.v1.Podium.GetRank:output_type -> podium.api.v1.GetRankResponse
+	32, // 74: podium.api.v1.Podium.GetAroundMember:output_type -> podium.api.v1.GetAroundMemberResponse
+	33, // 75: podium.api.v1.Podium.GetAroundScore:output_type -> podium.api.v1.GetAroundScoreResponse
+	34, // 76: podium.api.v1.Podium.GetTopMembers:output_type -> podium.api.v1.GetTopMembersResponse
+	35, // 77: podium.api.v1.Podium.GetTopPercentage:output_type -> podium.api.v1.GetTopPercentageResponse
+	27, // 78: podium.api.v1.Podium.UpsertScoreMultiLeaderboards:output_type -> podium.api.v1.UpsertScoreMultiLeaderboardsResponse
+	29, // 79: podium.api.v1.Podium.GetRankMultiLeaderboards:output_type -> podium.api.v1.GetRankMultiLeaderboardsResponse
+	42, // 80: podium.api.v1.Podium.CreateLeaderboardDefinition:output_type -> podium.api.v1.LeaderboardDefinitionResponse
+	42, // 81: podium.api.v1.Podium.GetLeaderboardDefinition:output_type -> podium.api.v1.LeaderboardDefinitionResponse
+	43, // 82: podium.api.v1.Podium.ListLeaderboardDefinitions:output_type -> podium.api.v1.ListLeaderboardDefinitionsResponse
+	42, // 83: podium.api.v1.Podium.UpdateLeaderboardDefinition:output_type -> podium.api.v1.LeaderboardDefinitionResponse
+	44, // 84: podium.api.v1.Podium.RemoveLeaderboardDefinition:output_type -> podium.api.v1.RemoveLeaderboardDefinitionResponse
+	48, // 85: podium.api.v1.Podium.GetLeaderboardArchive:output_type -> podium.api.v1.GetLeaderboardArchiveResponse
+	50, // 86: podium.api.v1.Podium.GetArchivedTopMembers:output_type -> podium.api.v1.GetArchivedTopMembersResponse
+	52, // 87: podium.api.v1.Podium.GetArchivedMember:output_type -> podium.api.v1.GetArchivedMemberResponse
+	54, // 88: podium.api.v1.Podium.GetArchivedMemberReward:output_type -> podium.api.v1.GetArchivedMemberRewardResponse
+	57, // 89: podium.api.v1.Podium.GetMemberTier:output_type -> podium.api.v1.GetMemberTierResponse
+	61, // 90: podium.api.v1.Podium.SubmitMatchResult:output_type -> podium.api.v1.SubmitMatchResultResponse
+	63, // 91: podium.api.v1.Podium.GetMemberRating:output_type -> podium.api.v1.GetMemberRatingResponse
+	66, // 92: podium.api.v1.Podium.ReportChallengeResult:output_type -> podium.api.v1.ReportChallengeResultResponse
+	69, // 93: podium.api.v1.Podium.GetQuarantinedMembers:output_type -> podium.api.v1.GetQuarantinedMembersResponse
+	62, // [62:94] is the sub-list for method output_type
+	30, // [30:62] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_proto_podium_api_v1_podium_proto_init() }
+func file_proto_podium_api_v1_podium_proto_init() {
+	if File_proto_podium_api_v1_podium_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_podium_api_v1_podium_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpsertScoresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrementScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertScoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrementScoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3574,8 +7194,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAroundMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3586,8 +7206,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3598,8 +7218,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLeaderboardRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopPercentageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3610,8 +7230,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkUpsertScoresRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertScoreMultiLeaderboardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3622,8 +7242,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Member); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertScoreMultiLeaderboardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3634,8 +7254,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertScoreRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankMultiLeaderboardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3646,8 +7266,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TotalMembersRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankMultiLeaderboardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3658,8 +7278,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TotalMembersResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAroundScoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3670,8 +7290,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrementScoreRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpsertScoresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3682,8 +7302,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMemberRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAroundMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3694,8 +7314,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertScoreResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAroundScoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3706,8 +7326,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrementScoreResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3718,8 +7338,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMemberResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopPercentageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3730,8 +7350,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMembersRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3742,8 +7362,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMembersResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLeaderboardDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3754,8 +7374,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMemberRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3766,8 +7386,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMembersRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeaderboardDefinitionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3778,8 +7398,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLeaderboardResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeaderboardDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3790,8 +7410,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMemberResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLeaderboardDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3802,8 +7422,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMembersResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardDefinitionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3814,8 +7434,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRankRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeaderboardDefinitionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3826,8 +7446,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRankResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLeaderboardDefinitionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3838,8 +7458,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAroundMemberRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3850,8 +7470,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopMembersRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardBracket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3862,8 +7482,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopPercentageRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardArchiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3874,8 +7494,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertScoreMultiLeaderboardsRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardArchiveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3886,8 +7506,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertScoreMultiLeaderboardsResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedTopMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3898,8 +7518,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRankMultiLeaderboardsRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedTopMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3910,8 +7530,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRankMultiLeaderboardsResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3922,8 +7542,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAroundScoreRequest); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3934,8 +7554,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkUpsertScoresResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedMemberRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3946,8 +7566,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAroundMemberResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedMemberRewardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3958,8 +7578,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAroundScoreResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberTierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3970,8 +7590,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopMembersResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TierMovement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3982,8 +7602,8 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopPercentageResponse); i {
+		file_proto_podium_api_v1_podium_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberTierResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3994,7 +7614,151 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitMatchResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberRating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitMatchResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberRatingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberRatingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportChallengeResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LadderPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportChallengeResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuarantinedMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantinedMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuarantinedMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BulkUpsertScoresRequest_MemberScore); i {
 			case 0:
 				return &v.state
@@ -4006,7 +7770,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BulkUpsertScoresRequest_MemberScores); i {
 			case 0:
 				return &v.state
@@ -4018,7 +7782,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpsertScoreRequest_ScoreChange); i {
 			case 0:
 				return &v.state
@@ -4030,7 +7794,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IncrementScoreRequest_Body); i {
 			case 0:
 				return &v.state
@@ -4042,7 +7806,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMembersResponse_Member); i {
 			case 0:
 				return &v.state
@@ -4054,7 +7818,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange); i {
 			case 0:
 				return &v.state
@@ -4066,7 +7830,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpsertScoreMultiLeaderboardsResponse_Member); i {
 			case 0:
 				return &v.state
@@ -4078,7 +7842,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRankMultiLeaderboardsResponse_Member); i {
 			case 0:
 				return &v.state
@@ -4090,7 +7854,7 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
-		file_proto_podium_api_v1_podium_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_podium_api_v1_podium_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BulkUpsertScoresResponse_Member); i {
 			case 0:
 				return &v.state
@@ -4102,14 +7866,40 @@ func file_proto_podium_api_v1_podium_proto_init() {
 				return nil
 			}
 		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitMatchResultRequest_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_podium_api_v1_podium_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportChallengeResultRequest_Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_proto_podium_api_v1_podium_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_proto_podium_api_v1_podium_proto_msgTypes[73].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_podium_api_v1_podium_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,20 @@ const (
 	Podium_GetTopPercentage_FullMethodName             = "/podium.api.v1.Podium/GetTopPercentage"
 	Podium_UpsertScoreMultiLeaderboards_FullMethodName = "/podium.api.v1.Podium/UpsertScoreMultiLeaderboards"
 	Podium_GetRankMultiLeaderboards_FullMethodName     = "/podium.api.v1.Podium/GetRankMultiLeaderboards"
+	Podium_CreateLeaderboardDefinition_FullMethodName  = "/podium.api.v1.Podium/CreateLeaderboardDefinition"
+	Podium_GetLeaderboardDefinition_FullMethodName     = "/podium.api.v1.Podium/GetLeaderboardDefinition"
+	Podium_ListLeaderboardDefinitions_FullMethodName   = "/podium.api.v1.Podium/ListLeaderboardDefinitions"
+	Podium_UpdateLeaderboardDefinition_FullMethodName  = "/podium.api.v1.Podium/UpdateLeaderboardDefinition"
+	Podium_RemoveLeaderboardDefinition_FullMethodName  = "/podium.api.v1.Podium/RemoveLeaderboardDefinition"
+	Podium_GetLeaderboardArchive_FullMethodName        = "/podium.api.v1.Podium/GetLeaderboardArchive"
+	Podium_GetArchivedTopMembers_FullMethodName        = "/podium.api.v1.Podium/GetArchivedTopMembers"
+	Podium_GetArchivedMember_FullMethodName            = "/podium.api.v1.Podium/GetArchivedMember"
+	Podium_GetArchivedMemberReward_FullMethodName      = "/podium.api.v1.Podium/GetArchivedMemberReward"
+	Podium_GetMemberTier_FullMethodName                = "/podium.api.v1.Podium/GetMemberTier"
+	Podium_SubmitMatchResult_FullMethodName            = "/podium.api.v1.Podium/SubmitMatchResult"
+	Podium_GetMemberRating_FullMethodName              = "/podium.api.v1.Podium/GetMemberRating"
+	Podium_ReportChallengeResult_FullMethodName        = "/podium.api.v1.Podium/ReportChallengeResult"
+	Podium_GetQuarantinedMembers_FullMethodName        = "/podium.api.v1.Podium/GetQuarantinedMembers"
 )
 
 // PodiumClient is the client API for Podium service.
@@ -81,6 +95,34 @@ type PodiumClient interface {
 	UpsertScoreMultiLeaderboards(ctx context.Context, in *UpsertScoreMultiLeaderboardsRequest, opts ...grpc.CallOption) (*UpsertScoreMultiLeaderboardsResponse, error)
 	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
 	GetRankMultiLeaderboards(ctx context.Context, in *GetRankMultiLeaderboardsRequest, opts ...grpc.CallOption) (*GetRankMultiLeaderboardsResponse, error)
+	// CreateLeaderboardDefinition registers a leaderboard with its default settings.
+	CreateLeaderboardDefinition(ctx context.Context, in *CreateLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error)
+	// GetLeaderboardDefinition retrieves a registered leaderboard definition.
+	GetLeaderboardDefinition(ctx context.Context, in *GetLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error)
+	// ListLeaderboardDefinitions retrieves every registered leaderboard definition.
+	ListLeaderboardDefinitions(ctx context.Context, in *ListLeaderboardDefinitionsRequest, opts ...grpc.CallOption) (*ListLeaderboardDefinitionsResponse, error)
+	// UpdateLeaderboardDefinition replaces a registered leaderboard definition.
+	UpdateLeaderboardDefinition(ctx context.Context, in *UpdateLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error)
+	// RemoveLeaderboardDefinition unregisters a leaderboard, its members are kept.
+	RemoveLeaderboardDefinition(ctx context.Context, in *RemoveLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*RemoveLeaderboardDefinitionResponse, error)
+	// GetLeaderboardArchive retrieves when the final standings of a leaderboard season were archived.
+	GetLeaderboardArchive(ctx context.Context, in *GetLeaderboardArchiveRequest, opts ...grpc.CallOption) (*GetLeaderboardArchiveResponse, error)
+	// GetArchivedTopMembers retrieves a page of the final standings of a leaderboard season.
+	GetArchivedTopMembers(ctx context.Context, in *GetArchivedTopMembersRequest, opts ...grpc.CallOption) (*GetArchivedTopMembersResponse, error)
+	// GetArchivedMember retrieves the final score and rank of a member in a leaderboard season.
+	GetArchivedMember(ctx context.Context, in *GetArchivedMemberRequest, opts ...grpc.CallOption) (*GetArchivedMemberResponse, error)
+	// GetArchivedMemberReward retrieves the reward bracket a member was assigned when its leaderboard was archived.
+	GetArchivedMemberReward(ctx context.Context, in *GetArchivedMemberRewardRequest, opts ...grpc.CallOption) (*GetArchivedMemberRewardResponse, error)
+	// GetMemberTier retrieves the tier a member plays the current season of a tiered league in and its tier history.
+	GetMemberTier(ctx context.Context, in *GetMemberTierRequest, opts ...grpc.CallOption) (*GetMemberTierResponse, error)
+	// SubmitMatchResult rates the members of a match of a rating leaderboard by how they finished it.
+	SubmitMatchResult(ctx context.Context, in *SubmitMatchResultRequest, opts ...grpc.CallOption) (*SubmitMatchResultResponse, error)
+	// GetMemberRating retrieves the rating of a member of a rating leaderboard.
+	GetMemberRating(ctx context.Context, in *GetMemberRatingRequest, opts ...grpc.CallOption) (*GetMemberRatingResponse, error)
+	// ReportChallengeResult reorders the positions of a ladder leaderboard by the result of a challenge.
+	ReportChallengeResult(ctx context.Context, in *ReportChallengeResultRequest, opts ...grpc.CallOption) (*ReportChallengeResultResponse, error)
+	// GetQuarantinedMembers lists the score writes held for review because they broke a leaderboard score rule.
+	GetQuarantinedMembers(ctx context.Context, in *GetQuarantinedMembersRequest, opts ...grpc.CallOption) (*GetQuarantinedMembersResponse, error)
 }
 
 type podiumClient struct {
@@ -253,6 +295,132 @@ func (c *podiumClient) GetRankMultiLeaderboards(ctx context.Context, in *GetRank
 	return out, nil
 }
 
+func (c *podiumClient) CreateLeaderboardDefinition(ctx context.Context, in *CreateLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error) {
+	out := new(LeaderboardDefinitionResponse)
+	err := c.cc.Invoke(ctx, Podium_CreateLeaderboardDefinition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetLeaderboardDefinition(ctx context.Context, in *GetLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error) {
+	out := new(LeaderboardDefinitionResponse)
+	err := c.cc.Invoke(ctx, Podium_GetLeaderboardDefinition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) ListLeaderboardDefinitions(ctx context.Context, in *ListLeaderboardDefinitionsRequest, opts ...grpc.CallOption) (*ListLeaderboardDefinitionsResponse, error) {
+	out := new(ListLeaderboardDefinitionsResponse)
+	err := c.cc.Invoke(ctx, Podium_ListLeaderboardDefinitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UpdateLeaderboardDefinition(ctx context.Context, in *UpdateLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*LeaderboardDefinitionResponse, error) {
+	out := new(LeaderboardDefinitionResponse)
+	err := c.cc.Invoke(ctx, Podium_UpdateLeaderboardDefinition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) RemoveLeaderboardDefinition(ctx context.Context, in *RemoveLeaderboardDefinitionRequest, opts ...grpc.CallOption) (*RemoveLeaderboardDefinitionResponse, error) {
+	out := new(RemoveLeaderboardDefinitionResponse)
+	err := c.cc.Invoke(ctx, Podium_RemoveLeaderboardDefinition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetLeaderboardArchive(ctx context.Context, in *GetLeaderboardArchiveRequest, opts ...grpc.CallOption) (*GetLeaderboardArchiveResponse, error) {
+	out := new(GetLeaderboardArchiveResponse)
+	err := c.cc.Invoke(ctx, Podium_GetLeaderboardArchive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetArchivedTopMembers(ctx context.Context, in *GetArchivedTopMembersRequest, opts ...grpc.CallOption) (*GetArchivedTopMembersResponse, error) {
+	out := new(GetArchivedTopMembersResponse)
+	err := c.cc.Invoke(ctx, Podium_GetArchivedTopMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetArchivedMember(ctx context.Context, in *GetArchivedMemberRequest, opts ...grpc.CallOption) (*GetArchivedMemberResponse, error) {
+	out := new(GetArchivedMemberResponse)
+	err := c.cc.Invoke(ctx, Podium_GetArchivedMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetArchivedMemberReward(ctx context.Context, in *GetArchivedMemberRewardRequest, opts ...grpc.CallOption) (*GetArchivedMemberRewardResponse, error) {
+	out := new(GetArchivedMemberRewardResponse)
+	err := c.cc.Invoke(ctx, Podium_GetArchivedMemberReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMemberTier(ctx context.Context, in *GetMemberTierRequest, opts ...grpc.CallOption) (*GetMemberTierResponse, error) {
+	out := new(GetMemberTierResponse)
+	err := c.cc.Invoke(ctx, Podium_GetMemberTier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) SubmitMatchResult(ctx context.Context, in *SubmitMatchResultRequest, opts ...grpc.CallOption) (*SubmitMatchResultResponse, error) {
+	out := new(SubmitMatchResultResponse)
+	err := c.cc.Invoke(ctx, Podium_SubmitMatchResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMemberRating(ctx context.Context, in *GetMemberRatingRequest, opts ...grpc.CallOption) (*GetMemberRatingResponse, error) {
+	out := new(GetMemberRatingResponse)
+	err := c.cc.Invoke(ctx, Podium_GetMemberRating_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) ReportChallengeResult(ctx context.Context, in *ReportChallengeResultRequest, opts ...grpc.CallOption) (*ReportChallengeResultResponse, error) {
+	out := new(ReportChallengeResultResponse)
+	err := c.cc.Invoke(ctx, Podium_ReportChallengeResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetQuarantinedMembers(ctx context.Context, in *GetQuarantinedMembersRequest, opts ...grpc.CallOption) (*GetQuarantinedMembersResponse, error) {
+	out := new(GetQuarantinedMembersResponse)
+	err := c.cc.Invoke(ctx, Podium_GetQuarantinedMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PodiumServer is the server API for Podium service.
 // All implementations must embed UnimplementedPodiumServer
 // for forward compatibility
@@ -294,6 +462,34 @@ type PodiumServer interface {
 	UpsertScoreMultiLeaderboards(context.Context, *UpsertScoreMultiLeaderboardsRequest) (*UpsertScoreMultiLeaderboardsResponse, error)
 	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
 	GetRankMultiLeaderboards(context.Context, *GetRankMultiLeaderboardsRequest) (*GetRankMultiLeaderboardsResponse, error)
+	// CreateLeaderboardDefinition registers a leaderboard with its default settings.
+	CreateLeaderboardDefinition(context.Context, *CreateLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error)
+	// GetLeaderboardDefinition retrieves a registered leaderboard definition.
+	GetLeaderboardDefinition(context.Context, *GetLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error)
+	// ListLeaderboardDefinitions retrieves every registered leaderboard definition.
+	ListLeaderboardDefinitions(context.Context, *ListLeaderboardDefinitionsRequest) (*ListLeaderboardDefinitionsResponse, error)
+	// UpdateLeaderboardDefinition replaces a registered leaderboard definition.
+	UpdateLeaderboardDefinition(context.Context, *UpdateLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error)
+	// RemoveLeaderboardDefinition unregisters a leaderboard, its members are kept.
+	RemoveLeaderboardDefinition(context.Context, *RemoveLeaderboardDefinitionRequest) (*RemoveLeaderboardDefinitionResponse, error)
+	// GetLeaderboardArchive retrieves when the final standings of a leaderboard season were archived.
+	GetLeaderboardArchive(context.Context, *GetLeaderboardArchiveRequest) (*GetLeaderboardArchiveResponse, error)
+	// GetArchivedTopMembers retrieves a page of the final standings of a leaderboard season.
+	GetArchivedTopMembers(context.Context, *GetArchivedTopMembersRequest) (*GetArchivedTopMembersResponse, error)
+	// GetArchivedMember retrieves the final score and rank of a member in a leaderboard season.
+	GetArchivedMember(context.Context, *GetArchivedMemberRequest) (*GetArchivedMemberResponse, error)
+	// GetArchivedMemberReward retrieves the reward bracket a member was assigned when its leaderboard was archived.
+	GetArchivedMemberReward(context.Context, *GetArchivedMemberRewardRequest) (*GetArchivedMemberRewardResponse, error)
+	// GetMemberTier retrieves the tier a member plays the current season of a tiered league in and its tier history.
+	GetMemberTier(context.Context, *GetMemberTierRequest) (*GetMemberTierResponse, error)
+	// SubmitMatchResult rates the members of a match of a rating leaderboard by how they finished it.
+	SubmitMatchResult(context.Context, *SubmitMatchResultRequest) (*SubmitMatchResultResponse, error)
+	// GetMemberRating retrieves the rating of a member of a rating leaderboard.
+	GetMemberRating(context.Context, *GetMemberRatingRequest) (*GetMemberRatingResponse, error)
+	// ReportChallengeResult reorders the positions of a ladder leaderboard by the result of a challenge.
+	ReportChallengeResult(context.Context, *ReportChallengeResultRequest) (*ReportChallengeResultResponse, error)
+	// GetQuarantinedMembers lists the score writes held for review because they broke a leaderboard score rule.
+	GetQuarantinedMembers(context.Context, *GetQuarantinedMembersRequest) (*GetQuarantinedMembersResponse, error)
 	mustEmbedUnimplementedPodiumServer()
 }
 
@@ -355,6 +551,48 @@ func (UnimplementedPodiumServer) UpsertScoreMultiLeaderboards(context.Context, *
 func (UnimplementedPodiumServer) GetRankMultiLeaderboards(context.Context, *GetRankMultiLeaderboardsRequest) (*GetRankMultiLeaderboardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRankMultiLeaderboards not implemented")
 }
+func (UnimplementedPodiumServer) CreateLeaderboardDefinition(context.Context, *CreateLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeaderboardDefinition not implemented")
+}
+func (UnimplementedPodiumServer) GetLeaderboardDefinition(context.Context, *GetLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboardDefinition not implemented")
+}
+func (UnimplementedPodiumServer) ListLeaderboardDefinitions(context.Context, *ListLeaderboardDefinitionsRequest) (*ListLeaderboardDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboardDefinitions not implemented")
+}
+func (UnimplementedPodiumServer) UpdateLeaderboardDefinition(context.Context, *UpdateLeaderboardDefinitionRequest) (*LeaderboardDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeaderboardDefinition not implemented")
+}
+func (UnimplementedPodiumServer) RemoveLeaderboardDefinition(context.Context, *RemoveLeaderboardDefinitionRequest) (*RemoveLeaderboardDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLeaderboardDefinition not implemented")
+}
+func (UnimplementedPodiumServer) GetLeaderboardArchive(context.Context, *GetLeaderboardArchiveRequest) (*GetLeaderboardArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboardArchive not implemented")
+}
+func (UnimplementedPodiumServer) GetArchivedTopMembers(context.Context, *GetArchivedTopMembersRequest) (*GetArchivedTopMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedTopMembers not implemented")
+}
+func (UnimplementedPodiumServer) GetArchivedMember(context.Context, *GetArchivedMemberRequest) (*GetArchivedMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedMember not implemented")
+}
+func (UnimplementedPodiumServer) GetArchivedMemberReward(context.Context, *GetArchivedMemberRewardRequest) (*GetArchivedMemberRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedMemberReward not implemented")
+}
+func (UnimplementedPodiumServer) GetMemberTier(context.Context, *GetMemberTierRequest) (*GetMemberTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberTier not implemented")
+}
+func (UnimplementedPodiumServer) SubmitMatchResult(context.Context, *SubmitMatchResultRequest) (*SubmitMatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMatchResult not implemented")
+}
+func (UnimplementedPodiumServer) GetMemberRating(context.Context, *GetMemberRatingRequest) (*GetMemberRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberRating not implemented")
+}
+func (UnimplementedPodiumServer) ReportChallengeResult(context.Context, *ReportChallengeResultRequest) (*ReportChallengeResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportChallengeResult not implemented")
+}
+func (UnimplementedPodiumServer) GetQuarantinedMembers(context.Context, *GetQuarantinedMembersRequest) (*GetQuarantinedMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuarantinedMembers not implemented")
+}
 func (UnimplementedPodiumServer) mustEmbedUnimplementedPodiumServer() {}
 
 // UnsafePodiumServer may be embedded to opt out of forward compatibility for this service.
@@ -692,6 +930,258 @@ func _Podium_GetRankMultiLeaderboards_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Podium_CreateLeaderboardDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeaderboardDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).CreateLeaderboardDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_CreateLeaderboardDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).CreateLeaderboardDefinition(ctx, req.(*CreateLeaderboardDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetLeaderboardDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetLeaderboardDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetLeaderboardDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetLeaderboardDefinition(ctx, req.(*GetLeaderboardDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_ListLeaderboardDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaderboardDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).ListLeaderboardDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_ListLeaderboardDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).ListLeaderboardDefinitions(ctx, req.(*ListLeaderboardDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_UpdateLeaderboardDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaderboardDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).UpdateLeaderboardDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_UpdateLeaderboardDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).UpdateLeaderboardDefinition(ctx, req.(*UpdateLeaderboardDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_RemoveLeaderboardDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLeaderboardDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).RemoveLeaderboardDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_RemoveLeaderboardDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).RemoveLeaderboardDefinition(ctx, req.(*RemoveLeaderboardDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetLeaderboardArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetLeaderboardArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetLeaderboardArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetLeaderboardArchive(ctx, req.(*GetLeaderboardArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetArchivedTopMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedTopMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetArchivedTopMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetArchivedTopMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetArchivedTopMembers(ctx, req.(*GetArchivedTopMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetArchivedMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetArchivedMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetArchivedMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetArchivedMember(ctx, req.(*GetArchivedMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetArchivedMemberReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedMemberRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetArchivedMemberReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetArchivedMemberReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetArchivedMemberReward(ctx, req.(*GetArchivedMemberRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetMemberTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetMemberTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetMemberTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetMemberTier(ctx, req.(*GetMemberTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_SubmitMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).SubmitMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_SubmitMatchResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).SubmitMatchResult(ctx, req.(*SubmitMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetMemberRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetMemberRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetMemberRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetMemberRating(ctx, req.(*GetMemberRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_ReportChallengeResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportChallengeResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).ReportChallengeResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_ReportChallengeResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).ReportChallengeResult(ctx, req.(*ReportChallengeResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetQuarantinedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuarantinedMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetQuarantinedMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Podium_GetQuarantinedMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetQuarantinedMembers(ctx, req.(*GetQuarantinedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Podium_ServiceDesc is the grpc.ServiceDesc for Podium service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -771,6 +1261,62 @@ var Podium_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRankMultiLeaderboards",
 			Handler:    _Podium_GetRankMultiLeaderboards_Handler,
 		},
+		{
+			MethodName: "CreateLeaderboardDefinition",
+			Handler:    _Podium_CreateLeaderboardDefinition_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardDefinition",
+			Handler:    _Podium_GetLeaderboardDefinition_Handler,
+		},
+		{
+			MethodName: "ListLeaderboardDefinitions",
+			Handler:    _Podium_ListLeaderboardDefinitions_Handler,
+		},
+		{
+			MethodName: "UpdateLeaderboardDefinition",
+			Handler:    _Podium_UpdateLeaderboardDefinition_Handler,
+		},
+		{
+			MethodName: "RemoveLeaderboardDefinition",
+			Handler:    _Podium_RemoveLeaderboardDefinition_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardArchive",
+			Handler:    _Podium_GetLeaderboardArchive_Handler,
+		},
+		{
+			MethodName: "GetArchivedTopMembers",
+			Handler:    _Podium_GetArchivedTopMembers_Handler,
+		},
+		{
+			MethodName: "GetArchivedMember",
+			Handler:    _Podium_GetArchivedMember_Handler,
+		},
+		{
+			MethodName: "GetArchivedMemberReward",
+			Handler:    _Podium_GetArchivedMemberReward_Handler,
+		},
+		{
+			MethodName: "GetMemberTier",
+			Handler:    _Podium_GetMemberTier_Handler,
+		},
+		{
+			MethodName: "SubmitMatchResult",
+			Handler:    _Podium_SubmitMatchResult_Handler,
+		},
+		{
+			MethodName: "GetMemberRating",
+			Handler:    _Podium_GetMemberRating_Handler,
+		},
+		{
+			MethodName: "ReportChallengeResult",
+			Handler:    _Podium_ReportChallengeResult_Handler,
+		},
+		{
+			MethodName: "GetQuarantinedMembers",
+			Handler:    _Podium_GetQuarantinedMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/podium/api/v1/podium.proto",
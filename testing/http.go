@@ -22,45 +22,45 @@ func Get(app *api.App, url string, headersKv ...string) (int, string) {
 }
 
 // Post to server
-func Post(app *api.App, url, body string) (int, string) {
-	return doRequest(app, "POST", url, body)
+func Post(app *api.App, url, body string, headersKv ...string) (int, string) {
+	return doRequest(app, "POST", url, body, headersKv...)
 }
 
 // PostJSON to server
-func PostJSON(app *api.App, url string, body interface{}) (int, string) {
+func PostJSON(app *api.App, url string, body interface{}, headersKv ...string) (int, string) {
 	result, err := json.Marshal(body)
 	if err != nil {
 		return 510, "Failed to marshal specified body to JSON format"
 	}
-	return Post(app, url, string(result))
+	return Post(app, url, string(result), headersKv...)
 }
 
 // Put to server
-func Put(app *api.App, url, body string) (int, string) {
-	return doRequest(app, "PUT", url, body)
+func Put(app *api.App, url, body string, headersKv ...string) (int, string) {
+	return doRequest(app, "PUT", url, body, headersKv...)
 }
 
 // PutJSON to server
-func PutJSON(app *api.App, url string, body interface{}) (int, string) {
+func PutJSON(app *api.App, url string, body interface{}, headersKv ...string) (int, string) {
 	result, err := json.Marshal(body)
 	if err != nil {
 		return 510, "Failed to marshal specified body to JSON format"
 	}
-	return Put(app, url, string(result))
+	return Put(app, url, string(result), headersKv...)
 }
 
 // Patch to server
-func Patch(app *api.App, url, body string) (int, string) {
-	return doRequest(app, "PATCH", url, body)
+func Patch(app *api.App, url, body string, headersKv ...string) (int, string) {
+	return doRequest(app, "PATCH", url, body, headersKv...)
 }
 
 // PatchJSON to server
-func PatchJSON(app *api.App, url string, body interface{}) (int, string) {
+func PatchJSON(app *api.App, url string, body interface{}, headersKv ...string) (int, string) {
 	result, err := json.Marshal(body)
 	if err != nil {
 		return 510, "Failed to marshal specified body to JSON format"
 	}
-	return Patch(app, url, string(result))
+	return Patch(app, url, string(result), headersKv...)
 }
 
 // Delete from server
@@ -99,26 +99,16 @@ func (w *ExpirationWorker) configure() error {
 	w.ExpirationLimitPerRun = w.Config.GetInt("worker.expirationLimitPerRun")
 	w.stop = make(chan bool, 1)
 
-	database := database.NewRedisDatabase(database.RedisOptions{
-		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
-		Addrs:          w.Config.GetStringSlice("redis.addrs"),
-		Host:           w.Config.GetString("redis.host"),
-		Port:           w.Config.GetInt("redis.port"),
-		Password:       w.Config.GetString("redis.password"),
-		DB:             w.Config.GetInt("redis.db"),
-	})
-	w.Database = database
+	redisDatabase, err := newRedisDatabase(w.Config)
+	if err != nil {
+		return err
+	}
+	w.Database = redisDatabase
 	return nil
 }
 
 func (w *ExpirationWorker) setConfigurationDefaults() {
-	w.Config.SetDefault("redis.clusterEnabled", "false")
-	w.Config.SetDefault("redis.addrs", "")
-	w.Config.SetDefault("redis.host", "localhost")
-	w.Config.SetDefault("redis.port", "6379")
-	w.Config.SetDefault("redis.password", "")
-	w.Config.SetDefault("redis.db", 0)
-	w.Config.SetDefault("redis.maxPoolSize", 20)
+	setRedisConfigurationDefaults(w.Config)
 	w.Config.SetDefault("worker.expirationCheckInterval", "60s")
 	w.Config.SetDefault("worker.expirationLimitPerRun", "1000")
 }
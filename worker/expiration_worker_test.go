@@ -70,7 +70,7 @@ var _ = Describe("Scores Expirer Worker", func() {
 
 	It("should expire scores and delete set", func() {
 		ttl := "1"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, "")
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
@@ -109,7 +109,7 @@ var _ = Describe("Scores Expirer Worker", func() {
 
 	It("should not expire scores that are in the future", func() {
 		ttl := "20"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, "")
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
@@ -149,7 +149,7 @@ var _ = Describe("Scores Expirer Worker", func() {
 	It("should not expire scores that are not inserted with scoreTTL set", func() {
 		ttl := ""
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, "")
 		Expect(err).NotTo(HaveOccurred())
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
 		Expect(err).To(MatchError(redis.NewKeyNotFoundError(redisLBExpirationKey)))
@@ -182,9 +182,9 @@ var _ = Describe("Scores Expirer Worker", func() {
 		expirationWorker.ExpirationCheckInterval = time.Duration(4) * time.Second
 
 		ttl := "2"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, "")
 		Expect(err).NotTo(HaveOccurred())
-		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix2", 481512, false, ttl)
+		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix2", 481512, false, ttl, "")
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
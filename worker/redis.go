@@ -0,0 +1,46 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+func setRedisConfigurationDefaults(config *viper.Viper) {
+	config.SetDefault("redis.clusterEnabled", "false")
+	config.SetDefault("redis.addrs", "")
+	config.SetDefault("redis.host", "localhost")
+	config.SetDefault("redis.port", "6379")
+	config.SetDefault("redis.password", "")
+	config.SetDefault("redis.db", 0)
+	config.SetDefault("redis.maxPoolSize", 20)
+	config.SetDefault("redis.keys.schema", int(database.KeySchemaV1))
+	config.SetDefault("redis.keys.prefix", "")
+}
+
+// newRedisDatabase create the database of config, cluster deployments must use key schema v2
+func newRedisDatabase(config *viper.Viper) (*database.Redis, error) {
+	options := database.RedisOptions{
+		ClusterEnabled: config.GetBool("redis.cluster.enabled"),
+		Addrs:          config.GetStringSlice("redis.addrs"),
+		Host:           config.GetString("redis.host"),
+		Port:           config.GetInt("redis.port"),
+		Password:       config.GetString("redis.password"),
+		DB:             config.GetInt("redis.db"),
+		KeySchema:      database.KeySchemaVersion(config.GetInt("redis.keys.schema")),
+		KeyPrefix:      config.GetString("redis.keys.prefix"),
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	return database.NewRedisDatabase(options), nil
+}
@@ -0,0 +1,331 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/rewards"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// rewardMembersPageSize is how many rewarded members are read from the archive and posted to the webhook at once
+const rewardMembersPageSize = 1000
+
+// rewardSettings are the settings of configured rewards
+type rewardSettings struct {
+	archiveOptions *database.ArchiveOptions
+	webhookURL     string
+}
+
+// RewardsPayload is a page of the body posted to reward webhooks once a leaderboard is archived, pages list
+// up to rewardMembersPageSize members in rank order and brackets with members in them
+type RewardsPayload struct {
+	LeaderboardID string                   `json:"leaderboardId"`
+	SeasonEnd     int64                    `json:"seasonEnd"`
+	TotalMembers  int                      `json:"totalMembers"`
+	RewardOrder   string                   `json:"rewardOrder"`
+	RankMode      string                   `json:"rankMode"`
+	Page          int                      `json:"page"`
+	LastPage      bool                     `json:"lastPage"`
+	Brackets      []*RewardsPayloadBracket `json:"brackets"`
+}
+
+// RewardsPayloadBracket lists the members of a page assigned to a reward bracket
+type RewardsPayloadBracket struct {
+	Name    string                  `json:"name"`
+	MinRank int                     `json:"minRank"`
+	MaxRank int                     `json:"maxRank"`
+	Members []*RewardsPayloadMember `json:"members"`
+}
+
+// RewardsPayloadMember is a member final rank in the reward order and rank mode
+type RewardsPayloadMember struct {
+	PublicID string `json:"publicID"`
+	Rank     int    `json:"rank"`
+}
+
+// loadRewards validates the configured rewards and builds their settings
+func (w *RolloverWorker) loadRewards() error {
+	parsedConfig := &config.PodiumConfig{}
+	if err := w.Config.Unmarshal(parsedConfig, config.DecodeHook()); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	w.defaultRankMode = parsedConfig.Leaderboards.DefaultRankMode
+	if !service.IsValidRankMode(w.defaultRankMode) {
+		return fmt.Errorf("invalid default rank mode: %s", w.defaultRankMode)
+	}
+	w.rankModes = parsedConfig.Leaderboards.RankModes
+
+	w.rewards = map[string]*rewardSettings{}
+	for pattern, rewardsConfig := range parsedConfig.Leaderboards.Rewards {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid rewards pattern %s: %w", pattern, err)
+		}
+
+		order := rewardsConfig.Order
+		if order == "" {
+			order = "desc"
+		}
+		if order != "asc" && order != "desc" {
+			return fmt.Errorf("invalid rewards order for %s: %s", pattern, order)
+		}
+
+		tiers := make([]rewards.Tier, 0, len(rewardsConfig.Tiers))
+		for _, tierConfig := range rewardsConfig.Tiers {
+			tiers = append(tiers, rewards.Tier{
+				Name:       tierConfig.Name,
+				MaxRank:    tierConfig.MaxRank,
+				TopPercent: tierConfig.TopPercent,
+			})
+		}
+		if len(tiers) == 0 {
+			return fmt.Errorf("rewards for %s have no tiers", pattern)
+		}
+		if err := rewards.ValidateTiers(tiers); err != nil {
+			return fmt.Errorf("invalid rewards for %s: %w", pattern, err)
+		}
+
+		w.rewards[pattern] = &rewardSettings{
+			archiveOptions: &database.ArchiveOptions{
+				RewardTiers:    tiers,
+				RewardOrder:    order,
+				DeliverRewards: rewardsConfig.WebhookURL != "",
+			},
+			webhookURL: rewardsConfig.WebhookURL,
+		}
+	}
+
+	return nil
+}
+
+// getRewards returns the reward settings of a leaderboard, nil if its members aren't rewarded
+func (w *RolloverWorker) getRewards(leaderboard string) *rewardSettings {
+	settings, _ := config.MatchLeaderboardPattern(w.rewards, leaderboard)
+	return settings
+}
+
+// getRankMode returns the configured rank mode of leaderboard, members are assigned to reward brackets
+// by their rank in it
+func (w *RolloverWorker) getRankMode(leaderboard string) string {
+	if rankMode, ok := config.MatchLeaderboardPattern(w.rankModes, leaderboard); ok {
+		return rankMode
+	}
+	if w.defaultRankMode == "" {
+		return service.RankModeOrdinal
+	}
+	return w.defaultRankMode
+}
+
+// getArchiveOptions returns the options leaderboard is archived with, tier leaderboards schedule the
+// movements of their members between tiers
+func (w *RolloverWorker) getArchiveOptions(leaderboard string) *database.ArchiveOptions {
+	var options *database.ArchiveOptions
+	if settings := w.getRewards(leaderboard); settings != nil {
+		options = settings.archiveOptions
+	}
+
+	if _, settings, _ := w.getTieredLeague(leaderboard); settings != nil {
+		tierOptions := &database.ArchiveOptions{}
+		if options != nil {
+			*tierOptions = *options
+		}
+		tierOptions.MoveTiers = true
+		options = tierOptions
+	}
+
+	return options
+}
+
+// deliverRewards posts the reward brackets of up to RolloverLimitPerRun archived leaderboards to their webhook,
+// failed deliveries are retried by later runs until they fail RewardDeliveryMaxAttempts times
+func (w *RolloverWorker) deliverRewards(errChan chan<- error) {
+	ctx := context.Background()
+
+	leaderboards, err := w.Database.GetRewardDeliveries(ctx, time.Now().UTC(), w.RolloverLimitPerRun)
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	for _, leaderboard := range leaderboards {
+		err := w.deliverLeaderboardRewards(ctx, leaderboard)
+		if err == nil {
+			err = w.Database.CompleteRewardDelivery(ctx, leaderboard)
+			if err != nil {
+				errChan <- err
+			}
+			continue
+		}
+
+		attempts, retryErr := w.Database.RetryRewardDelivery(ctx, leaderboard, w.RewardDeliveryMaxAttempts, w.RewardDeliveryRetryInterval)
+		if retryErr != nil {
+			errChan <- retryErr
+			continue
+		}
+
+		if attempts >= w.RewardDeliveryMaxAttempts {
+			errChan <- fmt.Errorf("rewards delivery of %s dropped after %d attempts: %w", leaderboard, attempts, err)
+		} else {
+			errChan <- fmt.Errorf("rewards delivery of %s failed %d times: %w", leaderboard, attempts, err)
+		}
+	}
+}
+
+// deliverLeaderboardRewards posts the reward brackets of leaderboard archive to its webhook in pages, a failed
+// page fails the delivery and its retry posts every page again
+func (w *RolloverWorker) deliverLeaderboardRewards(ctx context.Context, leaderboard string) error {
+	settings := w.getRewards(leaderboard)
+	if settings == nil || settings.webhookURL == "" {
+		return fmt.Errorf("no rewards webhook is configured")
+	}
+
+	return w.forEachRewardsPayload(ctx, leaderboard, func(payload *RewardsPayload) error {
+		return w.postWebhook(ctx, settings.webhookURL, payload)
+	})
+}
+
+// postWebhook posts payload as json to url, responses without a 2xx status are failures
+func (w *RolloverWorker) postWebhook(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// forEachRewardsPayload reads the rewarded members of leaderboard archive in pages and calls send with each
+// payload page, members are ranked in the rank mode of leaderboard so tied members share their bracket.
+// Leaderboards without rewarded members get a single page without brackets
+func (w *RolloverWorker) forEachRewardsPayload(ctx context.Context, leaderboard string, send func(*RewardsPayload) error) error {
+	archive, err := w.Database.GetLeaderboardArchive(ctx, leaderboard)
+	if err != nil {
+		return err
+	}
+
+	rankMode := w.getRankMode(leaderboard)
+	newPayload := func(page int) *RewardsPayload {
+		return &RewardsPayload{
+			LeaderboardID: archive.ID,
+			SeasonEnd:     archive.SeasonEnd.Unix(),
+			TotalMembers:  archive.TotalMembers,
+			RewardOrder:   archive.RewardOrder,
+			RankMode:      rankMode,
+			Page:          page,
+			Brackets:      []*RewardsPayloadBracket{},
+		}
+	}
+
+	payload := newPayload(1)
+	payloadMembers := 0
+	var previous *database.Member
+	rank := 0
+
+pages:
+	for start := 0; start < archive.TotalMembers; start += rewardMembersPageSize {
+		members, err := w.Database.GetArchivedOrderedMembers(ctx, leaderboard, start, start+rewardMembersPageSize-1, archive.RewardOrder)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			rank = rewardRank(rankMode, rank, previous, member)
+			previous = member
+
+			bracket, ok := rewards.FindBracket(archive.RewardBrackets, rank)
+			if !ok {
+				break pages
+			}
+
+			if payloadMembers == rewardMembersPageSize {
+				if err := send(payload); err != nil {
+					return err
+				}
+				payload = newPayload(payload.Page + 1)
+				payloadMembers = 0
+			}
+
+			payloadBracket := payload.bracket(bracket)
+			payloadBracket.Members = append(payloadBracket.Members, &RewardsPayloadMember{
+				PublicID: member.Member,
+				Rank:     rank,
+			})
+			payloadMembers++
+		}
+
+		if len(members) < rewardMembersPageSize {
+			break
+		}
+	}
+
+	payload.LastPage = true
+	return send(payload)
+}
+
+// bracket returns the payload bracket of bracket, appending it if the page has no members in it yet
+func (p *RewardsPayload) bracket(bracket rewards.Bracket) *RewardsPayloadBracket {
+	if len(p.Brackets) > 0 && p.Brackets[len(p.Brackets)-1].Name == bracket.Name {
+		return p.Brackets[len(p.Brackets)-1]
+	}
+
+	payloadBracket := &RewardsPayloadBracket{
+		Name:    bracket.Name,
+		MinRank: bracket.MinRank,
+		MaxRank: bracket.MaxRank,
+		Members: []*RewardsPayloadMember{},
+	}
+	p.Brackets = append(p.Brackets, payloadBracket)
+	return payloadBracket
+}
+
+// rewardRank returns the rank of member in rankMode, given the rank of the previous member in the reward order
+func rewardRank(rankMode string, rank int, previous, member *database.Member) int {
+	tied := previous != nil && previous.Score == member.Score
+	switch rankMode {
+	case service.RankModeCompetition:
+		if tied {
+			return rank
+		}
+		return int(member.Rank) + 1
+	case service.RankModeDense:
+		if tied {
+			return rank
+		}
+		return rank + 1
+	default:
+		return int(member.Rank) + 1
+	}
+}
@@ -0,0 +1,186 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// RolloverResult is the struct that represents the result of a leaderboard archived by a rollover job
+type RolloverResult struct {
+	Leaderboard    string
+	TotalMembers   int
+	RewardBrackets int
+}
+
+func (r *RolloverResult) String() string {
+	return fmt.Sprintf("(Leaderboard: %s, TotalMembers: %d, RewardBrackets: %d)", r.Leaderboard, r.TotalMembers, r.RewardBrackets)
+}
+
+// RolloverWorker is the struct that represents the worker archiving leaderboards final standings
+// when their season ends, delivering their reward brackets and moving tiered league members between tiers
+type RolloverWorker struct {
+	Config                      *viper.Viper
+	Database                    database.Archive
+	Tiers                       database.Tiers
+	ConfigPath                  string
+	RolloverCheckInterval       time.Duration
+	RolloverLimitPerRun         int
+	RewardWebhookTimeout        time.Duration
+	RewardDeliveryMaxAttempts   int
+	RewardDeliveryRetryInterval time.Duration
+	rewards                     map[string]*rewardSettings
+	rankModes                   map[string]string
+	defaultRankMode             string
+	tieredLeagues               map[string]*tieredLeagueSettings
+	webhookClient               *http.Client
+	stop                        chan bool
+}
+
+// GetRolloverWorker returns a new leaderboards rollover worker
+func GetRolloverWorker(configPath string) (*RolloverWorker, error) {
+	worker := &RolloverWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *RolloverWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *RolloverWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.RolloverCheckInterval = w.Config.GetDuration("worker.rolloverCheckInterval")
+	w.RolloverLimitPerRun = w.Config.GetInt("worker.rolloverLimitPerRun")
+	w.RewardWebhookTimeout = w.Config.GetDuration("worker.rewardWebhookTimeout")
+	w.RewardDeliveryMaxAttempts = w.Config.GetInt("worker.rewardDeliveryMaxAttempts")
+	w.RewardDeliveryRetryInterval = w.Config.GetDuration("worker.rewardDeliveryRetryInterval")
+	w.webhookClient = &http.Client{Timeout: w.RewardWebhookTimeout}
+	w.stop = make(chan bool, 1)
+	redisDatabase, err := newRedisDatabase(w.Config)
+	if err != nil {
+		return err
+	}
+	w.Database = redisDatabase
+	w.Tiers = redisDatabase
+	if err := w.loadRewards(); err != nil {
+		return err
+	}
+	return w.loadTieredLeagues()
+}
+
+func (w *RolloverWorker) setConfigurationDefaults() {
+	setRedisConfigurationDefaults(w.Config)
+	w.Config.SetDefault("worker.rolloverCheckInterval", "60s")
+	w.Config.SetDefault("worker.rolloverLimitPerRun", "100")
+	w.Config.SetDefault("worker.rewardWebhookTimeout", "5s")
+	w.Config.SetDefault("worker.rewardDeliveryMaxAttempts", "5")
+	w.Config.SetDefault("worker.rewardDeliveryRetryInterval", "60s")
+}
+
+// Stop finish rollover worker execution
+func (w *RolloverWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker
+func (w *RolloverWorker) Run(resultsChan chan<- []*RolloverResult, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *RolloverWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*RolloverResult, errChan chan<- error) {
+	ticker := time.NewTicker(w.RolloverCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.archiveLeaderboards(resultsChan, errChan)
+			w.deliverRewards(errChan)
+			w.moveTiers(errChan)
+		}
+	}
+}
+
+// archiveLeaderboards archive up to RolloverLimitPerRun leaderboards whose season ended, evaluating their
+// reward brackets, leaderboards that fail are reported and kept to be archived by the next run
+func (w *RolloverWorker) archiveLeaderboards(resultsChan chan<- []*RolloverResult, errChan chan<- error) {
+	leaderboards, err := w.Database.GetLeaderboardsToArchive(context.Background(), time.Now().UTC(), w.RolloverLimitPerRun)
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	result := []*RolloverResult{}
+	for _, leaderboard := range leaderboards {
+		archive, err := w.Database.ArchiveLeaderboard(context.Background(), leaderboard, w.getArchiveOptions(leaderboard))
+		if err != nil {
+			errChan <- err
+			continue
+		}
+
+		result = append(result, &RolloverResult{
+			Leaderboard:    archive.ID,
+			TotalMembers:   archive.TotalMembers,
+			RewardBrackets: len(archive.RewardBrackets),
+		})
+	}
+	resultsChan <- result
+}
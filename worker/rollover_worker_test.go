@@ -0,0 +1,398 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leaderboards Rollover Worker", func() {
+
+	var redisClient *database.Redis
+	var rolloverWorker *worker.RolloverWorker
+
+	const lbName string = "test-rollover-leaderboard"
+
+	rolloverSink := make(chan []*worker.RolloverResult)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-rolloverSink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	BeforeEach(func() {
+		var err error
+
+		rolloverWorker, err = worker.GetRolloverWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: rolloverWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          rolloverWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           rolloverWorker.Config.GetString("redis.host"),
+			Port:           rolloverWorker.Config.GetInt("redis.port"),
+			Password:       rolloverWorker.Config.GetString("redis.password"),
+			DB:             rolloverWorker.Config.GetInt("redis.db"),
+		})
+	})
+
+	AfterEach(func() {
+		redisClient.Del(context.Background(), lbName)
+		redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive(lbName))
+		redisClient.Del(context.Background(), database.LeaderboardArchives)
+		redisClient.Del(context.Background(), database.SeasonRollovers)
+		redisClient.Del(context.Background(), database.RewardDeliveries)
+		redisClient.Del(context.Background(), database.RewardDeliveryAttempts)
+	})
+
+	upsertMembers := func(seasonEnd time.Time, members ...*database.Member) {
+		_, err := redisClient.UpsertMembers(context.Background(), lbName, members, &database.UpsertOptions{
+			Order:     "desc",
+			SeasonEnd: seasonEnd,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should archive leaderboards whose season ended", func() {
+		upsertMembers(time.Now().Add(time.Second),
+			&database.Member{Member: "denix", Score: 481516},
+			&database.Member{Member: "arthur", Score: 1000},
+		)
+
+		go func() {
+			time.Sleep(time.Duration(4) * time.Second)
+			rolloverWorker.Stop()
+		}()
+		rolloverWorker.Run(rolloverSink, errorSink)
+
+		archive, err := redisClient.GetLeaderboardArchive(context.Background(), lbName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archive.TotalMembers).To(Equal(2))
+
+		members, err := redisClient.GetArchivedOrderedMembers(context.Background(), lbName, 0, -1, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(HaveLen(2))
+		Expect(members[0].Member).To(Equal("denix"))
+
+		leaderboards, err := redisClient.GetLeaderboardsToArchive(context.Background(), time.Now(), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(BeEmpty())
+	})
+
+	It("should not archive leaderboards whose season is running", func() {
+		upsertMembers(time.Now().Add(time.Hour), &database.Member{Member: "denix", Score: 481516})
+
+		go func() {
+			time.Sleep(time.Duration(3) * time.Second)
+			rolloverWorker.Stop()
+		}()
+		rolloverWorker.Run(rolloverSink, errorSink)
+
+		_, err := redisClient.GetLeaderboardArchive(context.Background(), lbName)
+		Expect(err).To(Equal(database.NewLeaderboardArchiveNotFoundError(lbName)))
+
+		leaderboards, err := redisClient.GetLeaderboardsToArchive(context.Background(), time.Now().Add(2*time.Hour), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(Equal([]string{lbName}))
+	})
+
+	It("a call to archiveLeaderboards should only archive RolloverLimitPerRun leaderboards", func() {
+		rolloverWorker.RolloverLimitPerRun = 1
+		rolloverWorker.RolloverCheckInterval = time.Duration(10) * time.Second
+
+		upsertMembers(time.Now().Add(-time.Second), &database.Member{Member: "denix", Score: 481516})
+		err := redisClient.ZAdd(context.Background(), database.SeasonRollovers, &redis.Member{Member: "another-leaderboard", Score: 1})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(11) * time.Second)
+			rolloverWorker.Stop()
+		}()
+		rolloverWorker.Run(rolloverSink, errorSink)
+
+		leaderboards, err := redisClient.GetLeaderboardsToArchive(context.Background(), time.Now(), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(Equal([]string{lbName}))
+
+		redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive("another-leaderboard"))
+	})
+
+	It("should keep archiving leaderboards after one fails", func() {
+		upsertMembers(time.Now().Add(-time.Second), &database.Member{Member: "denix", Score: 481516})
+		err := redisClient.ZAdd(context.Background(), database.SeasonRollovers, &redis.Member{Member: "broken-leaderboard", Score: 1})
+		Expect(err).NotTo(HaveOccurred())
+		err = redisClient.HSet(context.Background(), redisClient.Keys.LeaderboardArchive("broken-leaderboard"), "denix", "481516")
+		Expect(err).NotTo(HaveOccurred())
+		defer redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive("broken-leaderboard"))
+
+		var lock sync.Mutex
+		archived := []string{}
+		errorsCount := 0
+		resultsChan := make(chan []*worker.RolloverResult)
+		errChan := make(chan error)
+		go func() {
+			for {
+				select {
+				case results := <-resultsChan:
+					lock.Lock()
+					for _, result := range results {
+						archived = append(archived, result.Leaderboard)
+					}
+					lock.Unlock()
+				case <-errChan:
+					lock.Lock()
+					errorsCount++
+					lock.Unlock()
+				}
+			}
+		}()
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			rolloverWorker.Stop()
+		}()
+		rolloverWorker.Run(resultsChan, errChan)
+
+		lock.Lock()
+		defer lock.Unlock()
+		Expect(archived).To(Equal([]string{lbName}))
+		Expect(errorsCount).To(BeNumerically(">", 0))
+
+		leaderboards, err := redisClient.GetLeaderboardsToArchive(context.Background(), time.Now(), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(Equal([]string{"broken-leaderboard"}))
+	})
+
+	Describe("rewards", func() {
+		const rewardsLbName string = "test-rollover-rewards"
+		const competitionLbName string = "test-rollover-rewards-competition"
+
+		var server *http.Server
+		var lock sync.Mutex
+		var payloads []*worker.RewardsPayload
+		var failures int
+
+		BeforeEach(func() {
+			payloads = nil
+			failures = 0
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rewards", func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				if failures > 0 {
+					failures--
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				payload := &worker.RewardsPayload{}
+				Expect(json.NewDecoder(r.Body).Decode(payload)).To(Succeed())
+				payloads = append(payloads, payload)
+			})
+			server = &http.Server{Addr: "127.0.0.1:10002", Handler: mux}
+			go server.ListenAndServe()
+		})
+
+		AfterEach(func() {
+			server.Close()
+			redisClient.Del(context.Background(), rewardsLbName)
+			redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive(rewardsLbName))
+			redisClient.Del(context.Background(), competitionLbName)
+			redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive(competitionLbName))
+		})
+
+		upsertRewardedMembers := func() {
+			_, err := redisClient.UpsertMembers(context.Background(), rewardsLbName, []*database.Member{
+				{Member: "denix", Score: 481516},
+				{Member: "arthur", Score: 1000},
+				{Member: "felipe", Score: 10},
+				{Member: "dayvson", Score: 1},
+			}, &database.UpsertOptions{Order: "desc", SeasonEnd: time.Now().Add(-time.Second)})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		getPayloads := func() []*worker.RewardsPayload {
+			lock.Lock()
+			defer lock.Unlock()
+			return payloads
+		}
+
+		It("should evaluate reward brackets and deliver them to the webhook", func() {
+			upsertRewardedMembers()
+
+			go func() {
+				time.Sleep(time.Duration(3) * time.Second)
+				rolloverWorker.Stop()
+			}()
+			rolloverWorker.Run(rolloverSink, errorSink)
+
+			archive, err := redisClient.GetLeaderboardArchive(context.Background(), rewardsLbName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archive.RewardOrder).To(Equal("desc"))
+			Expect(archive.RewardBrackets).To(HaveLen(2))
+
+			Expect(getPayloads()).To(HaveLen(1))
+			payload := getPayloads()[0]
+			Expect(payload.LeaderboardID).To(Equal(rewardsLbName))
+			Expect(payload.TotalMembers).To(Equal(4))
+			Expect(payload.RankMode).To(Equal("ordinal"))
+			Expect(payload.Page).To(Equal(1))
+			Expect(payload.LastPage).To(BeTrue())
+			Expect(payload.Brackets).To(Equal([]*worker.RewardsPayloadBracket{
+				{Name: "champion", MinRank: 1, MaxRank: 1, Members: []*worker.RewardsPayloadMember{{PublicID: "denix", Rank: 1}}},
+				{Name: "top50percent", MinRank: 2, MaxRank: 2, Members: []*worker.RewardsPayloadMember{{PublicID: "arthur", Rank: 2}}},
+			}))
+
+			deliveries, err := redisClient.GetRewardDeliveries(context.Background(), time.Now().Add(time.Hour), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+		})
+
+		It("should give tied members the bracket of their rank in the leaderboard rank mode", func() {
+			_, err := redisClient.UpsertMembers(context.Background(), competitionLbName, []*database.Member{
+				{Member: "denix", Score: 1000},
+				{Member: "arthur", Score: 1000},
+				{Member: "felipe", Score: 10},
+				{Member: "dayvson", Score: 1},
+			}, &database.UpsertOptions{Order: "desc", SeasonEnd: time.Now().Add(-time.Second)})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				time.Sleep(time.Duration(3) * time.Second)
+				rolloverWorker.Stop()
+			}()
+			rolloverWorker.Run(rolloverSink, errorSink)
+
+			Expect(getPayloads()).To(HaveLen(1))
+			payload := getPayloads()[0]
+			Expect(payload.LeaderboardID).To(Equal(competitionLbName))
+			Expect(payload.RankMode).To(Equal("competition"))
+			Expect(payload.LastPage).To(BeTrue())
+			Expect(payload.Brackets).To(Equal([]*worker.RewardsPayloadBracket{
+				{Name: "champion", MinRank: 1, MaxRank: 1, Members: []*worker.RewardsPayloadMember{
+					{PublicID: "denix", Rank: 1},
+					{PublicID: "arthur", Rank: 1},
+				}},
+			}))
+		})
+
+		It("should retry failed deliveries", func() {
+			failures = 1
+			upsertRewardedMembers()
+
+			go func() {
+				time.Sleep(time.Duration(5) * time.Second)
+				rolloverWorker.Stop()
+			}()
+			rolloverWorker.Run(rolloverSink, errorSink)
+
+			Expect(getPayloads()).To(HaveLen(1))
+
+			deliveries, err := redisClient.GetRewardDeliveries(context.Background(), time.Now().Add(time.Hour), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+		})
+	})
+
+	Describe("tiered leagues", func() {
+		const tierLbName string = "test-tiered-silver-season1~0"
+		const tieredLeague string = "test-tiered"
+
+		var server *http.Server
+		var lock sync.Mutex
+		var payloads []*worker.TierMovementsPayload
+
+		BeforeEach(func() {
+			payloads = nil
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/tiers", func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				payload := &worker.TierMovementsPayload{}
+				Expect(json.NewDecoder(r.Body).Decode(payload)).To(Succeed())
+				payloads = append(payloads, payload)
+			})
+			server = &http.Server{Addr: "127.0.0.1:10002", Handler: mux}
+			go server.ListenAndServe()
+		})
+
+		AfterEach(func() {
+			server.Close()
+			redisClient.Del(context.Background(), tierLbName)
+			redisClient.Del(context.Background(), redisClient.Keys.LeaderboardArchive(tierLbName))
+			redisClient.Del(context.Background(), redisClient.Keys.TierHistory(tieredLeague))
+			redisClient.Del(context.Background(), database.TieredLeagueSet)
+			redisClient.Del(context.Background(), database.TierMovementJobs)
+			redisClient.Del(context.Background(), database.TierMovementAttempts)
+		})
+
+		getPayloads := func() []*worker.TierMovementsPayload {
+			lock.Lock()
+			defer lock.Unlock()
+			return payloads
+		}
+
+		It("should move members between tiers and post the movements to the webhook", func() {
+			_, err := redisClient.UpsertMembers(context.Background(), tierLbName, []*database.Member{
+				{Member: "denix", Score: 481516},
+				{Member: "arthur", Score: 1000},
+				{Member: "felipe", Score: 10},
+			}, &database.UpsertOptions{Order: "desc", SeasonEnd: time.Now().Add(-time.Second)})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				time.Sleep(time.Duration(3) * time.Second)
+				rolloverWorker.Stop()
+			}()
+			rolloverWorker.Run(rolloverSink, errorSink)
+
+			Expect(getPayloads()).To(HaveLen(1))
+			payload := getPayloads()[0]
+			Expect(payload.TieredLeagueID).To(Equal(tieredLeague))
+			Expect(payload.LeaderboardID).To(Equal(tierLbName))
+			Expect(payload.Tier).To(Equal("silver"))
+			Expect(payload.Members).To(Equal([]*worker.TierMovementsPayloadMember{
+				{PublicID: "denix", Rank: 1, Movement: "promoted", NextTier: "gold"},
+				{PublicID: "arthur", Rank: 2, Movement: "stayed", NextTier: "silver"},
+				{PublicID: "felipe", Rank: 3, Movement: "relegated", NextTier: "bronze"},
+			}))
+
+			history, err := redisClient.GetTierHistory(context.Background(), tieredLeague, "felipe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Leaderboard).To(Equal(tierLbName))
+			Expect(history[0].NextTier).To(Equal("bronze"))
+
+			jobs, err := redisClient.GetTierMovementJobs(context.Background(), time.Now().Add(time.Hour), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobs).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,194 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// tierMovementsOrder is the order members of a tier bucket are ranked in to move them between tiers
+const tierMovementsOrder = "desc"
+
+// tieredLeagueSettings are the settings of a configured tiered league
+type tieredLeagueSettings struct {
+	tieredLeague *service.TieredLeague
+	webhookURL   string
+}
+
+// TierMovementsPayload is the body posted to tiered league webhooks once a tier bucket is archived
+type TierMovementsPayload struct {
+	TieredLeagueID string                        `json:"tieredLeagueId"`
+	LeaderboardID  string                        `json:"leaderboardId"`
+	Tier           string                        `json:"tier"`
+	SeasonEnd      int64                         `json:"seasonEnd"`
+	TotalMembers   int                           `json:"totalMembers"`
+	Members        []*TierMovementsPayloadMember `json:"members"`
+}
+
+// TierMovementsPayloadMember is how a member finished the season and the tier it plays the next one in
+type TierMovementsPayloadMember struct {
+	PublicID string `json:"publicID"`
+	Rank     int    `json:"rank"`
+	Movement string `json:"movement"`
+	NextTier string `json:"nextTier"`
+}
+
+// loadTieredLeagues validates the configured tiered leagues and builds their settings
+func (w *RolloverWorker) loadTieredLeagues() error {
+	parsedConfig := &config.PodiumConfig{}
+	if err := w.Config.Unmarshal(parsedConfig, config.DecodeHook()); err != nil {
+		return fmt.Errorf("could not parse configuration file: %w", err)
+	}
+
+	w.tieredLeagues = map[string]*tieredLeagueSettings{}
+	for leagueID, leagueConfig := range parsedConfig.Leaderboards.TieredLeagues {
+		if !expiration.IsValidCadence(leagueConfig.Cadence) {
+			return fmt.Errorf("invalid tiered league cadence for %s: %s", leagueID, leagueConfig.Cadence)
+		}
+		if len(leagueConfig.Tiers) == 0 {
+			return fmt.Errorf("tiered league %s has no tiers", leagueID)
+		}
+
+		prefix := leagueConfig.Prefix
+		if prefix == "" {
+			prefix = leagueID + "-"
+		}
+
+		w.tieredLeagues[leagueID] = &tieredLeagueSettings{
+			tieredLeague: &service.TieredLeague{
+				Prefix:     prefix,
+				Cadence:    expiration.Cadence(leagueConfig.Cadence),
+				Tiers:      leagueConfig.Tiers,
+				BucketSize: leagueConfig.BucketSize,
+				Promote:    leagueConfig.Promote,
+				Relegate:   leagueConfig.Relegate,
+			},
+			webhookURL: leagueConfig.WebhookURL,
+		}
+	}
+
+	return nil
+}
+
+// getTieredLeague returns the tiered league ID, settings and tier of a tier leaderboard, nil settings if
+// leaderboard isn't a tier of any tiered league
+func (w *RolloverWorker) getTieredLeague(leaderboard string) (string, *tieredLeagueSettings, string) {
+	for leagueID, settings := range w.tieredLeagues {
+		if tier, ok := settings.tieredLeague.ParseTier(leaderboard); ok {
+			return leagueID, settings, tier
+		}
+	}
+	return "", nil, ""
+}
+
+// moveTiers records the tier movements of the members of up to RolloverLimitPerRun archived tier leaderboards
+// and posts them to their webhook, failed ones are retried by later runs until they fail
+// RewardDeliveryMaxAttempts times
+func (w *RolloverWorker) moveTiers(errChan chan<- error) {
+	ctx := context.Background()
+
+	leaderboards, err := w.Tiers.GetTierMovementJobs(ctx, time.Now().UTC(), w.RolloverLimitPerRun)
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	for _, leaderboard := range leaderboards {
+		err := w.moveLeaderboardTiers(ctx, leaderboard)
+		if err == nil {
+			err = w.Tiers.CompleteTierMovementJob(ctx, leaderboard)
+			if err != nil {
+				errChan <- err
+			}
+			continue
+		}
+
+		attempts, retryErr := w.Tiers.RetryTierMovementJob(ctx, leaderboard, w.RewardDeliveryMaxAttempts, w.RewardDeliveryRetryInterval)
+		if retryErr != nil {
+			errChan <- retryErr
+			continue
+		}
+
+		if attempts >= w.RewardDeliveryMaxAttempts {
+			errChan <- fmt.Errorf("tier movements of %s dropped after %d attempts: %w", leaderboard, attempts, err)
+		} else {
+			errChan <- fmt.Errorf("tier movements of %s failed %d times: %w", leaderboard, attempts, err)
+		}
+	}
+}
+
+// moveLeaderboardTiers ranks the archived members of a tier leaderboard, records the tier each one plays
+// the next season in and posts the movements to the tiered league webhook, recording is idempotent so
+// retries don't duplicate history entries
+func (w *RolloverWorker) moveLeaderboardTiers(ctx context.Context, leaderboard string) error {
+	leagueID, settings, tier := w.getTieredLeague(leaderboard)
+	if settings == nil {
+		return fmt.Errorf("no tiered league is configured")
+	}
+
+	archive, err := w.Database.GetLeaderboardArchive(ctx, leaderboard)
+	if err != nil {
+		return err
+	}
+
+	payload := &TierMovementsPayload{
+		TieredLeagueID: leagueID,
+		LeaderboardID:  archive.ID,
+		Tier:           tier,
+		SeasonEnd:      archive.SeasonEnd.Unix(),
+		TotalMembers:   archive.TotalMembers,
+		Members:        []*TierMovementsPayloadMember{},
+	}
+	movements := map[string]*database.TierMovement{}
+
+	if archive.TotalMembers > 0 {
+		members, err := w.Database.GetArchivedOrderedMembers(ctx, leaderboard, 0, archive.TotalMembers-1, tierMovementsOrder)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			rank := int(member.Rank) + 1
+			movement, nextTier := settings.tieredLeague.Move(tier, rank, len(members))
+
+			movements[member.Member] = &database.TierMovement{
+				Leaderboard: leaderboard,
+				SeasonEnd:   archive.SeasonEnd,
+				Tier:        tier,
+				Rank:        rank,
+				Movement:    movement,
+				NextTier:    nextTier,
+			}
+			payload.Members = append(payload.Members, &TierMovementsPayloadMember{
+				PublicID: member.Member,
+				Rank:     rank,
+				Movement: movement,
+				NextTier: nextTier,
+			})
+		}
+	}
+
+	err = w.Tiers.RecordTierMovements(ctx, leagueID, movements)
+	if err != nil {
+		return err
+	}
+
+	if settings.webhookURL == "" {
+		return nil
+	}
+	return w.postWebhook(ctx, settings.webhookURL, payload)
+}